@@ -23,6 +23,10 @@ func Execute(ctx context.Context) {
 		cmdConfig(),
 		cmdResourceCommand(),
 		cmdModuleCommand(),
+		cmdWebhookCommand(),
+		cmdLockCommand(),
+		cmdPolicyCommand(),
+		cmdRoleBindingCommand(),
 		cmdWorker(),
 	)
 
@@ -31,6 +31,10 @@ func cmdResourceCommand() *cobra.Command {
 			$ entropy resource delete -u <urn>
 			$ entropy resource edit -u <urn> -f <file>
 			$ entropy resource revisions -u <urn>
+			$ entropy resource history -u <urn>
+			$ entropy resource audit -u <urn> --since 24h
+			$ entropy resource bulk-action -p <project> -k firehose -l team=payments -a stop
+			$ entropy resource schedule -u <urn> -a scale -f <file> --cron '0 21 * * *'
 		`),
 	}
 
@@ -45,9 +49,22 @@ func cmdResourceCommand() *cobra.Command {
 		cmdViewResource(),
 		cmdEditResource(),
 		cmdStreamLogs(),
+		cmdWatchResources(),
 		cmdApplyAction(),
+		cmdBulkApplyAction(),
 		cmdDeleteResource(),
 		cmdListRevisions(),
+		cmdDiffRevisions(),
+		cmdRollbackResource(),
+		cmdRetrySync(),
+		cmdScheduleAction(),
+		cmdListScheduledActions(),
+		cmdCancelScheduledAction(),
+		cmdListDependents(),
+		cmdSyncHistory(),
+		cmdListAuditEvents(),
+		cmdOperations(),
+		cmdProjectQuota(),
 	)
 
 	return cmd
@@ -13,6 +13,9 @@ import (
 	"github.com/spf13/cobra"
 	"gopkg.in/yaml.v2"
 
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/internal/server/auth"
 	"github.com/goto/entropy/pkg/errors"
 	"github.com/goto/entropy/pkg/logger"
 	"github.com/goto/entropy/pkg/telemetry"
@@ -24,13 +27,21 @@ const configFlag = "config"
 type Config struct {
 	Log       logger.LogConfig `mapstructure:"log"`
 	Syncer    SyncerConf       `mapstructure:"syncer"`
+	Scheduler SchedulerConf    `mapstructure:"scheduler"`
+	Webhooks  WebhookConfig    `mapstructure:"webhooks"`
+	Watch     WatchConf        `mapstructure:"watch"`
+	Quotas    *quota.Config    `mapstructure:"quotas"`
+	RBAC      rbac.Config      `mapstructure:"rbac"`
+	Auth      auth.Config      `mapstructure:"auth"`
 	Service   ServeConfig      `mapstructure:"service"`
+	Store     string           `mapstructure:"store" default:"postgres"`
 	PGConnStr string           `mapstructure:"pg_conn_str" default:"postgres://postgres@localhost:5432/entropy?sslmode=disable"`
 	Telemetry telemetry.Config `mapstructure:"telemetry"`
 }
 
 type SyncerConf struct {
 	SyncInterval        time.Duration           `mapstructure:"sync_interval" default:"1s"`
+	FallbackInterval    time.Duration           `mapstructure:"fallback_interval" default:"1m"`
 	RefreshInterval     time.Duration           `mapstructure:"refresh_interval" default:"3s"`
 	ExtendLockBy        time.Duration           `mapstructure:"extend_lock_by" default:"5s"`
 	SyncBackoffInterval time.Duration           `mapstructure:"sync_backoff_interval" default:"5s"`
@@ -38,6 +49,22 @@ type SyncerConf struct {
 	Workers             map[string]WorkerConfig `mapstructure:"workers" default:"[]"`
 }
 
+type SchedulerConf struct {
+	Interval time.Duration `mapstructure:"interval" default:"10s"`
+}
+
+type WatchConf struct {
+	Retention     time.Duration `mapstructure:"retention" default:"168h"`
+	PruneInterval time.Duration `mapstructure:"prune_interval" default:"1h"`
+}
+
+type WebhookConfig struct {
+	DispatchInterval time.Duration `mapstructure:"dispatch_interval" default:"5s"`
+	MaxAttempts      int           `mapstructure:"max_attempts" default:"8"`
+	Backoff          time.Duration `mapstructure:"backoff" default:"30s"`
+	Timeout          time.Duration `mapstructure:"timeout" default:"10s"`
+}
+
 type WorkerConfig struct {
 	Count int                 `mapstructure:"count" default:"1"`
 	Scope map[string][]string `mapstructure:"labels"`
@@ -50,6 +77,10 @@ type ServeConfig struct {
 	HTTPAddr              string `mapstructure:"http_addr" default:":8081"`
 	PaginationSizeDefault int32  `mapstructure:"pagination_size_default" default:"0"`
 	PaginationPageDefault int32  `mapstructure:"pagination_page_default" default:"1"`
+
+	// IdempotencyWindow is how long the results of requests made with an
+	// idempotency key are kept for their retries.
+	IdempotencyWindow time.Duration `mapstructure:"idempotency_window" default:"24h"`
 }
 
 type clientConfig struct {
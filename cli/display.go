@@ -8,10 +8,13 @@ import (
 	"strings"
 
 	"github.com/BurntSushi/toml"
+	"github.com/goto/salt/term"
 	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
 	"gopkg.in/yaml.v3"
 
 	"github.com/goto/entropy/pkg/errors"
+	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
 )
 
 type FormatFn func(w io.Writer, v any) error
@@ -79,6 +82,101 @@ func GoFormat(w io.Writer, v any) error {
 	return err
 }
 
+// DiffFormat outputs the revision diff 'v' with additions in green, removals
+// in red and modifications in yellow.
+func DiffFormat(w io.Writer, v any) error {
+	diff, ok := v.(*entropyv1beta1.DiffRevisionsResponse)
+	if !ok {
+		return errors.Errorf("cannot format %T as a diff", v)
+	}
+
+	to := "current spec"
+	if diff.GetToId() != "" {
+		to = "revision " + diff.GetToId()
+	}
+	_, _ = fmt.Fprintln(w, term.Bold(fmt.Sprintf("%s: revision %s -> %s", diff.GetUrn(), diff.GetFromId(), to)))
+
+	sections := []struct {
+		name    string
+		changes []*entropyv1beta1.SpecChange
+	}{
+		{name: "configs", changes: diff.GetConfigs()},
+		{name: "labels", changes: diff.GetLabels()},
+		{name: "dependencies", changes: diff.GetDependencies()},
+	}
+	for _, section := range sections {
+		_, _ = fmt.Fprintf(w, "\n%s:\n", section.name)
+		if len(section.changes) == 0 {
+			_, _ = fmt.Fprintln(w, term.Grey("  (no changes)"))
+			continue
+		}
+
+		for _, change := range section.changes {
+			_, _ = fmt.Fprintln(w, formatChange("  ", change))
+		}
+	}
+	return nil
+}
+
+// PlanFormat outputs the plan of a dry-run 'v' in the same colours as
+// DiffFormat, followed by the effects on external systems. The warn
+// policies the plan violates are listed first.
+func PlanFormat(w io.Writer, v any) error {
+	plan, ok := v.(*entropyv1beta1.ResourcePlan)
+	if !ok {
+		return errors.Errorf("cannot format %T as a plan", v)
+	}
+
+	for _, warning := range plan.GetWarnings() {
+		_, _ = fmt.Fprintln(w, term.Yellowf("Warning: policy '%s': %s", warning.GetPolicy(), warning.GetMessage()))
+	}
+	if len(plan.GetWarnings()) > 0 {
+		_, _ = fmt.Fprintln(w)
+	}
+
+	if len(plan.GetChanges()) == 0 && len(plan.GetEffects()) == 0 {
+		_, _ = fmt.Fprintln(w, "No changes.")
+		return nil
+	}
+
+	_, _ = fmt.Fprintln(w, term.Bold("Planned changes:"))
+	for _, change := range plan.GetChanges() {
+		_, _ = fmt.Fprintln(w, formatChange("  ", change))
+	}
+
+	for _, effect := range plan.GetEffects() {
+		_, _ = fmt.Fprintf(w, "\n%s\n", term.Bold(fmt.Sprintf("%s %s:", effect.GetKind(), effect.GetTarget())))
+		for _, change := range effect.GetChanges() {
+			_, _ = fmt.Fprintln(w, formatChange("  ", change))
+		}
+	}
+	return nil
+}
+
+func formatChange(indent string, change *entropyv1beta1.SpecChange) string {
+	path := change.GetPath()
+	if path == "" {
+		path = "."
+	}
+
+	switch change.GetOp() {
+	case entropyv1beta1.SpecChange_OP_ADDED:
+		return term.Greenf("%s+ %s: %s", indent, path, diffValue(change.GetTo()))
+	case entropyv1beta1.SpecChange_OP_REMOVED:
+		return term.Redf("%s- %s: %s", indent, path, diffValue(change.GetFrom()))
+	default:
+		return term.Yellowf("%s~ %s: %s -> %s", indent, path, diffValue(change.GetFrom()), diffValue(change.GetTo()))
+	}
+}
+
+func diffValue(v *structpb.Value) string {
+	b, err := json.Marshal(v.AsInterface())
+	if err != nil {
+		return v.String()
+	}
+	return string(b)
+}
+
 func jsonConvert(v any) (any, error) {
 	b, err := json.Marshal(v)
 	if err != nil {
@@ -0,0 +1,192 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/salt/printer"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
+)
+
+func cmdLockCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "lock",
+		Short: "Entropy client with resource lock commands",
+		Example: heredoc.Doc(`
+			$ entropy lock create -u <urn> --reason "incident INC-42"
+			$ entropy lock create -p <project> -l env=production --reason "change freeze" --expires-in 48h
+			$ entropy lock list -p <project>
+			$ entropy lock delete --id <id>
+		`),
+	}
+
+	cfg, _ := loadClientConfig()
+
+	cmd.PersistentFlags().StringP(flagEntropyHost, "h", cfg.Host, "Entropy host to connect to")
+	cmd.PersistentFlags().DurationP(flagDialTimeout, "", dialTimeout, "Dial timeout")
+	cmd.PersistentFlags().StringP(flagOutFormat, "o", "pretty", "output format (json, yaml, pretty)")
+
+	cmd.AddCommand(
+		cmdCreateLock(),
+		cmdListLocks(),
+		cmdDeleteLock(),
+	)
+
+	return cmd
+}
+
+func cmdCreateLock() *cobra.Command {
+	var project, urn, reason, owner string
+	var labels map[string]string
+	var expiresIn time.Duration
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Lock a resource, the resources matching a label selector or a whole project.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			l := &entropyv1beta1.Lock{
+				Project: project,
+				Urn:     urn,
+				Labels:  labels,
+				Reason:  reason,
+				Owner:   owner,
+			}
+			if expiresIn > 0 {
+				l.ExpiresAt = timestamppb.New(time.Now().Add(expiresIn))
+			}
+
+			spinner := printer.Spin("Creating lock...")
+			defer spinner.Stop()
+			res, err := client.CreateLock(cmd.Context(), &entropyv1beta1.CreateLockRequest{
+				Lock: l,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			created := res.GetLock()
+			return Display(cmd, created, func(w io.Writer, _ any) error {
+				_, _ = fmt.Fprintf(w, "Lock %s created.\n", created.GetId())
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project to lock (taken from the resource for urn locks)")
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource to lock")
+	cmd.Flags().StringToStringVarP(&labels, "label", "l", nil, "lock the resources matching these labels (key=value)")
+	cmd.Flags().StringVar(&reason, "reason", "", "why the resources are locked")
+	cmd.Flags().StringVar(&owner, "owner", "", "owner of the lock (defaults to the caller)")
+	cmd.Flags().DurationVar(&expiresIn, "expires-in", 0, "release the lock automatically after this duration")
+	cmd.MarkFlagRequired("reason")
+	cmd.MarkFlagsMutuallyExclusive("urn", "label")
+
+	return cmd
+}
+
+func cmdListLocks() *cobra.Command {
+	var project string
+	var includeExpired bool
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List resource locks.",
+		Aliases: []string{"ls"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Listing locks...")
+			defer spinner.Stop()
+			res, err := client.ListLocks(cmd.Context(), &entropyv1beta1.ListLocksRequest{
+				Project:        project,
+				IncludeExpired: includeExpired,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			locks := res.GetLocks()
+			return Display(cmd, locks, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"ID", "PROJECT", "URN", "LABELS", "OWNER", "REASON", "EXPIRES AT"})
+				for _, l := range locks {
+					var labels []string
+					for k, v := range l.GetLabels() {
+						labels = append(labels, k+"="+v)
+					}
+
+					expiresAt := "-"
+					if l.GetExpiresAt() != nil {
+						expiresAt = l.GetExpiresAt().AsTime().Format(time.RFC3339)
+					}
+
+					report = append(report, []string{
+						l.GetId(), l.GetProject(), l.GetUrn(), strings.Join(labels, ","),
+						l.GetOwner(), l.GetReason(), expiresAt,
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(locks))
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of the locks")
+	cmd.Flags().BoolVar(&includeExpired, "include-expired", false, "also list expired locks")
+
+	return cmd
+}
+
+func cmdDeleteLock() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Release a resource lock.",
+		Aliases: []string{"rm", "del", "unlock"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Deleting lock...")
+			defer spinner.Stop()
+			_, err = client.DeleteLock(cmd.Context(), &entropyv1beta1.DeleteLockRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, nil, func(w io.Writer, v any) error {
+				_, _ = fmt.Fprintln(w, "Lock deleted successfully")
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "ID of the lock to delete")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
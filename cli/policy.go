@@ -0,0 +1,259 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/salt/printer"
+	"github.com/spf13/cobra"
+
+	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
+)
+
+func cmdPolicyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "policy",
+		Short: "Entropy client with admission policy commands",
+		Example: heredoc.Doc(`
+			$ entropy policy create -f policy.yaml
+			$ entropy policy list -p <project> -k firehose
+			$ entropy policy get --id <id>
+			$ entropy policy update --id <id> -f policy.yaml
+			$ entropy policy delete --id <id>
+		`),
+	}
+
+	cfg, _ := loadClientConfig()
+
+	cmd.PersistentFlags().StringP(flagEntropyHost, "h", cfg.Host, "Entropy host to connect to")
+	cmd.PersistentFlags().DurationP(flagDialTimeout, "", dialTimeout, "Dial timeout")
+	cmd.PersistentFlags().StringP(flagOutFormat, "o", "pretty", "output format (json, yaml, pretty)")
+
+	cmd.AddCommand(
+		cmdCreatePolicy(),
+		cmdGetPolicy(),
+		cmdListPolicies(),
+		cmdUpdatePolicy(),
+		cmdDeletePolicy(),
+	)
+
+	return cmd
+}
+
+func cmdCreatePolicy() *cobra.Command {
+	var file string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create an admission policy.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			var reqBody entropyv1beta1.Policy
+			if err := parseFile(file, &reqBody); err != nil {
+				return err
+			}
+
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Creating policy...")
+			defer spinner.Stop()
+			res, err := client.CreatePolicy(cmd.Context(), &entropyv1beta1.CreatePolicyRequest{
+				Policy: &reqBody,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			created := res.GetPolicy()
+			return Display(cmd, created, func(w io.Writer, _ any) error {
+				_, _ = fmt.Fprintf(w, "Policy %s created with id %s.\n", created.GetName(), created.GetId())
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the policy body file")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func cmdGetPolicy() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:     "get",
+		Short:   "Show an admission policy.",
+		Aliases: []string{"view"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Getting policy...")
+			defer spinner.Stop()
+			res, err := client.GetPolicy(cmd.Context(), &entropyv1beta1.GetPolicyRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			p := res.GetPolicy()
+			return Display(cmd, p, func(w io.Writer, _ any) error {
+				printer.Table(os.Stdout, [][]string{
+					{"ID", p.GetId()},
+					{"NAME", p.GetName()},
+					{"PROJECT", p.GetProject()},
+					{"KIND", p.GetKind()},
+					{"ACTIONS", strings.Join(p.GetActions(), ",")},
+					{"MODE", p.GetMode()},
+					{"DISABLED", fmt.Sprint(p.GetDisabled())},
+					{"EXPRESSION", p.GetExpression()},
+					{"MESSAGE", p.GetMessage()},
+				})
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "ID of the policy")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func cmdListPolicies() *cobra.Command {
+	var project, kind string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List admission policies.",
+		Aliases: []string{"ls"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Listing policies...")
+			defer spinner.Stop()
+			res, err := client.ListPolicies(cmd.Context(), &entropyv1beta1.ListPoliciesRequest{
+				Project: project,
+				Kind:    kind,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			policies := res.GetPolicies()
+			return Display(cmd, policies, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"ID", "NAME", "PROJECT", "KIND", "ACTIONS", "MODE", "DISABLED"})
+				for _, p := range policies {
+					report = append(report, []string{
+						p.GetId(), p.GetName(), p.GetProject(), p.GetKind(),
+						strings.Join(p.GetActions(), ","), p.GetMode(), fmt.Sprint(p.GetDisabled()),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(policies))
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "only list the policies that apply to this project")
+	cmd.Flags().StringVarP(&kind, "kind", "k", "", "only list the policies that apply to this kind")
+
+	return cmd
+}
+
+func cmdUpdatePolicy() *cobra.Command {
+	var id, file string
+	cmd := &cobra.Command{
+		Use:   "update",
+		Short: "Replace an admission policy.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			var reqBody entropyv1beta1.Policy
+			if err := parseFile(file, &reqBody); err != nil {
+				return err
+			}
+
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Updating policy...")
+			defer spinner.Stop()
+			res, err := client.UpdatePolicy(cmd.Context(), &entropyv1beta1.UpdatePolicyRequest{
+				Id:     id,
+				Policy: &reqBody,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			updated := res.GetPolicy()
+			return Display(cmd, updated, func(w io.Writer, _ any) error {
+				_, _ = fmt.Fprintf(w, "Policy %s updated.\n", updated.GetId())
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "ID of the policy to update")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the policy body file")
+	cmd.MarkFlagRequired("id")
+	cmd.MarkFlagRequired("file")
+
+	return cmd
+}
+
+func cmdDeletePolicy() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Delete an admission policy.",
+		Aliases: []string{"rm", "del"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Deleting policy...")
+			defer spinner.Stop()
+			_, err = client.DeletePolicy(cmd.Context(), &entropyv1beta1.DeletePolicyRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, nil, func(w io.Writer, v any) error {
+				_, _ = fmt.Fprintln(w, "Policy deleted successfully")
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "ID of the policy to delete")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
@@ -5,10 +5,12 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/goto/salt/printer"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/entropy/pkg/errors"
 	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
@@ -17,7 +19,8 @@ import (
 var PaginationSizeDefault, PaginationPageDefault int32
 
 func cmdViewResource() *cobra.Command {
-	var kind, project, urn string
+	var kind, project, urn, pageToken string
+	var labels map[string]string
 	var pageNum, pageSize int32
 	cmd := &cobra.Command{
 		Use:     "get",
@@ -56,10 +59,12 @@ func cmdViewResource() *cobra.Command {
 
 			// list resource
 			req := entropyv1beta1.ListResourcesRequest{
-				Kind:     kind,
-				Project:  project,
-				PageNum:  pageNum,
-				PageSize: pageSize,
+				Kind:      kind,
+				Project:   project,
+				Labels:    labels,
+				PageNum:   pageNum,
+				PageSize:  pageSize,
+				PageToken: pageToken,
 			}
 
 			spinner := printer.Spin("Listing resources...")
@@ -77,8 +82,11 @@ func cmdViewResource() *cobra.Command {
 				for _, r := range resources {
 					report = append(report, []string{r.Urn, r.Name, r.Kind, r.Project, r.State.Status.String()})
 				}
-				_, _ = fmt.Fprintf(w, "Total: %d\n", len(report)-1)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", res.GetCount())
 				printer.Table(os.Stdout, report)
+				if token := res.GetNextPageToken(); token != "" {
+					_, _ = fmt.Fprintf(w, "Next page token: %s\n", token)
+				}
 				return nil
 			})
 		}),
@@ -88,13 +96,16 @@ func cmdViewResource() *cobra.Command {
 	cmd.Flags().StringVarP(&project, "project", "p", "", "project of resources")
 	cmd.Flags().Int32Var(&pageNum, "page-num", PaginationPageDefault, "resources page number")
 	cmd.Flags().Int32Var(&pageSize, "page-size", PaginationSizeDefault, "resources page size")
+	cmd.Flags().StringVar(&pageToken, "page-token", "", "token from a previous listing to fetch the next page")
+	cmd.Flags().StringToStringVarP(&labels, "label", "l", nil, "label filter of resources (key=value)")
 	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the module to view")
 
 	return cmd
 }
 
 func cmdCreateResource() *cobra.Command {
-	var file string
+	var file, idempotencyKey string
+	var breakGlass bool
 	cmd := &cobra.Command{
 		Use:   "create",
 		Short: "Create a new resource on Entropy.",
@@ -111,7 +122,9 @@ func cmdCreateResource() *cobra.Command {
 			defer cancel()
 
 			req := &entropyv1beta1.CreateResourceRequest{
-				Resource: &reqBody,
+				Resource:       &reqBody,
+				BreakGlass:     breakGlass,
+				IdempotencyKey: idempotencyKey,
 			}
 
 			spinner := printer.Spin("Creating resource...")
@@ -133,12 +146,15 @@ func cmdCreateResource() *cobra.Command {
 
 	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the updated spec of resource")
 	cmd.MarkFlagRequired("file")
+	cmd.Flags().BoolVar(&breakGlass, "break-glass", false, "override the locks held on the resource (audited)")
+	cmd.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "retries with the same key return the result of the first request")
 
 	return cmd
 }
 
 func cmdEditResource() *cobra.Command {
-	var file, urn string
+	var file, urn, expectedVersion, idempotencyKey string
+	var breakGlass, clearDependencies bool
 	cmd := &cobra.Command{
 		Use:   "edit",
 		Short: "Make updates to an existing resource",
@@ -149,8 +165,12 @@ func cmdEditResource() *cobra.Command {
 			}
 
 			reqBody := entropyv1beta1.UpdateResourceRequest{
-				Urn:     urn,
-				NewSpec: &newSpec,
+				Urn:               urn,
+				NewSpec:           &newSpec,
+				ExpectedVersion:   expectedVersion,
+				BreakGlass:        breakGlass,
+				IdempotencyKey:    idempotencyKey,
+				ClearDependencies: clearDependencies,
 			}
 			if err := reqBody.ValidateAll(); err != nil {
 				return err
@@ -183,11 +203,16 @@ func cmdEditResource() *cobra.Command {
 	cmd.MarkFlagRequired("file")
 	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource to update")
 	cmd.MarkFlagRequired("urn")
+	cmd.Flags().StringVar(&expectedVersion, "expected-version", "", "only update if the resource is at this version (etag)")
+	cmd.Flags().BoolVar(&breakGlass, "break-glass", false, "override the locks held on the resource (audited)")
+	cmd.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "retries with the same key return the result of the first request")
+	cmd.Flags().BoolVar(&clearDependencies, "clear-dependencies", false, "remove all the dependencies of the resource")
 	return cmd
 }
 
 func cmdApplyAction() *cobra.Command {
-	var urn, file, actionName string
+	var urn, file, actionName, expectedVersion, idempotencyKey string
+	var breakGlass bool
 	cmd := &cobra.Command{
 		Use:     "action",
 		Short:   "Apply an action on an existing resource",
@@ -201,9 +226,12 @@ func cmdApplyAction() *cobra.Command {
 			}
 
 			reqBody := entropyv1beta1.ApplyActionRequest{
-				Urn:    urn,
-				Action: actionName,
-				Params: &params,
+				Urn:             urn,
+				Action:          actionName,
+				Params:          &params,
+				ExpectedVersion: expectedVersion,
+				BreakGlass:      breakGlass,
+				IdempotencyKey:  idempotencyKey,
 			}
 
 			err := reqBody.ValidateAll()
@@ -228,7 +256,11 @@ func cmdApplyAction() *cobra.Command {
 			resource := res.GetResource()
 			return Display(cmd, resource, func(w io.Writer, v any) error {
 				_, _ = fmt.Fprintln(w, "Action request placed successfully.")
-				_, _ = fmt.Fprintln(w, "Use 'entropy resource get <urn>' to view status.")
+				if op := res.GetOperation(); op != nil {
+					_, _ = fmt.Fprintf(w, "Use 'entropy resource operations --id %s' to follow its progress.\n", op.GetId())
+				} else {
+					_, _ = fmt.Fprintln(w, "Use 'entropy resource get <urn>' to view status.")
+				}
 				return nil
 			})
 		}),
@@ -238,12 +270,88 @@ func cmdApplyAction() *cobra.Command {
 	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the params file")
 	cmd.Flags().StringVarP(&actionName, "action", "a", "", "action to apply")
 	cmd.MarkFlagRequired("action")
+	cmd.Flags().StringVar(&expectedVersion, "expected-version", "", "only apply if the resource is at this version (etag)")
+	cmd.Flags().BoolVar(&breakGlass, "break-glass", false, "override the locks held on the resource (audited)")
+	cmd.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "retries with the same key return the result of the first request")
+
+	return cmd
+}
+
+func cmdBulkApplyAction() *cobra.Command {
+	var project, kind, file, actionName string
+	var labels map[string]string
+	var concurrency, batchSize int32
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "bulk-action",
+		Short: "Apply an action on all resources matching a selector",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			var params structpb.Value
+			if file != "" {
+				if err := parseFile(file, &params); err != nil {
+					return err
+				}
+			}
+
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Applying action...")
+			defer spinner.Stop()
+			res, err := client.BulkApplyAction(cmd.Context(), &entropyv1beta1.BulkApplyActionRequest{
+				Project:     project,
+				Kind:        kind,
+				Labels:      labels,
+				Action:      actionName,
+				Params:      &params,
+				Concurrency: concurrency,
+				BatchSize:   batchSize,
+				DryRun:      dryRun,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, res, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"URN", "RESULT", "STATUS", "ERROR"})
+				for _, r := range res.GetResults() {
+					result := "failure"
+					if r.GetSuccess() {
+						result = "success"
+					}
+					report = append(report, []string{
+						r.GetUrn(), result, r.GetResource().GetState().GetStatus().String(), r.GetError(),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Succeeded: %d, Failed: %d\n", res.GetSucceeded(), res.GetFailed())
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of the resources")
+	cmd.Flags().StringVarP(&kind, "kind", "k", "", "kind of the resources")
+	cmd.Flags().StringToStringVarP(&labels, "label", "l", nil, "label filter of the resources (key=value)")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the params file")
+	cmd.Flags().StringVarP(&actionName, "action", "a", "", "action to apply")
+	cmd.Flags().Int32Var(&concurrency, "concurrency", 0, "number of actions applied in parallel (default 4)")
+	cmd.Flags().Int32Var(&batchSize, "batch-size", 0, "apply in batches of this many resources, each once the actions of the previous one are applied")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only plan the action on every resource")
+	cmd.MarkFlagRequired("project")
+	cmd.MarkFlagRequired("action")
 
 	return cmd
 }
 
 func cmdDeleteResource() *cobra.Command {
-	var urn string
+	var urn, idempotencyKey string
+	var cascade, breakGlass bool
 	cmd := &cobra.Command{
 		Use:     "delete",
 		Short:   "Delete an existing resource.",
@@ -257,20 +365,31 @@ func cmdDeleteResource() *cobra.Command {
 
 			spinner := printer.Spin("Deleting resource...")
 			defer spinner.Stop()
-			_, err = client.DeleteResource(cmd.Context(), &entropyv1beta1.DeleteResourceRequest{Urn: urn})
+			res, err := client.DeleteResource(cmd.Context(), &entropyv1beta1.DeleteResourceRequest{
+				Urn:            urn,
+				Cascade:        cascade,
+				BreakGlass:     breakGlass,
+				IdempotencyKey: idempotencyKey,
+			})
 			if err != nil {
 				return err
 			}
 			spinner.Stop()
 
-			return Display(cmd, nil, func(w io.Writer, v any) error {
+			return Display(cmd, res.GetOperation(), func(w io.Writer, v any) error {
 				_, _ = fmt.Fprintln(w, "Delete request placed successfully")
+				if op := res.GetOperation(); op != nil {
+					_, _ = fmt.Fprintf(w, "Use 'entropy resource operations --id %s' to follow its progress.\n", op.GetId())
+				}
 				return nil
 			})
 		}),
 	}
 
 	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource to delete")
+	cmd.Flags().BoolVar(&cascade, "cascade", false, "also delete all the resources depending on this resource")
+	cmd.Flags().BoolVar(&breakGlass, "break-glass", false, "override the locks held on the resources (audited)")
+	cmd.Flags().StringVar(&idempotencyKey, "idempotency-key", "", "retries with the same key return the result of the first request")
 	cmd.MarkFlagRequired("urn")
 
 	return cmd
@@ -322,6 +441,363 @@ func cmdListRevisions() *cobra.Command {
 	return cmd
 }
 
+func cmdRollbackResource() *cobra.Command {
+	var urn, revisionID string
+	var dryRun bool
+	cmd := &cobra.Command{
+		Use:   "rollback",
+		Short: "Rollback a resource to the spec configs of a previous revision.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Rolling back resource...")
+			defer spinner.Stop()
+			res, err := client.RollbackResource(cmd.Context(), &entropyv1beta1.RollbackResourceRequest{
+				Urn:        urn,
+				RevisionId: revisionID,
+				DryRun:     dryRun,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			if dryRun {
+				return Display(cmd, res.GetPlan(), PlanFormat)
+			}
+
+			resource := res.GetResource()
+			return Display(cmd, resource, func(w io.Writer, v any) error {
+				_, _ = fmt.Fprintf(w, "Rollback to revision %s placed successfully.\n", revisionID)
+				_, _ = fmt.Fprintln(w, "Use 'entropy resource get <urn>' to view status.")
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource to rollback")
+	cmd.Flags().StringVarP(&revisionID, "revision", "r", "", "ID of the revision to rollback to")
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "only plan the rollback without applying it")
+	cmd.MarkFlagRequired("urn")
+	cmd.MarkFlagRequired("revision")
+
+	return cmd
+}
+
+func cmdRetrySync() *cobra.Command {
+	var urn, fromStep string
+	var breakGlass bool
+	cmd := &cobra.Command{
+		Use:   "retry",
+		Short: "Retry the sync of a resource in error state.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Retrying resource sync...")
+			defer spinner.Stop()
+			res, err := client.RetrySync(cmd.Context(), &entropyv1beta1.RetrySyncRequest{
+				Urn:        urn,
+				FromStep:   fromStep,
+				BreakGlass: breakGlass,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			resource := res.GetResource()
+			return Display(cmd, resource, func(w io.Writer, v any) error {
+				_, _ = fmt.Fprintln(w, "Resource queued for sync again.")
+				if op := res.GetOperation(); op != nil {
+					_, _ = fmt.Fprintf(w, "Use 'entropy resource operations --id %s' to follow its progress.\n", op.GetId())
+				} else {
+					_, _ = fmt.Fprintln(w, "Use 'entropy resource history -u <urn>' to follow the sync.")
+				}
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource to retry")
+	cmd.Flags().StringVar(&fromStep, "from-step", "", "pending step to resume from, skipping the ones before it")
+	cmd.Flags().BoolVar(&breakGlass, "break-glass", false, "override the locks held on the resource (audited)")
+	cmd.MarkFlagRequired("urn")
+
+	return cmd
+}
+
+func cmdDiffRevisions() *cobra.Command {
+	var urn, fromID, toID string
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Show the differences between two revisions of a resource.",
+		Long: "Show the differences in spec configs, labels and dependencies between two revisions " +
+			"of a resource. Without --to, the revision is compared against the current spec.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Comparing revisions...")
+			defer spinner.Stop()
+			res, err := client.DiffRevisions(cmd.Context(), &entropyv1beta1.DiffRevisionsRequest{
+				Urn:    urn,
+				FromId: fromID,
+				ToId:   toID,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, res, DiffFormat)
+		}),
+	}
+
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource")
+	cmd.Flags().StringVar(&fromID, "from", "", "ID of the revision to compare from")
+	cmd.Flags().StringVar(&toID, "to", "", "ID of the revision to compare to (defaults to the current spec)")
+	cmd.MarkFlagRequired("urn")
+	cmd.MarkFlagRequired("from")
+
+	return cmd
+}
+
+func cmdListDependents() *cobra.Command {
+	var urn string
+	var transitive bool
+	cmd := &cobra.Command{
+		Use:     "dependents",
+		Short:   "List resources depending on a resource.",
+		Aliases: []string{"deps"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			req := &entropyv1beta1.GetResourceDependentsRequest{
+				Urn:        urn,
+				Transitive: transitive,
+			}
+
+			spinner := printer.Spin("Retrieving resource dependents...")
+			defer spinner.Stop()
+			res, err := client.GetResourceDependents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			dependents := res.GetDependents()
+			return Display(cmd, dependents, func(w io.Writer, v any) error {
+				var report [][]string
+				report = append(report, []string{"URN", "NAME", "KIND", "PROJECT", "STATUS"})
+				for _, r := range dependents {
+					report = append(report, []string{r.Urn, r.Name, r.Kind, r.Project, r.State.Status.String()})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(report)-1)
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource to list dependents of")
+	cmd.Flags().BoolVarP(&transitive, "transitive", "t", false, "include indirect dependents")
+	cmd.MarkFlagRequired("urn")
+
+	return cmd
+}
+
+func cmdSyncHistory() *cobra.Command {
+	var urn string
+	var limit int32
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "List the sync history of a resource.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			req := &entropyv1beta1.GetResourceSyncHistoryRequest{Urn: urn, Limit: limit}
+
+			spinner := printer.Spin("Retrieving sync history...")
+			defer spinner.Stop()
+			res, err := client.GetResourceSyncHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			runs := res.GetRuns()
+			return Display(cmd, runs, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"STARTED AT", "STEP", "DURATION", "OUTCOME", "RETRIES", "ERROR"})
+				for _, run := range runs {
+					report = append(report, []string{
+						run.GetStartedAt().AsTime().String(), run.GetStep(), run.GetDuration().AsDuration().String(),
+						run.GetOutcome(), fmt.Sprint(run.GetRetries()), run.GetError(),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(runs))
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource")
+	cmd.Flags().Int32Var(&limit, "limit", 0, "maximum number of syncs to list")
+	cmd.MarkFlagRequired("urn")
+
+	return cmd
+}
+
+func cmdListAuditEvents() *cobra.Command {
+	var project, urn, userID string
+	var since, until time.Duration
+	var limit int32
+	cmd := &cobra.Command{
+		Use:   "audit",
+		Short: "List audit events of resource and module mutations.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			req := &entropyv1beta1.ListAuditEventsRequest{
+				Project: project,
+				Urn:     urn,
+				UserId:  userID,
+				Limit:   limit,
+			}
+			now := time.Now()
+			if since > 0 {
+				req.Since = timestamppb.New(now.Add(-since))
+			}
+			if until > 0 {
+				req.Until = timestamppb.New(now.Add(-until))
+			}
+
+			spinner := printer.Spin("Listing audit events...")
+			defer spinner.Stop()
+			res, err := client.ListAuditEvents(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			events := res.GetEvents()
+			return Display(cmd, events, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"TIMESTAMP", "URN", "USER", "ACTION", "RESULT", "ERROR"})
+				for _, e := range events {
+					report = append(report, []string{
+						e.GetTimestamp().AsTime().String(), e.GetUrn(), e.GetUserId(),
+						e.GetAction(), e.GetResult(), e.GetError(),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(events))
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of the events")
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource or module")
+	cmd.Flags().StringVar(&userID, "user", "", "user who triggered the events")
+	cmd.Flags().DurationVar(&since, "since", 0, "only events newer than this duration (e.g. 24h)")
+	cmd.Flags().DurationVar(&until, "until", 0, "only events older than this duration")
+	cmd.Flags().Int32Var(&limit, "limit", 0, "maximum number of events to list")
+
+	return cmd
+}
+
+func cmdOperations() *cobra.Command {
+	var id, project, urn string
+	var statuses []string
+	var limit int32
+	cmd := &cobra.Command{
+		Use:   "operations",
+		Short: "List or view the operations tracking the actions on resources.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			var ops []*entropyv1beta1.Operation
+			if id != "" {
+				spinner := printer.Spin("Getting operation...")
+				defer spinner.Stop()
+				res, err := client.GetOperation(cmd.Context(), &entropyv1beta1.GetOperationRequest{Id: id})
+				if err != nil {
+					return err
+				}
+				spinner.Stop()
+				ops = append(ops, res.GetOperation())
+			} else {
+				spinner := printer.Spin("Listing operations...")
+				defer spinner.Stop()
+				res, err := client.ListOperations(cmd.Context(), &entropyv1beta1.ListOperationsRequest{
+					Project:  project,
+					Urn:      urn,
+					Statuses: statuses,
+					Limit:    limit,
+				})
+				if err != nil {
+					return err
+				}
+				spinner.Stop()
+				ops = res.GetOperations()
+			}
+
+			return Display(cmd, ops, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"ID", "URN", "ACTION", "USER", "STATUS", "PENDING STEPS", "STARTED AT", "ERROR"})
+				for _, op := range ops {
+					report = append(report, []string{
+						op.GetId(), op.GetUrn(), op.GetAction(), op.GetUserId(), op.GetStatus(),
+						strings.Join(op.GetPendingSteps(), ","), op.GetStartedAt().AsTime().String(), op.GetError(),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				if id == "" {
+					_, _ = fmt.Fprintf(w, "Total: %d\n", len(ops))
+				}
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "ID of the operation to view")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of the operations")
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "URN of the resource")
+	cmd.Flags().StringSliceVar(&statuses, "status", nil, "only operations in these statuses (pending, running, completed, failed, superseded)")
+	cmd.Flags().Int32Var(&limit, "limit", 0, "maximum number of operations to list")
+
+	return cmd
+}
+
 func cmdStreamLogs() *cobra.Command {
 	var urn string
 	var filter []string
@@ -385,3 +861,124 @@ func cmdStreamLogs() *cobra.Command {
 
 	return cmd
 }
+
+func cmdWatchResources() *cobra.Command {
+	var kind, project, token string
+	var labels map[string]string
+	cmd := &cobra.Command{
+		Use:   "watch",
+		Short: "Stream the changes of resources as they happen.",
+		Long: "Stream the changes of resources matching the filters as they happen. Every event " +
+			"carries a watch token, pass it with --token to resume the watch after that event.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			stream, err := client.WatchResources(cmd.Context(), &entropyv1beta1.WatchResourcesRequest{
+				Project:    project,
+				Kind:       kind,
+				Labels:     labels,
+				WatchToken: token,
+			})
+			if err != nil {
+				return err
+			}
+
+			for {
+				resp, err := stream.Recv()
+				if err != nil {
+					if errors.Is(err, io.EOF) {
+						break
+					}
+					return fmt.Errorf("failed to read stream: %w", err)
+				}
+
+				ev := resp.GetEvent()
+				_ = Display(cmd, ev, func(w io.Writer, v any) error {
+					r := ev.GetResource()
+					_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n",
+						ev.GetTimestamp().AsTime().Format(time.RFC3339), ev.GetType(),
+						r.GetUrn(), r.GetState().GetStatus(), ev.GetWatchToken())
+					return nil
+				})
+			}
+
+			return nil
+		}),
+	}
+
+	cmd.Flags().StringVarP(&kind, "kind", "k", "", "kind of resources")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of resources")
+	cmd.Flags().StringToStringVarP(&labels, "label", "l", nil, "label filter of resources (key=value)")
+	cmd.Flags().StringVar(&token, "token", "", "watch token of the event to resume after")
+
+	return cmd
+}
+
+func cmdProjectQuota() *cobra.Command {
+	var project string
+	cmd := &cobra.Command{
+		Use:   "quota",
+		Short: "View the usage of a project against its quotas.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Retrieving project quota...")
+			defer spinner.Stop()
+			res, err := client.GetProjectQuota(cmd.Context(), &entropyv1beta1.GetProjectQuotaRequest{
+				Project: project,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, res, func(w io.Writer, _ any) error {
+				limitOf := func(v int64) string {
+					if v == 0 {
+						return "-"
+					}
+					return fmt.Sprint(v)
+				}
+				quantityOf := func(v string) string {
+					if v == "" {
+						return "-"
+					}
+					return v
+				}
+
+				var report [][]string
+				report = append(report, []string{"KIND", "RESOURCES", "MAX REPLICAS", "CPU", "MEMORY"})
+				row := func(kind string, limit *entropyv1beta1.QuotaLimit, usage *entropyv1beta1.QuotaUsage) []string {
+					return []string{
+						kind,
+						fmt.Sprintf("%d / %s", usage.GetResources(), limitOf(limit.GetMaxResources())),
+						fmt.Sprintf("%d / %s", usage.GetReplicas(), limitOf(limit.GetMaxReplicas())),
+						fmt.Sprintf("%s / %s", usage.GetCpu(), quantityOf(limit.GetMaxCpu())),
+						fmt.Sprintf("%s / %s", usage.GetMemory(), quantityOf(limit.GetMaxMemory())),
+					}
+				}
+
+				report = append(report, row("(all)", res.GetLimit(), res.GetUsage()))
+				for _, k := range res.GetKinds() {
+					report = append(report, row(k.GetKind(), k.GetLimit(), k.GetUsage()))
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintln(w, "Usage is shown as 'used / limit', '-' is unlimited.")
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project to view the quota of")
+	cmd.MarkFlagRequired("project")
+
+	return cmd
+}
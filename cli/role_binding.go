@@ -0,0 +1,172 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/salt/printer"
+	"github.com/spf13/cobra"
+
+	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
+)
+
+func cmdRoleBindingCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "role-binding",
+		Short:   "Entropy client with role binding commands",
+		Aliases: []string{"rb"},
+		Example: heredoc.Doc(`
+			$ entropy role-binding create -p <project> --user john.doe@goto.com --role viewer
+			$ entropy role-binding create -p <project> --group oncall --role operator --action scale --action restart
+			$ entropy role-binding list -p <project>
+			$ entropy role-binding delete --id <id>
+		`),
+	}
+
+	cfg, _ := loadClientConfig()
+
+	cmd.PersistentFlags().StringP(flagEntropyHost, "h", cfg.Host, "Entropy host to connect to")
+	cmd.PersistentFlags().DurationP(flagDialTimeout, "", dialTimeout, "Dial timeout")
+	cmd.PersistentFlags().StringP(flagOutFormat, "o", "pretty", "output format (json, yaml, pretty)")
+
+	cmd.AddCommand(
+		cmdCreateRoleBinding(),
+		cmdListRoleBindings(),
+		cmdDeleteRoleBinding(),
+	)
+
+	return cmd
+}
+
+func cmdCreateRoleBinding() *cobra.Command {
+	var project, user, group, role string
+	var actions []string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Grant a role on a project to a user or a group.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Creating role binding...")
+			defer spinner.Stop()
+			res, err := client.CreateRoleBinding(cmd.Context(), &entropyv1beta1.CreateRoleBindingRequest{
+				RoleBinding: &entropyv1beta1.RoleBinding{
+					Project: project,
+					User:    user,
+					Group:   group,
+					Role:    role,
+					Actions: actions,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			created := res.GetRoleBinding()
+			return Display(cmd, created, func(w io.Writer, _ any) error {
+				_, _ = fmt.Fprintf(w, "Role binding %s created.\n", created.GetId())
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project the role is granted on")
+	cmd.Flags().StringVar(&user, "user", "", "user the role is granted to")
+	cmd.Flags().StringVar(&group, "group", "", "group the role is granted to")
+	cmd.Flags().StringVar(&role, "role", "", "role to grant (viewer, operator, admin)")
+	cmd.Flags().StringArrayVar(&actions, "action", nil, "limit an operator to this action (repeatable)")
+	cmd.MarkFlagRequired("project")
+	cmd.MarkFlagRequired("role")
+	cmd.MarkFlagsMutuallyExclusive("user", "group")
+
+	return cmd
+}
+
+func cmdListRoleBindings() *cobra.Command {
+	var project string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List role bindings.",
+		Aliases: []string{"ls"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Listing role bindings...")
+			defer spinner.Stop()
+			res, err := client.ListRoleBindings(cmd.Context(), &entropyv1beta1.ListRoleBindingsRequest{
+				Project: project,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			bindings := res.GetRoleBindings()
+			return Display(cmd, bindings, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"ID", "PROJECT", "USER", "GROUP", "ROLE", "ACTIONS", "CREATED BY"})
+				for _, b := range bindings {
+					report = append(report, []string{
+						b.GetId(), b.GetProject(), b.GetUser(), b.GetGroup(),
+						b.GetRole(), strings.Join(b.GetActions(), ","), b.GetCreatedBy(),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(bindings))
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of the role bindings")
+
+	return cmd
+}
+
+func cmdDeleteRoleBinding() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Delete a role binding.",
+		Aliases: []string{"rm", "del"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Deleting role binding...")
+			defer spinner.Stop()
+			_, err = client.DeleteRoleBinding(cmd.Context(), &entropyv1beta1.DeleteRoleBindingRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, nil, func(w io.Writer, v any) error {
+				_, _ = fmt.Fprintln(w, "Role binding deleted successfully")
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "ID of the role binding to delete")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
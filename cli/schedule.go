@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"time"
+
+	"github.com/goto/salt/printer"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/pkg/errors"
+	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
+)
+
+func cmdScheduleAction() *cobra.Command {
+	var urn, file, actionName, at, cronExpr string
+	cmd := &cobra.Command{
+		Use:   "schedule",
+		Short: "Schedule an action on an existing resource",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			var params structpb.Value
+			if file != "" {
+				if err := parseFile(file, &params); err != nil {
+					return err
+				}
+			}
+
+			reqBody := entropyv1beta1.ScheduleActionRequest{
+				Urn:    urn,
+				Action: actionName,
+				Params: &params,
+				Cron:   cronExpr,
+			}
+			if at != "" {
+				runAt, err := parseRunAt(at)
+				if err != nil {
+					return err
+				}
+				reqBody.RunAt = timestamppb.New(runAt)
+			}
+
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Scheduling action...")
+			defer spinner.Stop()
+			res, err := client.ScheduleAction(cmd.Context(), &reqBody)
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			scheduled := res.GetScheduledAction()
+			return Display(cmd, scheduled, func(w io.Writer, _ any) error {
+				_, _ = fmt.Fprintf(w, "Action scheduled with id %s, next run at %s\n",
+					scheduled.GetId(), scheduled.GetNextRunAt().AsTime())
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "urn of the resource")
+	cmd.Flags().StringVarP(&file, "file", "f", "", "path to the params file")
+	cmd.Flags().StringVarP(&actionName, "action", "a", "", "action to apply")
+	cmd.Flags().StringVar(&at, "at", "", "time to run the action at (RFC3339, or a duration from now e.g. 2h)")
+	cmd.Flags().StringVar(&cronExpr, "cron", "", "cron expression to run the action on (e.g. '0 21 * * *')")
+	cmd.MarkFlagRequired("urn")
+	cmd.MarkFlagRequired("action")
+	cmd.MarkFlagsMutuallyExclusive("at", "cron")
+
+	return cmd
+}
+
+func cmdListScheduledActions() *cobra.Command {
+	var urn, project, status string
+	cmd := &cobra.Command{
+		Use:   "schedules",
+		Short: "List scheduled actions",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Listing scheduled actions...")
+			defer spinner.Stop()
+			res, err := client.ListScheduledActions(cmd.Context(), &entropyv1beta1.ListScheduledActionsRequest{
+				Urn:     urn,
+				Project: project,
+				Status:  status,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			schedules := res.GetScheduledActions()
+			return Display(cmd, schedules, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"ID", "URN", "ACTION", "WHEN", "STATUS", "NEXT RUN", "LAST ERROR"})
+				for _, s := range schedules {
+					when := s.GetCron()
+					if s.GetRunAt() != nil {
+						when = s.GetRunAt().AsTime().String()
+					}
+
+					nextRun := s.GetNextRunAt().AsTime().String()
+					if s.GetStatus() != schedule.StatusPending {
+						nextRun = "-"
+					}
+
+					report = append(report, []string{
+						s.GetId(), s.GetUrn(), s.GetAction(), when, s.GetStatus(), nextRun, s.GetLastError(),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(schedules))
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&urn, "urn", "u", "", "urn of the resource")
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of the resources")
+	cmd.Flags().StringVar(&status, "status", "", "status of the scheduled actions (pending, completed, failed, cancelled)")
+
+	return cmd
+}
+
+func cmdCancelScheduledAction() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:   "unschedule",
+		Short: "Cancel a pending scheduled action",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Cancelling scheduled action...")
+			defer spinner.Stop()
+			res, err := client.CancelScheduledAction(cmd.Context(), &entropyv1beta1.CancelScheduledActionRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, res.GetScheduledAction(), func(w io.Writer, _ any) error {
+				_, _ = fmt.Fprintln(w, "Scheduled action cancelled successfully")
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "id of the scheduled action")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
+
+func parseRunAt(at string) (time.Time, error) {
+	if d, err := time.ParseDuration(at); err == nil {
+		return time.Now().Add(d), nil
+	}
+
+	runAt, err := time.Parse(time.RFC3339, at)
+	if err != nil {
+		return time.Time{}, errors.ErrInvalid.WithMsgf("--at must be an RFC3339 time or a duration")
+	}
+	return runAt, nil
+}
@@ -11,7 +11,11 @@ import (
 
 	"github.com/goto/entropy/core"
 	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
 	entropyserver "github.com/goto/entropy/internal/server"
+	"github.com/goto/entropy/internal/server/auth"
+	"github.com/goto/entropy/internal/store/inmemory"
 	"github.com/goto/entropy/internal/store/postgres"
 	"github.com/goto/entropy/modules"
 	"github.com/goto/entropy/modules/dagger"
@@ -69,9 +73,17 @@ func StartServer(ctx context.Context, cfg Config, migrate, spawnWorker bool) err
 		}
 	}
 
-	store := setupStorage(cfg.PGConnStr, cfg.Syncer, cfg.Service)
-	moduleService := module.NewService(setupRegistry(), store)
-	resourceService := core.New(store, moduleService, time.Now, cfg.Syncer.SyncBackoffInterval, cfg.Syncer.MaxRetries, cfg.Telemetry.ServiceName)
+	store := setupStorage(cfg)
+	serviceOpts := setupServiceOptions(cfg)
+
+	var moduleOpts []module.ServiceOption
+	if authorizer := setupAuthorizer(cfg, store); authorizer != nil {
+		moduleOpts = append(moduleOpts, module.WithAuthorizer(authorizer))
+		serviceOpts = append(serviceOpts, core.WithAuthorizer(authorizer))
+	}
+
+	moduleService := module.NewService(setupRegistry(cfg.Telemetry.ServiceName), store, moduleOpts...)
+	resourceService := core.New(store, moduleService, time.Now, cfg.Syncer.SyncBackoffInterval, cfg.Syncer.MaxRetries, cfg.Telemetry.ServiceName, serviceOpts...)
 
 	if migrate {
 		if migrateErr := runMigrations(ctx, cfg); migrateErr != nil {
@@ -81,7 +93,10 @@ func StartServer(ctx context.Context, cfg Config, migrate, spawnWorker bool) err
 
 	if spawnWorker {
 		eg := &errgroup.Group{}
-		spawnWorkers(ctx, resourceService, cfg.Syncer.Workers, cfg.Syncer.SyncInterval, eg)
+		spawnWorkers(ctx, resourceService, cfg.Syncer.Workers, cfg.Syncer.SyncInterval, cfg.Syncer.FallbackInterval, eg)
+		resourceService.RunScheduler(ctx, cfg.Scheduler.Interval, eg)
+		resourceService.RunEventPruner(ctx, cfg.Watch.Retention, cfg.Watch.PruneInterval, eg)
+		spawnWebhookDispatcher(ctx, store, cfg.Webhooks, eg)
 		go func() {
 			if err := eg.Wait(); err != nil {
 				zap.L().Error("syncer exited with error", zap.Error(err))
@@ -91,11 +106,11 @@ func StartServer(ctx context.Context, cfg Config, migrate, spawnWorker bool) err
 
 	return entropyserver.Serve(ctx,
 		cfg.Service.httpAddr(), cfg.Service.grpcAddr(),
-		nrApp, resourceService, moduleService,
+		nrApp, setupAuthenticator(cfg), resourceService, moduleService,
 	)
 }
 
-func setupRegistry() module.Registry {
+func setupRegistry(serviceName string) module.Registry {
 	supported := []module.Descriptor{
 		kubernetes.Module,
 		firehose.Module,
@@ -105,7 +120,7 @@ func setupRegistry() module.Registry {
 		dagger.Module,
 	}
 
-	registry := &modules.Registry{}
+	registry := &modules.Registry{ServiceName: serviceName}
 	for _, desc := range supported {
 		if err := registry.Register(desc); err != nil {
 			zap.L().Fatal("failed to register module",
@@ -117,11 +132,83 @@ func setupRegistry() module.Registry {
 	return registry
 }
 
-func setupStorage(pgConStr string, syncCfg SyncerConf, serveCfg ServeConfig) *postgres.Store {
-	store, err := postgres.Open(pgConStr, syncCfg.RefreshInterval, syncCfg.ExtendLockBy, serveCfg.PaginationSizeDefault, serveCfg.PaginationPageDefault)
+const (
+	storePostgres = "postgres"
+	storeInMemory = "inmemory"
+)
+
+type storage interface {
+	resource.Store
+	module.Store
+
+	Migrate(ctx context.Context) error
+}
+
+func setupServiceOptions(cfg Config) []core.ServiceOption {
+	var opts []core.ServiceOption
+
+	if cfg.Quotas != nil {
+		if err := cfg.Quotas.Validate(); err != nil {
+			zap.L().Fatal("invalid quota configs", zap.Error(err))
+		}
+		opts = append(opts, core.WithQuotas(*cfg.Quotas))
+	}
+
+	if cfg.Service.IdempotencyWindow > 0 {
+		opts = append(opts, core.WithIdempotencyWindow(cfg.Service.IdempotencyWindow))
+	}
+	return opts
+}
+
+// setupAuthenticator returns the authenticator of the API requests, or nil
+// if the callers are to be identified by their headers.
+func setupAuthenticator(cfg Config) *auth.Authenticator {
+	if !cfg.Auth.Enabled {
+		return nil
+	}
+
+	authn, err := auth.New(cfg.Auth)
 	if err != nil {
-		zap.L().Fatal("failed to connect to Postgres database",
-			zap.Error(err), zap.String("conn_str", pgConStr))
+		zap.L().Fatal("failed to setup authentication", zap.Error(err))
+	}
+	return authn
+}
+
+// setupAuthorizer returns the authorizer of the API requests, or nil if
+// authorization is disabled.
+func setupAuthorizer(cfg Config, store storage) *rbac.Authorizer {
+	if !cfg.RBAC.Enabled {
+		return nil
+	}
+
+	bindingStore, ok := store.(rbac.Store)
+	if !ok {
+		zap.L().Fatal("rbac is enabled but the store does not support role bindings", zap.String("store", cfg.Store))
+	}
+	return rbac.NewAuthorizer(cfg.RBAC, bindingStore)
+}
+
+func setupStorage(cfg Config) storage {
+	syncCfg, serveCfg := cfg.Syncer, cfg.Service
+
+	switch cfg.Store {
+	case storeInMemory:
+		store, err := inmemory.Open(syncCfg.RefreshInterval, syncCfg.ExtendLockBy, serveCfg.PaginationSizeDefault, serveCfg.PaginationPageDefault)
+		if err != nil {
+			zap.L().Fatal("failed to setup in-memory store", zap.Error(err))
+		}
+		return store
+
+	case storePostgres, "":
+		store, err := postgres.Open(cfg.PGConnStr, syncCfg.RefreshInterval, syncCfg.ExtendLockBy, serveCfg.PaginationSizeDefault, serveCfg.PaginationPageDefault)
+		if err != nil {
+			zap.L().Fatal("failed to connect to Postgres database",
+				zap.Error(err), zap.String("conn_str", cfg.PGConnStr))
+		}
+		return store
+
+	default:
+		zap.L().Fatal("unknown store type", zap.String("store", cfg.Store))
+		return nil
 	}
-	return store
 }
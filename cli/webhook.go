@@ -0,0 +1,173 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/MakeNowJust/heredoc"
+	"github.com/goto/salt/printer"
+	"github.com/spf13/cobra"
+
+	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
+)
+
+func cmdWebhookCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "webhook",
+		Short: "Entropy client with webhook subscription commands",
+		Example: heredoc.Doc(`
+			$ entropy webhook create -p <project> --url <url> --kind firehose --status ERROR
+			$ entropy webhook list -p <project>
+			$ entropy webhook delete --id <id>
+		`),
+	}
+
+	cfg, _ := loadClientConfig()
+
+	cmd.PersistentFlags().StringP(flagEntropyHost, "h", cfg.Host, "Entropy host to connect to")
+	cmd.PersistentFlags().DurationP(flagDialTimeout, "", dialTimeout, "Dial timeout")
+	cmd.PersistentFlags().StringP(flagOutFormat, "o", "pretty", "output format (json, yaml, pretty)")
+
+	cmd.AddCommand(
+		cmdCreateWebhook(),
+		cmdListWebhooks(),
+		cmdDeleteWebhook(),
+	)
+
+	return cmd
+}
+
+func cmdCreateWebhook() *cobra.Command {
+	var project, url, secret string
+	var kinds, statuses, actions []string
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Subscribe a URL to the events of a project.",
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Creating webhook...")
+			defer spinner.Stop()
+			res, err := client.CreateWebhook(cmd.Context(), &entropyv1beta1.CreateWebhookRequest{
+				Webhook: &entropyv1beta1.Webhook{
+					Project:  project,
+					Url:      url,
+					Secret:   secret,
+					Kinds:    kinds,
+					Statuses: statuses,
+					Actions:  actions,
+				},
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			wh := res.GetWebhook()
+			return Display(cmd, wh, func(w io.Writer, _ any) error {
+				_, _ = fmt.Fprintf(w, "Webhook %s created, deliveries are signed with secret:\n%s\n", wh.GetId(), wh.GetSecret())
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project to receive the events of")
+	cmd.Flags().StringVar(&url, "url", "", "URL to post the events to")
+	cmd.Flags().StringVar(&secret, "secret", "", "secret to sign the events with (generated if not set)")
+	cmd.Flags().StringSliceVar(&kinds, "kind", nil, "only events of resources of these kinds")
+	cmd.Flags().StringSliceVar(&statuses, "status", nil, "only events of resources in these statuses (PENDING, COMPLETED, ERROR, DELETED)")
+	cmd.Flags().StringSliceVar(&actions, "action", nil, "only events of these actions")
+	cmd.MarkFlagRequired("project")
+	cmd.MarkFlagRequired("url")
+
+	return cmd
+}
+
+func cmdListWebhooks() *cobra.Command {
+	var project string
+	cmd := &cobra.Command{
+		Use:     "list",
+		Short:   "List webhook subscriptions.",
+		Aliases: []string{"ls"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Listing webhooks...")
+			defer spinner.Stop()
+			res, err := client.ListWebhooks(cmd.Context(), &entropyv1beta1.ListWebhooksRequest{
+				Project: project,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			webhooks := res.GetWebhooks()
+			return Display(cmd, webhooks, func(w io.Writer, _ any) error {
+				var report [][]string
+				report = append(report, []string{"ID", "PROJECT", "URL", "KINDS", "STATUSES", "ACTIONS"})
+				for _, wh := range webhooks {
+					report = append(report, []string{
+						wh.GetId(), wh.GetProject(), wh.GetUrl(),
+						strings.Join(wh.GetKinds(), ","),
+						strings.Join(wh.GetStatuses(), ","),
+						strings.Join(wh.GetActions(), ","),
+					})
+				}
+				printer.Table(os.Stdout, report)
+				_, _ = fmt.Fprintf(w, "Total: %d\n", len(webhooks))
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVarP(&project, "project", "p", "", "project of the webhooks")
+
+	return cmd
+}
+
+func cmdDeleteWebhook() *cobra.Command {
+	var id string
+	cmd := &cobra.Command{
+		Use:     "delete",
+		Short:   "Delete a webhook subscription along with its pending deliveries.",
+		Aliases: []string{"rm", "del"},
+		RunE: handleErr(func(cmd *cobra.Command, args []string) error {
+			client, cancel, err := createResourceServiceClient(cmd)
+			if err != nil {
+				return err
+			}
+			defer cancel()
+
+			spinner := printer.Spin("Deleting webhook...")
+			defer spinner.Stop()
+			_, err = client.DeleteWebhook(cmd.Context(), &entropyv1beta1.DeleteWebhookRequest{
+				Id: id,
+			})
+			if err != nil {
+				return err
+			}
+			spinner.Stop()
+
+			return Display(cmd, nil, func(w io.Writer, v any) error {
+				_, _ = fmt.Fprintln(w, "Webhook deleted successfully")
+				return nil
+			})
+		}),
+	}
+
+	cmd.Flags().StringVar(&id, "id", "", "ID of the webhook to delete")
+	cmd.MarkFlagRequired("id")
+
+	return cmd
+}
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"context"
+	"net/http"
 	"time"
 
 	"github.com/MakeNowJust/heredoc"
 	"github.com/goto/entropy/core"
 	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/webhook"
 	"github.com/goto/entropy/pkg/logger"
 	"github.com/goto/entropy/pkg/telemetry"
 	"github.com/newrelic/go-agent/v3/newrelic"
@@ -58,12 +60,15 @@ func cmdWorker() *cobra.Command {
 }
 
 func StartWorkers(ctx context.Context, cfg Config) error {
-	store := setupStorage(cfg.PGConnStr, cfg.Syncer, cfg.Service)
-	moduleService := module.NewService(setupRegistry(), store)
-	resourceService := core.New(store, moduleService, time.Now, cfg.Syncer.SyncBackoffInterval, cfg.Syncer.MaxRetries, cfg.Telemetry.ServiceName)
+	store := setupStorage(cfg)
+	moduleService := module.NewService(setupRegistry(cfg.Telemetry.ServiceName), store)
+	resourceService := core.New(store, moduleService, time.Now, cfg.Syncer.SyncBackoffInterval, cfg.Syncer.MaxRetries, cfg.Telemetry.ServiceName, setupServiceOptions(cfg)...)
 
 	eg := &errgroup.Group{}
-	spawnWorkers(ctx, resourceService, cfg.Syncer.Workers, cfg.Syncer.SyncInterval, eg)
+	spawnWorkers(ctx, resourceService, cfg.Syncer.Workers, cfg.Syncer.SyncInterval, cfg.Syncer.FallbackInterval, eg)
+	resourceService.RunScheduler(ctx, cfg.Scheduler.Interval, eg)
+	resourceService.RunEventPruner(ctx, cfg.Watch.Retention, cfg.Watch.PruneInterval, eg)
+	spawnWebhookDispatcher(ctx, store, cfg.Webhooks, eg)
 	if err := eg.Wait(); err != nil {
 		return err
 	}
@@ -71,12 +76,25 @@ func StartWorkers(ctx context.Context, cfg Config) error {
 	return nil
 }
 
-func spawnWorkers(ctx context.Context, resourceService *core.Service, workerModules map[string]WorkerConfig, syncInterval time.Duration, eg *errgroup.Group) {
+func spawnWorkers(ctx context.Context, resourceService *core.Service, workerModules map[string]WorkerConfig, syncInterval, fallbackInterval time.Duration, eg *errgroup.Group) {
 	if len(workerModules) == 0 {
-		resourceService.RunSyncer(ctx, 1, syncInterval, map[string][]string{}, eg)
+		resourceService.RunSyncer(ctx, 1, syncInterval, fallbackInterval, map[string][]string{}, eg)
 	} else {
 		for _, module := range workerModules {
-			resourceService.RunSyncer(ctx, module.Count, syncInterval, module.Scope, eg)
+			resourceService.RunSyncer(ctx, module.Count, syncInterval, fallbackInterval, module.Scope, eg)
 		}
 	}
 }
+
+// spawnWebhookDispatcher starts delivering the queued webhook events if the
+// store supports webhooks.
+func spawnWebhookDispatcher(ctx context.Context, store storage, cfg WebhookConfig, eg *errgroup.Group) {
+	webhookStore, ok := store.(webhook.Store)
+	if !ok {
+		return
+	}
+
+	client := &http.Client{Timeout: cfg.Timeout}
+	webhook.NewDispatcher(webhookStore, client, time.Now, cfg.MaxAttempts, cfg.Backoff).
+		Run(ctx, cfg.DispatchInterval, eg)
+}
@@ -0,0 +1,85 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const auditActionRetrySync = "retry_sync"
+
+// ListAuditEvents returns the audit events matching the filter, newest first.
+func (svc *Service) ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error) {
+	auditStore, ok := svc.store.(audit.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("audit log is not supported by the store")
+	}
+
+	var err error
+	if filter.Project == "" && filter.URN != "" {
+		err = svc.authorizeURN(ctx, filter.URN, rbac.PermissionRead)
+	} else {
+		err = svc.authorize(ctx, filter.Project, rbac.PermissionRead)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	events, err := auditStore.ListAuditEvents(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return events, nil
+}
+
+// recordAudit appends an event to the audit log if the store keeps one.
+// Failing to record is logged and does not fail the mutation, which has
+// already been applied at this point.
+func (svc *Service) recordAudit(ctx context.Context, res resource.Resource, userID, action string, params json.RawMessage, err error) {
+	auditStore, ok := svc.store.(audit.Store)
+	if !ok {
+		return
+	}
+
+	actor := audit.ActorFrom(ctx)
+	if userID == "" {
+		userID = actor.UserID
+	}
+
+	event := audit.Event{
+		Timestamp: svc.clock(),
+		Project:   res.Project,
+		URN:       res.URN,
+		UserID:    userID,
+		RequestID: actor.RequestID,
+		Action:    action,
+		Params:    params,
+		Result:    audit.ResultSuccess,
+	}
+	if err != nil {
+		event.Result = audit.ResultFailure
+		event.Error = err.Error()
+	}
+
+	if appendErr := auditStore.AppendAuditEvent(ctx, event); appendErr != nil {
+		zap.L().Warn("failed to record audit event",
+			zap.String("resource_urn", res.URN),
+			zap.String("action", action),
+			zap.Error(appendErr),
+		)
+	}
+}
+
+func retryParams(fromStep string) json.RawMessage {
+	if fromStep == "" {
+		return nil
+	}
+	params, _ := json.Marshal(map[string]string{"from_step": fromStep})
+	return params
+}
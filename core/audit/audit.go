@@ -0,0 +1,73 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+)
+
+const (
+	ResultSuccess = "success"
+	ResultFailure = "failure"
+)
+
+// Store is implemented by storage backends that keep an append-only log of
+// audit events. Services record events only if their store implements it.
+// Stores assign the ID and fill in the timestamp if it is not set.
+type Store interface {
+	AppendAuditEvent(ctx context.Context, event Event) error
+	ListAuditEvents(ctx context.Context, filter Filter) ([]Event, error)
+}
+
+// Event is a single mutation of a resource or module.
+type Event struct {
+	ID        int64           `json:"id"`
+	Timestamp time.Time       `json:"timestamp"`
+	Project   string          `json:"project"`
+	URN       string          `json:"urn"`
+	UserID    string          `json:"user_id"`
+	RequestID string          `json:"request_id"`
+	Action    string          `json:"action"`
+	Params    json.RawMessage `json:"params,omitempty"`
+	Result    string          `json:"result"`
+	Error     string          `json:"error,omitempty"`
+}
+
+// Filter selects audit events. Zero values match everything. Events are
+// returned newest first.
+type Filter struct {
+	Project string    `json:"project"`
+	URN     string    `json:"urn"`
+	UserID  string    `json:"user_id"`
+	Since   time.Time `json:"since"`
+	Until   time.Time `json:"until"`
+	Limit   int       `json:"limit"`
+}
+
+// Matches returns true if the event is selected by the filter.
+func (f Filter) Matches(e Event) bool {
+	return (f.Project == "" || f.Project == e.Project) &&
+		(f.URN == "" || f.URN == e.URN) &&
+		(f.UserID == "" || f.UserID == e.UserID) &&
+		(f.Since.IsZero() || !e.Timestamp.Before(f.Since)) &&
+		(f.Until.IsZero() || e.Timestamp.Before(f.Until))
+}
+
+// Actor identifies who triggered a mutation.
+type Actor struct {
+	UserID    string
+	RequestID string
+}
+
+type actorKey struct{}
+
+// WithActor returns a copy of ctx carrying the actor.
+func WithActor(ctx context.Context, actor Actor) context.Context {
+	return context.WithValue(ctx, actorKey{}, actor)
+}
+
+// ActorFrom returns the actor carried by ctx, if any.
+func ActorFrom(ctx context.Context) Actor {
+	actor, _ := ctx.Value(actorKey{}).(Actor)
+	return actor
+}
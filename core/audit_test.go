@@ -0,0 +1,103 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_AuditLog(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			if act.Name == "scale" {
+				return nil, errors.ErrInvalid.WithMsgf("replicas must be positive")
+			}
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	ctx := audit.WithActor(context.Background(), audit.Actor{UserID: "john", RequestID: "req-1"})
+	_, err = svc.CreateResource(ctx, resource.Resource{
+		Kind:      "mock",
+		Name:      "child",
+		Project:   "project",
+		CreatedBy: "john",
+		Spec:      resource.Spec{Configs: []byte(`{"replicas":1}`)},
+	})
+	require.NoError(t, err)
+
+	// dry-runs are not recorded.
+	_, err = svc.UpdateResource(ctx, urn, resource.UpdateRequest{
+		Spec: resource.Spec{Configs: []byte(`{"replicas":2}`)},
+	}, core.WithDryRun(true))
+	require.NoError(t, err)
+
+	ctx = audit.WithActor(context.Background(), audit.Actor{UserID: "jane", RequestID: "req-2"})
+	_, err = svc.ApplyAction(ctx, urn, module.ActionRequest{
+		Name:   "scale",
+		Params: json.RawMessage(`{"replicas":-1}`),
+	})
+	require.Error(t, err)
+
+	events, err := svc.ListAuditEvents(ctx, audit.Filter{URN: urn})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+
+	assert.Equal(t, audit.Event{
+		ID:        2,
+		Timestamp: frozenTime,
+		Project:   "project",
+		URN:       urn,
+		UserID:    "jane",
+		RequestID: "req-2",
+		Action:    "scale",
+		Params:    json.RawMessage(`{"replicas":-1}`),
+		Result:    audit.ResultFailure,
+		Error:     "bad_request: replicas must be positive",
+	}, events[0])
+	assert.Equal(t, audit.Event{
+		ID:        1,
+		Timestamp: frozenTime,
+		Project:   "project",
+		URN:       urn,
+		UserID:    "john",
+		RequestID: "req-1",
+		Action:    module.CreateAction,
+		Params:    json.RawMessage(`{"replicas":1}`),
+		Result:    audit.ResultSuccess,
+	}, events[1])
+
+	byUser, err := svc.ListAuditEvents(ctx, audit.Filter{UserID: "john", Until: frozenTime.Add(time.Second)})
+	require.NoError(t, err)
+	require.Len(t, byUser, 1)
+	assert.Equal(t, module.CreateAction, byUser[0].Action)
+}
@@ -0,0 +1,136 @@
+package core
+
+import (
+	"context"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	defaultBulkConcurrency = 4
+	maxBulkConcurrency     = 32
+	bulkListPageSize       = 100
+)
+
+// BulkActionRequest applies an action on every resource matching the
+// project, kind and labels of the selector.
+type BulkActionRequest struct {
+	Selector resource.Filter
+	Action   module.ActionRequest
+
+	// Concurrency is the number of actions applied in parallel.
+	Concurrency int
+
+	// BatchSize if set, splits the resources into batches that are applied
+	// one after another, each starting once the actions of the previous one
+	// are applied. The syncs started by the actions are not waited for.
+	BatchSize int
+
+	DryRun bool
+}
+
+// BulkActionResult is the outcome of the action on a single resource. Plan
+// is set for dry-runs only.
+type BulkActionResult struct {
+	URN      string
+	Resource *resource.Resource
+	Plan     *Plan
+	Err      error
+}
+
+// BulkApplyAction applies the action on all the resources selected by the
+// request and returns a result per resource, in the order the resources
+// were listed. Failing on a resource does not stop the others.
+func (svc *Service) BulkApplyAction(ctx context.Context, req BulkActionRequest) ([]BulkActionResult, error) {
+	if req.Selector.Project == "" {
+		return nil, errors.ErrInvalid.WithMsgf("project must be set for bulk actions")
+	} else if req.Action.Name == "" {
+		return nil, errors.ErrInvalid.WithMsgf("action must be set")
+	} else if req.Concurrency < 0 || req.Concurrency > maxBulkConcurrency {
+		return nil, errors.ErrInvalid.WithMsgf("concurrency must be between 1 and %d", maxBulkConcurrency)
+	} else if req.BatchSize < 0 {
+		return nil, errors.ErrInvalid.WithMsgf("batch size cannot be negative")
+	}
+
+	urns, err := svc.selectURNs(ctx, req.Selector)
+	if err != nil {
+		return nil, err
+	}
+
+	concurrency := req.Concurrency
+	if concurrency == 0 {
+		concurrency = defaultBulkConcurrency
+	}
+	batchSize := req.BatchSize
+	if batchSize == 0 {
+		batchSize = len(urns)
+	}
+
+	results := make([]BulkActionResult, len(urns))
+	for start := 0; start < len(urns); start += batchSize {
+		end := min(start+batchSize, len(urns))
+
+		eg := &errgroup.Group{}
+		eg.SetLimit(concurrency)
+		for i := start; i < end; i++ {
+			i := i
+			eg.Go(func() error {
+				results[i] = svc.bulkApplyOne(ctx, urns[i], req)
+				return nil
+			})
+		}
+		_ = eg.Wait()
+	}
+	return results, nil
+}
+
+func (svc *Service) bulkApplyOne(ctx context.Context, urn string, req BulkActionRequest) BulkActionResult {
+	result := BulkActionResult{URN: urn}
+	if err := ctx.Err(); err != nil {
+		result.Err = err
+		return result
+	}
+
+	opt := WithDryRun(false)
+	if req.DryRun {
+		result.Plan = &Plan{}
+		opt = WithPlan(result.Plan)
+	}
+
+	result.Resource, result.Err = svc.ApplyAction(ctx, urn, req.Action, opt)
+	if result.Err != nil {
+		result.Plan = nil
+	}
+	return result
+}
+
+// selectURNs lists the URNs of all the resources matching the selector.
+func (svc *Service) selectURNs(ctx context.Context, selector resource.Filter) ([]string, error) {
+	filter := resource.Filter{
+		Project:  selector.Project,
+		Kind:     selector.Kind,
+		Labels:   selector.Labels,
+		PageSize: bulkListPageSize,
+	}
+
+	var urns []string
+	for {
+		page, err := svc.ListResources(ctx, filter, false)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, res := range page.Resources {
+			urns = append(urns, res.URN)
+		}
+
+		if page.NextPageToken == "" {
+			return urns, nil
+		}
+		filter.PageToken = page.NextPageToken
+	}
+}
@@ -0,0 +1,146 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_BulkApplyAction(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	var mu sync.Mutex
+	var applied []string
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			mu.Lock()
+			applied = append(applied, res.URN)
+			mu.Unlock()
+
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{Status: resource.StatusPending}
+			return &planned, nil
+		})
+	mod.EXPECT().
+		DescribeEffects(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).Maybe()
+
+	ctx := context.Background()
+	newResource := func(name, team, status string) resource.Resource {
+		return resource.Resource{
+			URN:     "orn:entropy:mock:foo:" + name,
+			Kind:    "mock",
+			Name:    name,
+			Project: "foo",
+			Labels:  map[string]string{"team": team},
+			Spec:    resource.Spec{Configs: []byte(`{"stopped":false}`)},
+			State:   resource.State{Status: status},
+		}
+	}
+	for _, res := range []resource.Resource{
+		newResource("a", "payments", resource.StatusCompleted),
+		newResource("b", "search", resource.StatusCompleted),
+		newResource("c", "payments", resource.StatusPending),
+		newResource("d", "payments", resource.StatusCompleted),
+		newResource("e", "payments", resource.StatusError),
+	} {
+		require.NoError(t, store.Create(ctx, res))
+	}
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	req := core.BulkActionRequest{
+		Selector: resource.Filter{
+			Project: "foo",
+			Kind:    "mock",
+			Labels:  map[string]string{"team": "payments"},
+		},
+		Action:      module.ActionRequest{Name: "stop", Params: json.RawMessage(`{"stopped":true}`)},
+		Concurrency: 2,
+		BatchSize:   2,
+	}
+
+	t.Run("DryRun", func(t *testing.T) {
+		req := req
+		req.DryRun = true
+
+		results, err := svc.BulkApplyAction(ctx, req)
+		require.NoError(t, err)
+		require.Len(t, results, 4)
+
+		for _, result := range results {
+			if result.URN == "orn:entropy:mock:foo:c" {
+				assert.ErrorIs(t, result.Err, errors.ErrInvalid)
+				continue
+			}
+			require.NoError(t, result.Err)
+			require.NotNil(t, result.Plan)
+
+			stored, err := store.GetByURN(ctx, result.URN)
+			require.NoError(t, err)
+			assert.True(t, stored.State.IsTerminal())
+			assert.JSONEq(t, `{"stopped":false}`, string(stored.Spec.Configs))
+		}
+	})
+
+	mu.Lock()
+	applied = nil
+	mu.Unlock()
+
+	results, err := svc.BulkApplyAction(ctx, req)
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+
+	var urns []string
+	for _, result := range results {
+		urns = append(urns, result.URN)
+	}
+	assert.Equal(t, []string{
+		"orn:entropy:mock:foo:a",
+		"orn:entropy:mock:foo:c",
+		"orn:entropy:mock:foo:d",
+		"orn:entropy:mock:foo:e",
+	}, urns)
+
+	// the resource still being synced fails, the others are stopped.
+	assert.ErrorIs(t, results[1].Err, errors.ErrInvalid)
+	assert.Nil(t, results[1].Resource)
+	for _, i := range []int{0, 2, 3} {
+		require.NoError(t, results[i].Err)
+		assert.Nil(t, results[i].Plan)
+		assert.JSONEq(t, `{"stopped":true}`, string(results[i].Resource.Spec.Configs))
+	}
+	assert.ElementsMatch(t, []string{urns[0], urns[2], urns[3]}, applied)
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := svc.BulkApplyAction(ctx, core.BulkActionRequest{Action: req.Action})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		req := req
+		req.Concurrency = 100
+		_, err = svc.BulkApplyAction(ctx, req)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+}
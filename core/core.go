@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/rbac"
 	"github.com/goto/entropy/core/resource"
 	"github.com/goto/entropy/pkg/errors"
 )
@@ -19,28 +21,43 @@ type Service struct {
 	syncBackoff    time.Duration
 	maxSyncRetries int
 	serviceName    string
+	quotas         *quota.Config
+	authorizer     *rbac.Authorizer
+
+	idempotencyWindow time.Duration
 }
 
+// ServiceOption configures optional behaviour of the Service.
+type ServiceOption func(svc *Service)
+
 type ModuleService interface {
 	PlanAction(ctx context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error)
 	SyncState(ctx context.Context, res module.ExpandedResource) (*resource.State, error)
 	StreamLogs(ctx context.Context, res module.ExpandedResource, filter map[string]string) (<-chan module.LogChunk, error)
 	GetOutput(ctx context.Context, res module.ExpandedResource) (json.RawMessage, error)
+	GetDependencyChangePolicy(ctx context.Context, res module.ExpandedResource) (*module.DependencyChangePolicy, error)
+	DescribeEffects(ctx context.Context, cur module.ExpandedResource, planned resource.Resource) ([]module.Effect, error)
 }
 
-func New(repo resource.Store, moduleSvc ModuleService, clockFn func() time.Time, syncBackoffInterval time.Duration, maxRetries int, serviceName string) *Service {
+func New(repo resource.Store, moduleSvc ModuleService, clockFn func() time.Time, syncBackoffInterval time.Duration, maxRetries int, serviceName string, opts ...ServiceOption) *Service {
 	if clockFn == nil {
 		clockFn = time.Now
 	}
 
-	return &Service{
+	svc := &Service{
 		clock:          clockFn,
 		store:          repo,
 		syncBackoff:    syncBackoffInterval,
 		maxSyncRetries: maxRetries,
 		moduleSvc:      moduleSvc,
 		serviceName:    serviceName,
+
+		idempotencyWindow: defaultIdempotencyWindow,
 	}
+	for _, opt := range opts {
+		opt(svc)
+	}
+	return svc
 }
 
 func (svc *Service) generateModuleSpec(ctx context.Context, res resource.Resource) (*module.ExpandedResource, error) {
@@ -57,7 +74,7 @@ func (svc *Service) generateModuleSpec(ctx context.Context, res resource.Resourc
 					WithMsgf("dependency '%s' not found", resURN)
 			}
 			return nil, err
-		} else if d.State.Status != resource.StatusCompleted {
+		} else if d.State.Status != resource.StatusCompleted && !d.State.DeleteDeferred {
 			return nil, errors.ErrInvalid.
 				WithMsgf("dependency '%s' is in incomplete state (%s)", resURN, d.State.Status)
 		} else if d.Project != res.Project {
@@ -72,5 +89,24 @@ func (svc *Service) generateModuleSpec(ctx context.Context, res resource.Resourc
 		}
 	}
 
+	for key, resURN := range res.State.PreviousDependencies {
+		d, err := svc.GetResource(ctx, resURN)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				// nothing is left to clean up on a deleted dependency.
+				continue
+			}
+			return nil, err
+		}
+
+		if modSpec.PreviousDependencies == nil {
+			modSpec.PreviousDependencies = map[string]module.ResolvedDependency{}
+		}
+		modSpec.PreviousDependencies[key] = module.ResolvedDependency{
+			Kind:   d.Kind,
+			Output: d.State.Output,
+		}
+	}
+
 	return &modSpec, nil
 }
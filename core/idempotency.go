@@ -0,0 +1,172 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/idempotency"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	defaultIdempotencyWindow = 24 * time.Hour
+
+	// abandonedRequestTimeout is how long a request may stay pending before
+	// a retry with its key is allowed to take over (e.g., the server died
+	// while handling it).
+	abandonedRequestTimeout = 5 * time.Minute
+)
+
+// WithIdempotencyWindow sets how long the results of the requests made with
+// an idempotency key are kept for their retries.
+func WithIdempotencyWindow(window time.Duration) ServiceOption {
+	return func(svc *Service) {
+		svc.idempotencyWindow = window
+	}
+}
+
+// WithIdempotencyKey makes retries of the mutation with the same key return
+// the result of the first one instead of executing it again.
+func WithIdempotencyKey(key string) Options {
+	return Options{IdempotencyKey: key}
+}
+
+// idempotentResponse is the result of a request kept for its retries.
+type idempotentResponse struct {
+	Resource  *resource.Resource   `json:"resource"`
+	Operation *operation.Operation `json:"operation,omitempty"`
+}
+
+// idempotent runs exec unless a request with the same key already ran, in
+// which case its result is returned. Reusing a key for another request, or
+// while the first one is still running, fails with ErrConflict. Failed
+// requests are not remembered so that they can be retried.
+func (svc *Service) idempotent(ctx context.Context, opts Options, operationName string, request any, exec func(opts Options) (*resource.Resource, error)) (*resource.Resource, error) {
+	key := opts.IdempotencyKey
+	if key == "" || opts.DryRun {
+		return exec(opts)
+	}
+
+	recordStore, ok := svc.store.(idempotency.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("idempotency keys are not supported by the store")
+	}
+
+	// the caller is part of the request, a key cannot be used to replay
+	// the result of someone else's request.
+	hash, err := idempotency.Hash(struct {
+		Operation string `json:"operation"`
+		UserID    string `json:"user_id"`
+		Request   any    `json:"request"`
+	}{operationName, audit.ActorFrom(ctx).UserID, request})
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	now := svc.clock()
+	rec := idempotency.Record{
+		Key:         key,
+		Operation:   operationName,
+		RequestHash: hash,
+		Status:      idempotency.StatusPending,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(svc.idempotencyWindow),
+	}
+
+	if err := svc.claimIdempotencyKey(ctx, recordStore, rec); err != nil {
+		if !errors.Is(err, errors.ErrConflict) {
+			return nil, err
+		}
+
+		existing, getErr := recordStore.GetIdempotencyRecord(ctx, key)
+		if getErr != nil {
+			return nil, errors.ErrInternal.WithCausef("%s", getErr.Error())
+		}
+		return svc.replayIdempotent(ctx, *existing, rec, opts.Operation)
+	}
+
+	// the operation is kept along with the response so that the retries
+	// can report it too.
+	if opts.Operation == nil {
+		opts.Operation = &operation.Operation{}
+	}
+
+	res, err := exec(opts)
+	if err != nil {
+		if delErr := recordStore.DeleteIdempotencyRecord(ctx, key); delErr != nil {
+			zap.L().Warn("failed to release idempotency key", zap.String("key", key), zap.Error(delErr))
+		}
+		return nil, err
+	}
+
+	resp := idempotentResponse{Resource: res}
+	if opts.Operation.ID != 0 {
+		resp.Operation = opts.Operation
+	}
+
+	response, err := json.Marshal(resp)
+	if err == nil {
+		err = recordStore.CompleteIdempotencyRecord(ctx, key, response)
+	}
+	if err != nil {
+		// the request went through, failing it now would only make the
+		// client retry it.
+		zap.L().Warn("failed to record idempotent response", zap.String("key", key), zap.Error(err))
+	}
+	return res, nil
+}
+
+// claimIdempotencyKey stores the pending record for the key, taking over
+// the record of an abandoned request.
+func (svc *Service) claimIdempotencyKey(ctx context.Context, recordStore idempotency.Store, rec idempotency.Record) error {
+	err := recordStore.CreateIdempotencyRecord(ctx, rec)
+	if err == nil {
+		return nil
+	} else if !errors.Is(err, errors.ErrConflict) {
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	existing, getErr := recordStore.GetIdempotencyRecord(ctx, rec.Key)
+	if getErr != nil || existing.Status != idempotency.StatusPending ||
+		existing.RequestHash != rec.RequestHash || rec.CreatedAt.Sub(existing.CreatedAt) < abandonedRequestTimeout {
+		return err
+	}
+
+	if err := recordStore.DeleteIdempotencyRecord(ctx, rec.Key); err != nil && !errors.Is(err, errors.ErrNotFound) {
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return recordStore.CreateIdempotencyRecord(ctx, rec)
+}
+
+// replayIdempotent returns the result of the request recorded in existing.
+// op if set, receives the current state of the operation of the request.
+func (svc *Service) replayIdempotent(ctx context.Context, existing, rec idempotency.Record, op *operation.Operation) (*resource.Resource, error) {
+	if existing.Operation != rec.Operation || existing.RequestHash != rec.RequestHash {
+		return nil, errors.ErrConflict.
+			WithMsgf("idempotency key '%s' was already used for a different request", rec.Key)
+	} else if existing.Status != idempotency.StatusCompleted {
+		return nil, errors.ErrConflict.
+			WithMsgf("a request with idempotency key '%s' is still in progress", rec.Key)
+	}
+
+	var resp idempotentResponse
+	if err := json.Unmarshal(existing.Response, &resp); err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	if op != nil && resp.Operation != nil {
+		*op = *resp.Operation
+		if opStore, ok := svc.store.(operation.Store); ok {
+			if current, err := opStore.GetOperation(ctx, resp.Operation.ID); err == nil {
+				*op = *current
+			}
+		}
+	}
+	return resp.Resource, nil
+}
@@ -0,0 +1,53 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusCompleted = "completed"
+)
+
+// Store is implemented by storage backends that can keep idempotency
+// records. Idempotency keys are accepted only if the store implements it.
+type Store interface {
+	// CreateIdempotencyRecord stores the record, replacing an existing
+	// record for the key only if it expired before r.CreatedAt. Returns
+	// ErrConflict if a record for the key is still live.
+	CreateIdempotencyRecord(ctx context.Context, r Record) error
+	GetIdempotencyRecord(ctx context.Context, key string) (*Record, error)
+
+	// CompleteIdempotencyRecord marks the record of the key completed with
+	// the response of the request.
+	CompleteIdempotencyRecord(ctx context.Context, key string, response json.RawMessage) error
+	DeleteIdempotencyRecord(ctx context.Context, key string) error
+}
+
+// Record remembers a request made with an idempotency key, and once it
+// completed, its response. A record is pending while the request runs.
+type Record struct {
+	Key         string          `json:"key"`
+	Operation   string          `json:"operation"`
+	RequestHash string          `json:"request_hash"`
+	Status      string          `json:"status"`
+	Response    json.RawMessage `json:"response,omitempty"`
+	CreatedAt   time.Time       `json:"created_at"`
+	ExpiresAt   time.Time       `json:"expires_at"`
+}
+
+// Hash returns a digest of the request, for telling apart retries of a
+// request from other requests reusing its key.
+func Hash(request any) (string, error) {
+	data, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:]), nil
+}
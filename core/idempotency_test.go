@@ -0,0 +1,148 @@
+package core_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_Idempotency(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	var planned atomic.Int32
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned.Add(1)
+			if act.Name == "fail" {
+				return nil, errors.ErrInvalid.WithMsgf("action failed")
+			}
+
+			res.Resource.Spec.Configs = act.Params
+			res.Resource.State = resource.State{Status: resource.StatusCompleted}
+			return &res.Resource, nil
+		})
+
+	now := frozenTime
+	clock := func() time.Time { return now }
+	svc := core.New(store, mod, clock, defaultSyncBackoff, defaultMaxRetries, serviceName, core.WithIdempotencyWindow(time.Hour))
+
+	ctx := audit.WithActor(context.Background(), audit.Actor{UserID: "john"})
+	orders := resource.Resource{
+		Kind:    "firehose",
+		Name:    "orders",
+		Project: "production",
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":1}`)},
+	}
+
+	created, err := svc.CreateResource(ctx, orders, core.WithIdempotencyKey("create-orders"))
+	require.NoError(t, err)
+
+	t.Run("Retry", func(t *testing.T) {
+		before := planned.Load()
+		retried, err := svc.CreateResource(ctx, orders, core.WithIdempotencyKey("create-orders"))
+		require.NoError(t, err)
+		assert.Equal(t, created.URN, retried.URN)
+		assert.Equal(t, created.Version, retried.Version)
+		assert.Equal(t, before, planned.Load())
+
+		// without the key, the retry conflicts with the created resource.
+		_, err = svc.CreateResource(ctx, orders)
+		assert.ErrorIs(t, err, errors.ErrConflict)
+	})
+
+	t.Run("DifferentRequest", func(t *testing.T) {
+		other := orders
+		other.Name = "payments"
+		_, err := svc.CreateResource(ctx, other, core.WithIdempotencyKey("create-orders"))
+		assert.ErrorIs(t, err, errors.ErrConflict)
+		assert.Contains(t, err.Error(), "was already used for a different request")
+
+		// nor can another user replay the response.
+		jane := audit.WithActor(context.Background(), audit.Actor{UserID: "jane"})
+		_, err = svc.CreateResource(jane, orders, core.WithIdempotencyKey("create-orders"))
+		assert.ErrorIs(t, err, errors.ErrConflict)
+	})
+
+	t.Run("Action", func(t *testing.T) {
+		scale := module.ActionRequest{Name: "scale", Params: []byte(`{"replicas":2}`)}
+		var firstOp operation.Operation
+		first, err := svc.ApplyAction(ctx, created.URN, scale, core.WithIdempotencyKey("scale-orders"), core.WithOperation(&firstOp))
+		require.NoError(t, err)
+		require.NotZero(t, firstOp.ID)
+
+		before := planned.Load()
+		var secondOp operation.Operation
+		second, err := svc.ApplyAction(ctx, created.URN, scale, core.WithIdempotencyKey("scale-orders"), core.WithOperation(&secondOp))
+		require.NoError(t, err)
+		assert.Equal(t, first.Version, second.Version)
+		assert.Equal(t, before, planned.Load())
+
+		// retries report the operation of the first request.
+		assert.Equal(t, firstOp, secondOp)
+	})
+
+	t.Run("FailedRequestsAreNotKept", func(t *testing.T) {
+		fail := module.ActionRequest{Name: "fail"}
+		_, err := svc.ApplyAction(ctx, created.URN, fail, core.WithIdempotencyKey("fail-orders"))
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		before := planned.Load()
+		_, err = svc.ApplyAction(ctx, created.URN, fail, core.WithIdempotencyKey("fail-orders"))
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+		assert.Equal(t, before+1, planned.Load())
+	})
+
+	t.Run("DryRun", func(t *testing.T) {
+		before := planned.Load()
+		scale := module.ActionRequest{Name: "scale", Params: []byte(`{"replicas":3}`)}
+		_, err := svc.ApplyAction(ctx, created.URN, scale, core.WithDryRun(true), core.WithIdempotencyKey("dry-run"))
+		require.NoError(t, err)
+		assert.Equal(t, before+1, planned.Load())
+
+		_, err = store.GetIdempotencyRecord(ctx, "dry-run")
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, svc.DeleteResource(ctx, created.URN, core.WithIdempotencyKey("delete-orders")))
+		assert.NoError(t, svc.DeleteResource(ctx, created.URN, core.WithIdempotencyKey("delete-orders")))
+	})
+
+	t.Run("WindowExpired", func(t *testing.T) {
+		now = now.Add(2 * time.Hour)
+
+		// the key is free again, the request is executed anew and finds
+		// the resource that is still being deleted.
+		_, err := svc.CreateResource(ctx, orders, core.WithIdempotencyKey("create-orders"))
+		assert.ErrorIs(t, err, errors.ErrConflict)
+		assert.Contains(t, err.Error(), "already exists")
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.CreateResource(ctx, orders, core.WithIdempotencyKey("create-orders"))
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+}
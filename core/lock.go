@@ -0,0 +1,187 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/lock"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	auditActionLock       = "lock"
+	auditActionUnlock     = "unlock"
+	auditActionBreakGlass = "break_glass"
+)
+
+// CreateLock places a lock blocking changes to the resources it covers. A
+// lock on a single resource gets the project of the resource.
+func (svc *Service) CreateLock(ctx context.Context, l lock.Lock) (*lock.Lock, error) {
+	lockStore, err := svc.lockStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if l.URN != "" {
+		res, err := svc.store.GetByURN(ctx, l.URN)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				return nil, errors.ErrNotFound.WithMsgf("resource with urn '%s' not found", l.URN)
+			}
+			return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+		} else if l.Project != "" && l.Project != res.Project {
+			return nil, errors.ErrInvalid.WithMsgf("resource '%s' does not belong to project '%s'", l.URN, l.Project)
+		}
+		l.Project = res.Project
+	}
+
+	now := svc.clock()
+	if err := l.Validate(now); err != nil {
+		return nil, err
+	} else if err := svc.authorize(ctx, l.Project, rbac.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	if l.Owner == "" {
+		l.Owner = l.CreatedBy
+	}
+	l.CreatedAt = now
+
+	created, err := lockStore.CreateLock(ctx, l)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	svc.recordAudit(ctx, lockAuditTarget(*created), created.CreatedBy, auditActionLock, lockParams(*created), nil)
+	return created, nil
+}
+
+// ListLocks returns the locks of the project. Expired locks are left out
+// unless includeExpired is set.
+func (svc *Service) ListLocks(ctx context.Context, project string, includeExpired bool) ([]lock.Lock, error) {
+	lockStore, err := svc.lockStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.authorize(ctx, project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	locks, err := lockStore.ListLocks(ctx, project)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	if includeExpired {
+		return locks, nil
+	}
+
+	now := svc.clock()
+	active := make([]lock.Lock, 0, len(locks))
+	for _, l := range locks {
+		if l.IsActive(now) {
+			active = append(active, l)
+		}
+	}
+	return active, nil
+}
+
+// DeleteLock releases the lock.
+func (svc *Service) DeleteLock(ctx context.Context, id int64, userID string) error {
+	lockStore, err := svc.lockStore()
+	if err != nil {
+		return err
+	}
+
+	target, err := lockStore.GetLock(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return errors.ErrNotFound.WithMsgf("lock with id '%d' not found", id)
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, target.Project, rbac.PermissionManage); err != nil {
+		return err
+	}
+
+	if err := lockStore.DeleteLock(ctx, id); err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return errors.ErrNotFound.WithMsgf("lock with id '%d' not found", id)
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	svc.recordAudit(ctx, lockAuditTarget(*target), userID, auditActionUnlock, lockParams(*target), nil)
+	return nil
+}
+
+// checkLocks rejects a mutation of the resource if an active lock covers
+// it, unless the mutation is a break-glass one.
+func (svc *Service) checkLocks(ctx context.Context, res resource.Resource, actionName string, breakGlass bool) ([]lock.Lock, error) {
+	lockStore, ok := svc.store.(lock.Store)
+	if !ok {
+		return nil, nil
+	}
+
+	locks, err := lockStore.ListLocks(ctx, res.Project)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	now := svc.clock()
+	var held []lock.Lock
+	for _, l := range locks {
+		if l.IsActive(now) && l.Matches(res) {
+			held = append(held, l)
+		}
+	}
+
+	if len(held) == 0 || breakGlass {
+		return held, nil
+	}
+	return held, errors.ErrInvalid.
+		WithMsgf("cannot perform '%s' on resource '%s': locked by %s (%s)", actionName, res.URN, held[0].Owner, held[0].Reason)
+}
+
+// recordBreakGlass records a mutation that went through despite locks.
+func (svc *Service) recordBreakGlass(ctx context.Context, res resource.Resource, actionName, userID string, held []lock.Lock) {
+	lockIDs := make([]string, 0, len(held))
+	for _, l := range held {
+		lockIDs = append(lockIDs, strconv.FormatInt(l.ID, 10))
+	}
+
+	zap.L().Warn("mutation on locked resource with break-glass",
+		zap.String("resource_urn", res.URN),
+		zap.String("action", actionName),
+		zap.String("lock_ids", strings.Join(lockIDs, ",")),
+	)
+
+	params, _ := json.Marshal(map[string]any{
+		"action":   actionName,
+		"lock_ids": lockIDs,
+	})
+	svc.recordAudit(ctx, res, userID, auditActionBreakGlass, params, nil)
+}
+
+func (svc *Service) lockStore() (lock.Store, error) {
+	lockStore, ok := svc.store.(lock.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("locks are not supported by the store")
+	}
+	return lockStore, nil
+}
+
+func lockAuditTarget(l lock.Lock) resource.Resource {
+	return resource.Resource{Project: l.Project, URN: l.URN}
+}
+
+func lockParams(l lock.Lock) json.RawMessage {
+	params, _ := json.Marshal(l)
+	return params
+}
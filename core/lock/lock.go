@@ -0,0 +1,91 @@
+package lock
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	ScopeResource = "resource"
+	ScopeSelector = "selector"
+	ScopeProject  = "project"
+)
+
+// Store is implemented by storage backends that can keep locks. Mutations
+// are checked against locks only if the store implements it.
+type Store interface {
+	// CreateLock stores the lock and returns it with the ID assigned.
+	CreateLock(ctx context.Context, l Lock) (*Lock, error)
+	GetLock(ctx context.Context, id int64) (*Lock, error)
+
+	// ListLocks returns the locks of the project (of all projects if
+	// project is empty), including the expired ones.
+	ListLocks(ctx context.Context, project string) ([]Lock, error)
+
+	DeleteLock(ctx context.Context, id int64) error
+}
+
+// Lock blocks changes to a single resource (URN), to the resources of the
+// project carrying all of the Labels, or to the whole project if neither
+// is set. A lock without ExpiresAt holds until it is deleted.
+type Lock struct {
+	ID        int64             `json:"id"`
+	Project   string            `json:"project"`
+	URN       string            `json:"urn,omitempty"`
+	Labels    map[string]string `json:"labels,omitempty"`
+	Reason    string            `json:"reason"`
+	Owner     string            `json:"owner"`
+	ExpiresAt *time.Time        `json:"expires_at,omitempty"`
+	CreatedAt time.Time         `json:"created_at"`
+	CreatedBy string            `json:"created_by"`
+}
+
+func (l Lock) Validate(now time.Time) error {
+	if l.Project == "" {
+		return errors.ErrInvalid.WithMsgf("project must be set")
+	} else if l.Reason == "" {
+		return errors.ErrInvalid.WithMsgf("reason must be set")
+	} else if l.URN != "" && len(l.Labels) > 0 {
+		return errors.ErrInvalid.WithMsgf("only one of urn and labels can be set")
+	} else if l.ExpiresAt != nil && !l.ExpiresAt.After(now) {
+		return errors.ErrInvalid.WithMsgf("expiry must be in the future")
+	}
+	return nil
+}
+
+// Scope returns what the lock applies to: a resource, a label selector or
+// a project.
+func (l Lock) Scope() string {
+	switch {
+	case l.URN != "":
+		return ScopeResource
+	case len(l.Labels) > 0:
+		return ScopeSelector
+	default:
+		return ScopeProject
+	}
+}
+
+// IsActive returns true if the lock has not expired at t.
+func (l Lock) IsActive(t time.Time) bool {
+	return l.ExpiresAt == nil || l.ExpiresAt.After(t)
+}
+
+// Matches returns true if the resource is covered by the lock.
+func (l Lock) Matches(res resource.Resource) bool {
+	if l.Project != res.Project {
+		return false
+	} else if l.URN != "" {
+		return l.URN == res.URN
+	}
+
+	for k, v := range l.Labels {
+		if res.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
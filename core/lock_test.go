@@ -0,0 +1,165 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/lock"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_Locks(t *testing.T) {
+	t.Parallel()
+
+	const (
+		prodURN    = "orn:entropy:mock:project:prod"
+		stagingURN = "orn:entropy:mock:project:staging"
+	)
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, _ module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+
+	now := frozenTime
+	clock := func() time.Time { return now }
+	svc := core.New(store, mod, clock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	ctx := context.Background()
+	for urn, env := range map[string]string{prodURN: "production", stagingURN: "staging"} {
+		require.NoError(t, store.Create(ctx, resource.Resource{
+			URN:     urn,
+			Kind:    "mock",
+			Name:    env,
+			Project: "project",
+			Labels:  map[string]string{"env": env},
+			State:   resource.State{Status: resource.StatusCompleted},
+		}))
+	}
+
+	apply := func(urn string, opts ...core.Options) error {
+		_, err := svc.ApplyAction(ctx, urn, module.ActionRequest{Name: "restart", UserID: "jane"}, opts...)
+		return err
+	}
+
+	expiresAt := now.Add(time.Hour)
+	freeze, err := svc.CreateLock(ctx, lock.Lock{
+		Project:   "project",
+		Labels:    map[string]string{"env": "production"},
+		Reason:    "change freeze",
+		ExpiresAt: &expiresAt,
+		CreatedBy: "john",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "john", freeze.Owner)
+	assert.Equal(t, lock.ScopeSelector, freeze.Scope())
+
+	err = apply(prodURN)
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+	assert.Contains(t, err.Error(), "change freeze")
+	assert.NoError(t, apply(stagingURN))
+
+	_, err = svc.ApplyAction(ctx, prodURN, module.ActionRequest{Name: "restart"}, core.WithDryRun(true))
+	assert.NoError(t, err, "dry-runs are not blocked")
+
+	assert.NoError(t, apply(prodURN, core.WithBreakGlass(true)))
+
+	events, err := svc.ListAuditEvents(ctx, audit.Filter{Project: "project"})
+	require.NoError(t, err)
+	var actions []string
+	for _, ev := range events {
+		actions = append(actions, ev.Action)
+	}
+	assert.Contains(t, actions, "lock")
+	assert.Contains(t, actions, "break_glass")
+
+	t.Run("Expiry", func(t *testing.T) {
+		now = expiresAt
+		defer func() { now = frozenTime }()
+
+		assert.NoError(t, apply(prodURN))
+
+		active, err := svc.ListLocks(ctx, "project", false)
+		require.NoError(t, err)
+		assert.Empty(t, active)
+
+		all, err := svc.ListLocks(ctx, "project", true)
+		require.NoError(t, err)
+		assert.Len(t, all, 1)
+	})
+
+	t.Run("ProjectScope", func(t *testing.T) {
+		projectLock, err := svc.CreateLock(ctx, lock.Lock{
+			Project:   "project",
+			Reason:    "migration",
+			CreatedBy: "john",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, lock.ScopeProject, projectLock.Scope())
+
+		assert.ErrorIs(t, apply(stagingURN), errors.ErrInvalid)
+		assert.ErrorIs(t, svc.DeleteResource(ctx, stagingURN), errors.ErrInvalid)
+
+		require.NoError(t, svc.DeleteLock(ctx, projectLock.ID, "john"))
+		assert.NoError(t, apply(stagingURN))
+
+		err = svc.DeleteLock(ctx, projectLock.ID, "john")
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("ResourceScope", func(t *testing.T) {
+		_, err := svc.CreateLock(ctx, lock.Lock{
+			Project:   "other",
+			URN:       stagingURN,
+			Reason:    "incident",
+			CreatedBy: "john",
+		})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		resLock, err := svc.CreateLock(ctx, lock.Lock{
+			URN:       stagingURN,
+			Reason:    "incident",
+			CreatedBy: "john",
+		})
+		require.NoError(t, err)
+		assert.Equal(t, "project", resLock.Project)
+		assert.ErrorIs(t, apply(stagingURN), errors.ErrInvalid)
+		require.NoError(t, svc.DeleteLock(ctx, resLock.ID, "john"))
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		past := now.Add(-time.Minute)
+		_, err := svc.CreateLock(ctx, lock.Lock{Project: "project", Reason: "late", ExpiresAt: &past})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		_, err = svc.CreateLock(ctx, lock.Lock{Project: "project"})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.ListLocks(ctx, "project", false)
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+}
@@ -27,6 +27,125 @@ func (_m *ModuleService) EXPECT() *ModuleService_Expecter {
 	return &ModuleService_Expecter{mock: &_m.Mock}
 }
 
+// DescribeEffects provides a mock function with given fields: ctx, cur, planned
+func (_m *ModuleService) DescribeEffects(ctx context.Context, cur module.ExpandedResource, planned resource.Resource) ([]module.Effect, error) {
+	ret := _m.Called(ctx, cur, planned)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DescribeEffects")
+	}
+
+	var r0 []module.Effect
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, module.ExpandedResource, resource.Resource) ([]module.Effect, error)); ok {
+		return rf(ctx, cur, planned)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, module.ExpandedResource, resource.Resource) []module.Effect); ok {
+		r0 = rf(ctx, cur, planned)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]module.Effect)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, module.ExpandedResource, resource.Resource) error); ok {
+		r1 = rf(ctx, cur, planned)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModuleService_DescribeEffects_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DescribeEffects'
+type ModuleService_DescribeEffects_Call struct {
+	*mock.Call
+}
+
+// DescribeEffects is a helper method to define mock.On call
+//   - ctx context.Context
+//   - cur module.ExpandedResource
+//   - planned resource.Resource
+func (_e *ModuleService_Expecter) DescribeEffects(ctx interface{}, cur interface{}, planned interface{}) *ModuleService_DescribeEffects_Call {
+	return &ModuleService_DescribeEffects_Call{Call: _e.mock.On("DescribeEffects", ctx, cur, planned)}
+}
+
+func (_c *ModuleService_DescribeEffects_Call) Run(run func(ctx context.Context, cur module.ExpandedResource, planned resource.Resource)) *ModuleService_DescribeEffects_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(module.ExpandedResource), args[2].(resource.Resource))
+	})
+	return _c
+}
+
+func (_c *ModuleService_DescribeEffects_Call) Return(_a0 []module.Effect, _a1 error) *ModuleService_DescribeEffects_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModuleService_DescribeEffects_Call) RunAndReturn(run func(context.Context, module.ExpandedResource, resource.Resource) ([]module.Effect, error)) *ModuleService_DescribeEffects_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependencyChangePolicy provides a mock function with given fields: ctx, res
+func (_m *ModuleService) GetDependencyChangePolicy(ctx context.Context, res module.ExpandedResource) (*module.DependencyChangePolicy, error) {
+	ret := _m.Called(ctx, res)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependencyChangePolicy")
+	}
+
+	var r0 *module.DependencyChangePolicy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, module.ExpandedResource) (*module.DependencyChangePolicy, error)); ok {
+		return rf(ctx, res)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, module.ExpandedResource) *module.DependencyChangePolicy); ok {
+		r0 = rf(ctx, res)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*module.DependencyChangePolicy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, module.ExpandedResource) error); ok {
+		r1 = rf(ctx, res)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ModuleService_GetDependencyChangePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependencyChangePolicy'
+type ModuleService_GetDependencyChangePolicy_Call struct {
+	*mock.Call
+}
+
+// GetDependencyChangePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - res module.ExpandedResource
+func (_e *ModuleService_Expecter) GetDependencyChangePolicy(ctx interface{}, res interface{}) *ModuleService_GetDependencyChangePolicy_Call {
+	return &ModuleService_GetDependencyChangePolicy_Call{Call: _e.mock.On("GetDependencyChangePolicy", ctx, res)}
+}
+
+func (_c *ModuleService_GetDependencyChangePolicy_Call) Run(run func(ctx context.Context, res module.ExpandedResource)) *ModuleService_GetDependencyChangePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(module.ExpandedResource))
+	})
+	return _c
+}
+
+func (_c *ModuleService_GetDependencyChangePolicy_Call) Return(_a0 *module.DependencyChangePolicy, _a1 error) *ModuleService_GetDependencyChangePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ModuleService_GetDependencyChangePolicy_Call) RunAndReturn(run func(context.Context, module.ExpandedResource) (*module.DependencyChangePolicy, error)) *ModuleService_GetDependencyChangePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetOutput provides a mock function with given fields: ctx, res
 func (_m *ModuleService) GetOutput(ctx context.Context, res module.ExpandedResource) (json.RawMessage, error) {
 	ret := _m.Called(ctx, res)
@@ -146,6 +146,65 @@ func (_c *ResourceStore_Delete_Call) RunAndReturn(run func(context.Context, stri
 	return _c
 }
 
+// Dependents provides a mock function with given fields: ctx, urn
+func (_m *ResourceStore) Dependents(ctx context.Context, urn string) ([]string, error) {
+	ret := _m.Called(ctx, urn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Dependents")
+	}
+
+	var r0 []string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]string, error)); ok {
+		return rf(ctx, urn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []string); ok {
+		r0 = rf(ctx, urn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, urn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceStore_Dependents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Dependents'
+type ResourceStore_Dependents_Call struct {
+	*mock.Call
+}
+
+// Dependents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+func (_e *ResourceStore_Expecter) Dependents(ctx interface{}, urn interface{}) *ResourceStore_Dependents_Call {
+	return &ResourceStore_Dependents_Call{Call: _e.mock.On("Dependents", ctx, urn)}
+}
+
+func (_c *ResourceStore_Dependents_Call) Run(run func(ctx context.Context, urn string)) *ResourceStore_Dependents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceStore_Dependents_Call) Return(_a0 []string, _a1 error) *ResourceStore_Dependents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceStore_Dependents_Call) RunAndReturn(run func(context.Context, string) ([]string, error)) *ResourceStore_Dependents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetByURN provides a mock function with given fields: ctx, urn
 func (_m *ResourceStore) GetByURN(ctx context.Context, urn string) (*resource.Resource, error) {
 	ret := _m.Called(ctx, urn)
@@ -206,24 +265,22 @@ func (_c *ResourceStore_GetByURN_Call) RunAndReturn(run func(context.Context, st
 }
 
 // List provides a mock function with given fields: ctx, filter, withSpecConfigs
-func (_m *ResourceStore) List(ctx context.Context, filter resource.Filter, withSpecConfigs bool) ([]resource.Resource, error) {
+func (_m *ResourceStore) List(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error) {
 	ret := _m.Called(ctx, filter, withSpecConfigs)
 
 	if len(ret) == 0 {
 		panic("no return value specified for List")
 	}
 
-	var r0 []resource.Resource
+	var r0 resource.PagedResource
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) ([]resource.Resource, error)); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) (resource.PagedResource, error)); ok {
 		return rf(ctx, filter, withSpecConfigs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) []resource.Resource); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) resource.PagedResource); ok {
 		r0 = rf(ctx, filter, withSpecConfigs)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]resource.Resource)
-		}
+		r0 = ret.Get(0).(resource.PagedResource)
 	}
 
 	if rf, ok := ret.Get(1).(func(context.Context, resource.Filter, bool) error); ok {
@@ -255,12 +312,12 @@ func (_c *ResourceStore_List_Call) Run(run func(ctx context.Context, filter reso
 	return _c
 }
 
-func (_c *ResourceStore_List_Call) Return(_a0 []resource.Resource, _a1 error) *ResourceStore_List_Call {
+func (_c *ResourceStore_List_Call) Return(_a0 resource.PagedResource, _a1 error) *ResourceStore_List_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceStore_List_Call) RunAndReturn(run func(context.Context, resource.Filter, bool) ([]resource.Resource, error)) *ResourceStore_List_Call {
+func (_c *ResourceStore_List_Call) RunAndReturn(run func(context.Context, resource.Filter, bool) (resource.PagedResource, error)) *ResourceStore_List_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -324,17 +381,24 @@ func (_c *ResourceStore_Revisions_Call) RunAndReturn(run func(context.Context, r
 	return _c
 }
 
-// SyncOne provides a mock function with given fields: ctx, scope, syncFn
-func (_m *ResourceStore) SyncOne(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn) error {
-	ret := _m.Called(ctx, scope, syncFn)
+// SyncOne provides a mock function with given fields: ctx, scope, syncFn, hooks
+func (_m *ResourceStore) SyncOne(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn, hooks ...resource.MutationHook) error {
+	_va := make([]interface{}, len(hooks))
+	for _i := range hooks {
+		_va[_i] = hooks[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, scope, syncFn)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
 		panic("no return value specified for SyncOne")
 	}
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, map[string][]string, resource.SyncFn) error); ok {
-		r0 = rf(ctx, scope, syncFn)
+	if rf, ok := ret.Get(0).(func(context.Context, map[string][]string, resource.SyncFn, ...resource.MutationHook) error); ok {
+		r0 = rf(ctx, scope, syncFn, hooks...)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -351,13 +415,21 @@ type ResourceStore_SyncOne_Call struct {
 //   - ctx context.Context
 //   - scope map[string][]string
 //   - syncFn resource.SyncFn
-func (_e *ResourceStore_Expecter) SyncOne(ctx interface{}, scope interface{}, syncFn interface{}) *ResourceStore_SyncOne_Call {
-	return &ResourceStore_SyncOne_Call{Call: _e.mock.On("SyncOne", ctx, scope, syncFn)}
+//   - hooks ...resource.MutationHook
+func (_e *ResourceStore_Expecter) SyncOne(ctx interface{}, scope interface{}, syncFn interface{}, hooks ...interface{}) *ResourceStore_SyncOne_Call {
+	return &ResourceStore_SyncOne_Call{Call: _e.mock.On("SyncOne",
+		append([]interface{}{ctx, scope, syncFn}, hooks...)...)}
 }
 
-func (_c *ResourceStore_SyncOne_Call) Run(run func(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn)) *ResourceStore_SyncOne_Call {
+func (_c *ResourceStore_SyncOne_Call) Run(run func(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn, hooks ...resource.MutationHook)) *ResourceStore_SyncOne_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(map[string][]string), args[2].(resource.SyncFn))
+		variadicArgs := make([]resource.MutationHook, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(resource.MutationHook)
+			}
+		}
+		run(args[0].(context.Context), args[1].(map[string][]string), args[2].(resource.SyncFn), variadicArgs...)
 	})
 	return _c
 }
@@ -367,7 +439,7 @@ func (_c *ResourceStore_SyncOne_Call) Return(_a0 error) *ResourceStore_SyncOne_C
 	return _c
 }
 
-func (_c *ResourceStore_SyncOne_Call) RunAndReturn(run func(context.Context, map[string][]string, resource.SyncFn) error) *ResourceStore_SyncOne_Call {
+func (_c *ResourceStore_SyncOne_Call) RunAndReturn(run func(context.Context, map[string][]string, resource.SyncFn, ...resource.MutationHook) error) *ResourceStore_SyncOne_Call {
 	_c.Call.Return(run)
 	return _c
 }
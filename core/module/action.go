@@ -21,6 +21,11 @@ type ActionRequest struct {
 	Params json.RawMessage   `json:"params"`
 	Labels map[string]string `json:"labels"`
 	UserID string
+
+	// PreviousDependencies is set when the action changes the dependencies
+	// of the resource and holds the dependencies before the change. The
+	// resource being planned already refers to the new dependencies.
+	PreviousDependencies map[string]string `json:"previous_dependencies,omitempty"`
 }
 
 // ActionDesc is a descriptor for an action supported by a module.
@@ -0,0 +1,62 @@
+package module
+
+import (
+	"context"
+	"strings"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/audit"
+)
+
+const (
+	auditActionCreate = "module:create"
+	auditActionUpdate = "module:update"
+	auditActionDelete = "module:delete"
+)
+
+// recordAudit appends an event to the audit log if the store keeps one.
+func (mr *Service) recordAudit(ctx context.Context, mod Module, action string, err error) {
+	auditStore, ok := mr.store.(audit.Store)
+	if !ok {
+		return
+	}
+
+	project := mod.Project
+	if project == "" {
+		project = projectFromURN(mod.URN)
+	}
+
+	actor := audit.ActorFrom(ctx)
+	event := audit.Event{
+		Project:   project,
+		URN:       mod.URN,
+		UserID:    actor.UserID,
+		RequestID: actor.RequestID,
+		Action:    action,
+		Params:    mod.Configs,
+		Result:    audit.ResultSuccess,
+	}
+	if err != nil {
+		event.Result = audit.ResultFailure
+		event.Error = err.Error()
+	}
+
+	if appendErr := auditStore.AppendAuditEvent(ctx, event); appendErr != nil {
+		zap.L().Warn("failed to record audit event",
+			zap.String("module_urn", mod.URN),
+			zap.String("action", action),
+			zap.Error(appendErr),
+		)
+	}
+}
+
+// projectFromURN extracts the project from module URNs generated by
+// generateURN.
+func projectFromURN(urn string) string {
+	parts := strings.Split(urn, ":")
+	if len(parts) != 5 {
+		return ""
+	}
+	return parts[3]
+}
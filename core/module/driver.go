@@ -38,11 +38,34 @@ type Loggable interface {
 	Log(ctx context.Context, res ExpandedResource, filter map[string]string) (<-chan LogChunk, error)
 }
 
+// EffectDescriber extension of driver allows describing the changes to
+// external systems that a planned resource would cause once synced.
+type EffectDescriber interface {
+	Driver
+
+	// DescribeEffects SHOULD NOT have side effects. cur is the resource before
+	// the change (without configs for a create) and planned is the result of
+	// Plan() for it.
+	DescribeEffects(ctx context.Context, cur ExpandedResource, planned resource.Resource) ([]Effect, error)
+}
+
+// Effect is a change to an external system, e.g. a helm release upgrade.
+type Effect struct {
+	Kind    string            `json:"kind"`
+	Target  string            `json:"target"`
+	Changes []resource.Change `json:"changes,omitempty"`
+}
+
 // ExpandedResource represents the context for Plan() or Sync() invocations.
 type ExpandedResource struct {
 	resource.Resource `json:"resource"`
 
 	Dependencies map[string]ResolvedDependency `json:"dependencies"`
+
+	// PreviousDependencies holds the dependencies replaced by a change that
+	// is not completed yet, so that the module can clean up after them. The
+	// ones that no longer exist are left out.
+	PreviousDependencies map[string]ResolvedDependency `json:"previous_dependencies,omitempty"`
 }
 
 type ResolvedDependency struct {
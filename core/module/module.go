@@ -29,6 +29,19 @@ type Descriptor struct {
 	Actions       []ActionDesc                               `json:"actions"`
 	Dependencies  map[string]string                          `json:"dependencies"`
 	DriverFactory func(conf json.RawMessage) (Driver, error) `json:"-"`
+
+	// OnDependencyChange opts resources of this kind into reacting when the
+	// output of one of their dependencies changes. If nil, such changes are
+	// ignored until the resource is updated explicitly.
+	OnDependencyChange *DependencyChangePolicy `json:"on_dependency_change,omitempty"`
+}
+
+// DependencyChangePolicy describes how a resource reacts to output changes
+// of its dependencies.
+type DependencyChangePolicy struct {
+	// Action is applied (with no params) to the resource when set. When empty,
+	// the resource is simply re-synced with the new dependency outputs.
+	Action string `json:"action,omitempty"`
 }
 
 // Registry is responsible for installing and managing module-drivers as per
@@ -37,6 +50,12 @@ type Registry interface {
 	GetDriver(ctx context.Context, mod Module) (Driver, Descriptor, error)
 }
 
+// DriverCache is implemented by registries that cache initialised drivers.
+// Service uses it to drop stale drivers when module configs change.
+type DriverCache interface {
+	InvalidateDriver(urn string)
+}
+
 // Store is responsible for persisting modules defined for each project.
 type Store interface {
 	GetModule(ctx context.Context, urn string) (*Module, error)
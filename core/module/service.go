@@ -9,16 +9,45 @@ import (
 	"github.com/goto/entropy/pkg/errors"
 )
 
+// Permissions the Authorizer is asked for.
+const (
+	permissionRead   = "read"
+	permissionManage = "manage"
+)
+
 type Service struct {
-	store    Store
-	registry Registry
+	store      Store
+	registry   Registry
+	authorizer Authorizer
+}
+
+// Authorizer checks that the caller in ctx holds a permission on a
+// project. Modules are read with the "read" permission and changed with
+// the "manage" permission.
+type Authorizer interface {
+	Authorize(ctx context.Context, project, permission string) error
+}
+
+// ServiceOption configures optional behaviour of the Service.
+type ServiceOption func(mr *Service)
+
+// WithAuthorizer makes the service check the permissions of the callers
+// of the module APIs.
+func WithAuthorizer(authorizer Authorizer) ServiceOption {
+	return func(mr *Service) {
+		mr.authorizer = authorizer
+	}
 }
 
-func NewService(registry Registry, store Store) *Service {
-	return &Service{
+func NewService(registry Registry, store Store, opts ...ServiceOption) *Service {
+	mr := &Service{
 		store:    store,
 		registry: registry,
 	}
+	for _, opt := range opts {
+		opt(mr)
+	}
+	return mr
 }
 
 func (mr *Service) PlanAction(ctx context.Context, res ExpandedResource, act ActionRequest) (*resource.Resource, error) {
@@ -74,6 +103,26 @@ func (mr *Service) StreamLogs(ctx context.Context, res ExpandedResource, filter
 	return lg.Log(ctx, res, filter)
 }
 
+// DescribeEffects returns the external effects of the planned change as
+// described by the driver. Returns nil if the driver does not support it.
+func (mr *Service) DescribeEffects(ctx context.Context, cur ExpandedResource, planned resource.Resource) ([]Effect, error) {
+	mod, err := mr.discoverModule(ctx, cur.Kind, cur.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	driver, _, err := mr.initDriver(ctx, *mod)
+	if err != nil {
+		return nil, err
+	}
+
+	describer, supported := driver.(EffectDescriber)
+	if !supported {
+		return nil, nil
+	}
+	return describer.DescribeEffects(ctx, cur, planned)
+}
+
 func (mr *Service) GetOutput(ctx context.Context, res ExpandedResource) (json.RawMessage, error) {
 	mod, err := mr.discoverModule(ctx, res.Kind, res.Project)
 	if err != nil {
@@ -88,17 +137,44 @@ func (mr *Service) GetOutput(ctx context.Context, res ExpandedResource) (json.Ra
 	return driver.Output(ctx, res)
 }
 
+// GetDependencyChangePolicy returns the policy the module defines for output
+// changes of dependencies. Returns nil if the module has not opted in.
+func (mr *Service) GetDependencyChangePolicy(ctx context.Context, res ExpandedResource) (*DependencyChangePolicy, error) {
+	mod, err := mr.discoverModule(ctx, res.Kind, res.Project)
+	if err != nil {
+		return nil, err
+	}
+
+	_, desc, err := mr.initDriver(ctx, *mod)
+	if err != nil {
+		return nil, err
+	}
+
+	return desc.OnDependencyChange, nil
+}
+
 func (mr *Service) GetModule(ctx context.Context, urn string) (*Module, error) {
-	return mr.store.GetModule(ctx, urn)
+	mod, err := mr.store.GetModule(ctx, urn)
+	if err != nil {
+		return nil, err
+	} else if err := mr.authorize(ctx, mod.Project, permissionRead); err != nil {
+		return nil, err
+	}
+	return mod, nil
 }
 
 func (mr *Service) ListModules(ctx context.Context, project string) ([]Module, error) {
+	if err := mr.authorize(ctx, project, permissionRead); err != nil {
+		return nil, err
+	}
 	return mr.store.ListModules(ctx, project)
 }
 
 func (mr *Service) CreateModule(ctx context.Context, mod Module) (*Module, error) {
 	if err := mod.sanitise(true); err != nil {
 		return nil, err
+	} else if err := mr.authorize(ctx, mod.Project, permissionManage); err != nil {
+		return nil, err
 	}
 
 	if _, _, err := mr.registry.GetDriver(ctx, mod); err != nil {
@@ -112,7 +188,9 @@ func (mr *Service) CreateModule(ctx context.Context, mod Module) (*Module, error
 		return nil, err
 	}
 
-	if err := mr.store.CreateModule(ctx, mod); err != nil {
+	err := mr.store.CreateModule(ctx, mod)
+	mr.recordAudit(ctx, mod, auditActionCreate, err)
+	if err != nil {
 		if errors.Is(err, errors.ErrConflict) {
 			return nil, errors.ErrConflict.
 				WithMsgf("module with given name and project already exists").
@@ -127,6 +205,8 @@ func (mr *Service) UpdateModule(ctx context.Context, urn string, newConfigs json
 	mod, err := mr.store.GetModule(ctx, urn)
 	if err != nil {
 		return nil, err
+	} else if err := mr.authorize(ctx, mod.Project, permissionManage); err != nil {
+		return nil, err
 	}
 	mod.Configs = newConfigs
 
@@ -134,14 +214,37 @@ func (mr *Service) UpdateModule(ctx context.Context, urn string, newConfigs json
 		return nil, err
 	}
 
-	if err := mr.store.UpdateModule(ctx, *mod); err != nil {
+	err = mr.store.UpdateModule(ctx, *mod)
+	mr.recordAudit(ctx, *mod, auditActionUpdate, err)
+	if err != nil {
 		return nil, err
 	}
+	mr.invalidateDriver(mod.URN)
 	return mod, nil
 }
 
 func (mr *Service) DeleteModule(ctx context.Context, urn string) error {
-	return mr.store.DeleteModule(ctx, urn)
+	mod, err := mr.store.GetModule(ctx, urn)
+	if err != nil {
+		return err
+	} else if err := mr.authorize(ctx, mod.Project, permissionManage); err != nil {
+		return err
+	}
+
+	err = mr.store.DeleteModule(ctx, urn)
+	mr.recordAudit(ctx, *mod, auditActionDelete, err)
+	if err != nil {
+		return err
+	}
+	mr.invalidateDriver(urn)
+	return nil
+}
+
+func (mr *Service) authorize(ctx context.Context, project, permission string) error {
+	if mr.authorizer == nil {
+		return nil
+	}
+	return mr.authorizer.Authorize(ctx, project, permission)
 }
 
 func (mr *Service) discoverModule(ctx context.Context, kind, project string) (*Module, error) {
@@ -174,6 +277,12 @@ func (mr *Service) initDriver(ctx context.Context, mod Module) (Driver, Descript
 	return driver, desc, nil
 }
 
+func (mr *Service) invalidateDriver(urn string) {
+	if cache, ok := mr.registry.(DriverCache); ok {
+		cache.InvalidateDriver(urn)
+	}
+}
+
 func generateURN(name, project string) string {
 	return fmt.Sprintf("orn:entropy:module:%s:%s", project, name)
 }
@@ -0,0 +1,195 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// WithOperation makes the mutation fill op with the operation tracking it.
+// op is left untouched for dry-runs and by stores that do not keep
+// operations.
+func WithOperation(op *operation.Operation) Options {
+	return Options{Operation: op}
+}
+
+func (svc *Service) GetOperation(ctx context.Context, id int64) (*operation.Operation, error) {
+	opStore, err := svc.operationStore()
+	if err != nil {
+		return nil, err
+	}
+
+	op, err := opStore.GetOperation(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("operation with id '%d' not found", id)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, op.Project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+	return op, nil
+}
+
+// ListOperations returns the operations matching the filter, newest first.
+func (svc *Service) ListOperations(ctx context.Context, filter operation.Filter) ([]operation.Operation, error) {
+	opStore, err := svc.operationStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Project == "" && filter.URN != "" {
+		err = svc.authorizeURN(ctx, filter.URN, rbac.PermissionRead)
+	} else {
+		err = svc.authorize(ctx, filter.Project, rbac.PermissionRead)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := opStore.ListOperations(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return ops, nil
+}
+
+func (svc *Service) operationStore() (operation.Store, error) {
+	opStore, ok := svc.store.(operation.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("operations are not supported by the store")
+	}
+	return opStore, nil
+}
+
+// startOperation returns the hooks that record the operation for an action
+// applied to the resource within the write of the resource, if the store
+// keeps operations, so that the operation exists once the resource can be
+// synced. The recorded operation is copied to op. Actions that leave
+// nothing to sync complete right away.
+func (svc *Service) startOperation(res resource.Resource, act module.ActionRequest, op *operation.Operation) []resource.MutationHook {
+	opStore, ok := svc.store.(operation.Store)
+	if !ok {
+		return nil
+	}
+
+	record := func(ctx context.Context) error {
+		userID := act.UserID
+		if userID == "" {
+			userID = audit.ActorFrom(ctx).UserID
+		}
+
+		started := operation.Operation{
+			URN:       res.URN,
+			Project:   res.Project,
+			Action:    act.Name,
+			Params:    act.Params,
+			UserID:    userID,
+			Status:    operation.StatusPending,
+			StartedAt: svc.clock(),
+		}
+		applySyncedState(&started, res.State, started.StartedAt)
+
+		created, err := opStore.CreateOperation(ctx, started)
+		if err != nil {
+			return errors.ErrInternal.WithMsgf("failed to record operation").WithCausef("%s", err.Error())
+		} else if err := supersedeOperations(ctx, opStore, *created); err != nil {
+			return err
+		}
+		*op = *created
+		return nil
+	}
+	return []resource.MutationHook{record}
+}
+
+// supersedeOperations finishes the operations on the resource left
+// unfinished before op was started, since syncs only track the latest one.
+func supersedeOperations(ctx context.Context, opStore operation.Store, op operation.Operation) error {
+	ops, err := opStore.ListOperations(ctx, operation.Filter{
+		URN:      op.URN,
+		Statuses: []string{operation.StatusPending, operation.StatusRunning},
+	})
+	if err != nil {
+		return errors.ErrInternal.WithMsgf("failed to find operations").WithCausef("%s", err.Error())
+	}
+
+	for _, older := range ops {
+		if older.ID >= op.ID {
+			continue
+		}
+
+		older.Status = operation.StatusSuperseded
+		older.PendingSteps = nil
+		older.FinishedAt = &op.StartedAt
+		if err := opStore.UpdateOperation(ctx, older); err != nil {
+			return errors.ErrInternal.WithMsgf("failed to update operation").WithCausef("%s", err.Error())
+		}
+	}
+	return nil
+}
+
+// trackOperation updates the latest unfinished operation on the resource
+// with the outcome of a sync and returns its action, empty if there is no
+// such operation.
+func (svc *Service) trackOperation(ctx context.Context, res resource.Resource, synced *resource.Resource, syncErr error) string {
+	opStore, ok := svc.store.(operation.Store)
+	if !ok {
+		return ""
+	}
+
+	ops, err := opStore.ListOperations(ctx, operation.Filter{
+		URN:      res.URN,
+		Statuses: []string{operation.StatusPending, operation.StatusRunning},
+		Limit:    1,
+	})
+	if err != nil || len(ops) == 0 {
+		if err != nil {
+			zap.L().Warn("failed to find operation", zap.String("resource_urn", res.URN), zap.Error(err))
+		}
+		return ""
+	}
+
+	op := ops[0]
+	op.Status = operation.StatusRunning
+	if syncErr != nil {
+		// the resource is left as it was and will be synced again.
+		op.Error = syncErr.Error()
+	} else {
+		applySyncedState(&op, synced.State, svc.clock())
+	}
+
+	if err := opStore.UpdateOperation(ctx, op); err != nil {
+		zap.L().Warn("failed to update operation",
+			zap.String("resource_urn", res.URN),
+			zap.Int64("operation_id", op.ID),
+			zap.Error(err),
+		)
+	}
+	return op.Action
+}
+
+// applySyncedState moves the operation along with the state of the
+// resource. Operations finish once the resource needs no more syncs.
+func applySyncedState(op *operation.Operation, state resource.State, now time.Time) {
+	op.PendingSteps = pendingSteps(state.ModuleData)
+	op.Error = state.SyncResult.LastError
+
+	switch {
+	case state.Status == resource.StatusError:
+		op.Status = operation.StatusFailed
+	case state.IsTerminal() || state.InDeletion():
+		op.Status = operation.StatusCompleted
+		op.PendingSteps = nil
+	default:
+		return
+	}
+	op.FinishedAt = &now
+}
@@ -0,0 +1,80 @@
+package operation
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+)
+
+const (
+	// StatusPending operations are waiting for their first sync.
+	StatusPending = "pending"
+
+	// StatusRunning operations have been synced at least once but the
+	// resource has not reached a terminal state yet.
+	StatusRunning = "running"
+
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+
+	// StatusSuperseded operations were still unfinished when a newer
+	// operation was started on the resource. Their outcome is carried by
+	// the newer operation.
+	StatusSuperseded = "superseded"
+)
+
+// Store is implemented by storage backends that can keep operations. Actions
+// are tracked as operations only if the store implements it.
+type Store interface {
+	// CreateOperation stores the operation and returns it with the ID
+	// assigned. Called from the mutation hooks of a resource write, the
+	// operations are read and written within the write so that they are
+	// committed along with the change, or not at all.
+	CreateOperation(ctx context.Context, op Operation) (*Operation, error)
+	GetOperation(ctx context.Context, id int64) (*Operation, error)
+	ListOperations(ctx context.Context, filter Filter) ([]Operation, error)
+
+	// UpdateOperation replaces the status, pending steps, error and finish
+	// time of the operation.
+	UpdateOperation(ctx context.Context, op Operation) error
+}
+
+// Operation is an action accepted on a resource and carried out by the
+// syncer in the background.
+type Operation struct {
+	ID           int64           `json:"id"`
+	URN          string          `json:"urn"`
+	Project      string          `json:"project"`
+	Action       string          `json:"action"`
+	Params       json.RawMessage `json:"params,omitempty"`
+	UserID       string          `json:"user_id"`
+	Status       string          `json:"status"`
+	PendingSteps []string        `json:"pending_steps,omitempty"`
+	Error        string          `json:"error,omitempty"`
+	StartedAt    time.Time       `json:"started_at"`
+	FinishedAt   *time.Time      `json:"finished_at,omitempty"`
+}
+
+// IsFinished returns true if the operation needs no further sync.
+func (op Operation) IsFinished() bool {
+	return op.Status == StatusCompleted || op.Status == StatusFailed || op.Status == StatusSuperseded
+}
+
+// Filter selects operations. Zero values match everything. Operations are
+// returned newest first.
+type Filter struct {
+	Project string `json:"project"`
+	URN     string `json:"urn"`
+
+	// Statuses if set, limits the operations to the ones in any of them.
+	Statuses []string `json:"statuses"`
+	Limit    int      `json:"limit"`
+}
+
+// Matches returns true if the operation is selected by the filter.
+func (f Filter) Matches(op Operation) bool {
+	return (f.Project == "" || f.Project == op.Project) &&
+		(f.URN == "" || f.URN == op.URN) &&
+		(len(f.Statuses) == 0 || slices.Contains(f.Statuses, op.Status))
+}
@@ -0,0 +1,223 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_Operations(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Create(context.Background(), resource.Resource{
+		URN:     urn,
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		State:   resource.State{Status: resource.StatusCompleted},
+	}))
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{
+				Status:     resource.StatusPending,
+				ModuleData: []byte(`{"pending_steps":["release_update","consumer_reset"]}`),
+				NextSyncAt: &frozenTime,
+			}
+			return &planned, nil
+		})
+	mod.EXPECT().
+		DescribeEffects(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil)
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+	ctx := context.Background()
+
+	t.Run("DryRun", func(t *testing.T) {
+		var op operation.Operation
+		_, err := svc.ApplyAction(ctx, urn, module.ActionRequest{Name: "reset"},
+			core.WithPlan(&core.Plan{}), core.WithOperation(&op))
+		require.NoError(t, err)
+		assert.Zero(t, op)
+	})
+
+	_, err = svc.CreateWebhook(ctx, webhook.Subscription{
+		Project: "project",
+		URL:     "http://localhost/resets",
+		Actions: []string{"reset"},
+	})
+	require.NoError(t, err)
+
+	var op operation.Operation
+	_, err = svc.ApplyAction(ctx, urn, module.ActionRequest{
+		Name:   "reset",
+		Params: []byte(`{"to":"latest"}`),
+		UserID: "john",
+	}, core.WithOperation(&op))
+	require.NoError(t, err)
+
+	assert.Equal(t, operation.Operation{
+		ID:           1,
+		URN:          urn,
+		Project:      "project",
+		Action:       "reset",
+		Params:       []byte(`{"to":"latest"}`),
+		UserID:       "john",
+		Status:       operation.StatusPending,
+		PendingSteps: []string{"release_update", "consumer_reset"},
+		StartedAt:    frozenTime,
+	}, op)
+
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		Return(&resource.State{
+			Status:     resource.StatusPending,
+			ModuleData: []byte(`{"pending_steps":["consumer_reset"]}`),
+			NextSyncAt: &frozenTime,
+		}, nil).
+		Once()
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		Return(&resource.State{Status: resource.StatusCompleted}, nil).
+		Once()
+
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eg := &errgroup.Group{}
+	svc.RunSyncer(syncCtx, 1, 5*time.Millisecond, time.Second, nil, eg)
+
+	assert.Eventually(t, func() bool {
+		res, err := store.GetByURN(ctx, urn)
+		return err == nil && res.State.Status == resource.StatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, eg.Wait(), context.Canceled)
+
+	got, err := svc.GetOperation(ctx, op.ID)
+	require.NoError(t, err)
+	assert.Equal(t, operation.StatusCompleted, got.Status)
+	assert.Empty(t, got.PendingSteps)
+	require.NotNil(t, got.FinishedAt)
+	assert.Equal(t, frozenTime, *got.FinishedAt)
+
+	ops, err := svc.ListOperations(ctx, operation.Filter{URN: urn})
+	require.NoError(t, err)
+	assert.Equal(t, []operation.Operation{*got}, ops)
+
+	// the end of the sync is sent to the subscribers of the action.
+	deliveries, err := store.ClaimDeliveries(ctx, frozenTime, time.Minute, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 2)
+	assert.Equal(t, webhook.EventResourceAction, deliveries[0].Event.Type)
+	assert.Equal(t, webhook.EventSyncCompleted, deliveries[1].Event.Type)
+	assert.Equal(t, "reset", deliveries[1].Event.Action)
+
+	ops, err = svc.ListOperations(ctx, operation.Filter{Statuses: []string{operation.StatusPending, operation.StatusRunning}})
+	require.NoError(t, err)
+	assert.Empty(t, ops)
+
+	_, err = svc.GetOperation(ctx, 42)
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	t.Run("Superseded", func(t *testing.T) {
+		var first, second operation.Operation
+		_, err := svc.ApplyAction(ctx, urn, module.ActionRequest{Name: "reset"}, core.WithOperation(&first))
+		require.NoError(t, err)
+
+		// the resource is made terminal again without the first operation
+		// being synced, so that the second action can be queued.
+		res, err := store.GetByURN(ctx, urn)
+		require.NoError(t, err)
+		res.State = resource.State{Status: resource.StatusCompleted}
+		require.NoError(t, store.Update(ctx, *res, false, ""))
+
+		_, err = svc.ApplyAction(ctx, urn, module.ActionRequest{Name: "reset"}, core.WithOperation(&second))
+		require.NoError(t, err)
+
+		got, err := svc.GetOperation(ctx, first.ID)
+		require.NoError(t, err)
+		assert.Equal(t, operation.StatusSuperseded, got.Status)
+		assert.True(t, got.IsFinished())
+		assert.Empty(t, got.PendingSteps)
+		require.NotNil(t, got.FinishedAt)
+		assert.Equal(t, frozenTime, *got.FinishedAt)
+
+		ops, err := svc.ListOperations(ctx, operation.Filter{
+			URN:      urn,
+			Statuses: []string{operation.StatusPending, operation.StatusRunning},
+		})
+		require.NoError(t, err)
+		require.Len(t, ops, 1)
+		assert.Equal(t, second.ID, ops[0].ID)
+	})
+
+	t.Run("RecordedWithResource", func(t *testing.T) {
+		// the resource is made terminal so that the action can be queued.
+		res, err := store.GetByURN(ctx, urn)
+		require.NoError(t, err)
+		res.State = resource.State{Status: resource.StatusCompleted}
+		require.NoError(t, store.Update(ctx, *res, false, ""))
+
+		// a syncer picking the resource up right after the write finds the
+		// operation to track.
+		var found []operation.Operation
+		observed := &observedStore{Store: store, afterUpdate: func(ctx context.Context, res resource.Resource) {
+			found, _ = store.ListOperations(ctx, operation.Filter{URN: res.URN, Statuses: []string{operation.StatusPending}})
+		}}
+		svc := core.New(observed, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+		var op operation.Operation
+		_, err = svc.ApplyAction(ctx, urn, module.ActionRequest{Name: "reset"}, core.WithOperation(&op))
+		require.NoError(t, err)
+		require.Len(t, found, 1)
+		assert.Equal(t, op.ID, found[0].ID)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.ListOperations(ctx, operation.Filter{})
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+}
+
+// observedStore calls afterUpdate once an update of a resource is saved.
+type observedStore struct {
+	*inmemory.Store
+	afterUpdate func(ctx context.Context, res resource.Resource)
+}
+
+func (st *observedStore) Update(ctx context.Context, res resource.Resource, saveRevision bool, reason string, hooks ...resource.MutationHook) error {
+	if err := st.Store.Update(ctx, res, saveRevision, reason, hooks...); err != nil {
+		return err
+	}
+	st.afterUpdate(ctx, res)
+	return nil
+}
@@ -0,0 +1,157 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// Plan describes what a create, update or action would change if it was
+// not a dry-run.
+type Plan struct {
+	// Changes between the current and the planned resource. Paths are
+	// prefixed with the part of the resource they belong to, e.g.
+	// 'spec.configs.replicas' or 'state.status'.
+	Changes []resource.Change `json:"changes"`
+
+	// Effects on external systems, as described by the module driver,
+	// that syncing the planned resource would cause.
+	Effects []module.Effect `json:"effects,omitempty"`
+
+	// Warnings are the warn policies violated by the planned resource.
+	Warnings []policy.Violation `json:"warnings,omitempty"`
+}
+
+// WithPlan makes the operation a dry-run and stores its plan in p.
+func WithPlan(p *Plan) Options {
+	return Options{DryRun: true, Plan: p}
+}
+
+func (svc *Service) describePlan(ctx context.Context, cur, planned resource.Resource, isCreate bool) (*Plan, error) {
+	base := cur
+	if isCreate {
+		// the requested resource only carries what is being created.
+		base = resource.Resource{}
+	}
+
+	changes, err := diffPlanned(base, planned)
+	if err != nil {
+		return nil, err
+	}
+
+	modSpec, err := svc.generateModuleSpec(ctx, cur)
+	if err != nil {
+		return nil, err
+	}
+
+	effects, err := svc.moduleSvc.DescribeEffects(ctx, *modSpec, planned)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMsgf("failed to describe effects").WithCausef("%s", err.Error())
+	}
+
+	return &Plan{Changes: changes, Effects: effects}, nil
+}
+
+func diffPlanned(cur, planned resource.Resource) ([]resource.Change, error) {
+	configs, err := resource.DiffJSON(cur.Spec.Configs, planned.Spec.Configs)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []resource.Change
+	changes = appendPrefixed(changes, "spec.configs", configs)
+	changes = appendPrefixed(changes, "labels", resource.DiffStringMaps(cur.Labels, planned.Labels))
+
+	if cur.State.Status != planned.State.Status {
+		changes = append(changes, valueChange("state.status", cur.State.Status, planned.State.Status))
+	}
+
+	curSteps, plannedSteps := pendingSteps(cur.State.ModuleData), pendingSteps(planned.State.ModuleData)
+	if !slices.Equal(curSteps, plannedSteps) {
+		changes = append(changes, valueChange("state.module_data.pending_steps", stepsValue(curSteps), stepsValue(plannedSteps)))
+	}
+	return changes, nil
+}
+
+func appendPrefixed(changes []resource.Change, prefix string, sectionChanges []resource.Change) []resource.Change {
+	for _, change := range sectionChanges {
+		if change.Path == "" {
+			change.Path = prefix
+		} else {
+			change.Path = prefix + "." + change.Path
+		}
+		changes = append(changes, change)
+	}
+	return changes
+}
+
+func valueChange(path string, from, to any) resource.Change {
+	change := resource.Change{Op: resource.ChangeChanged, Path: path, From: from, To: to}
+	switch {
+	case isZero(from):
+		change.Op, change.From = resource.ChangeAdded, nil
+	case isZero(to):
+		change.Op, change.To = resource.ChangeRemoved, nil
+	}
+	return change
+}
+
+func isZero(v any) bool {
+	switch val := v.(type) {
+	case nil:
+		return true
+	case string:
+		return val == ""
+	case []any:
+		return len(val) == 0
+	}
+	return false
+}
+
+// pendingSteps returns the steps drivers track under 'pending_steps' in the
+// module data, if any.
+func pendingSteps(moduleData json.RawMessage) []string {
+	var data struct {
+		PendingSteps []string `json:"pending_steps"`
+	}
+	if len(moduleData) == 0 || json.Unmarshal(moduleData, &data) != nil {
+		return nil
+	}
+	return data.PendingSteps
+}
+
+// setPendingSteps returns the module data with 'pending_steps' replaced by
+// the given steps. Other fields of the module data are left untouched.
+func setPendingSteps(moduleData json.RawMessage, steps []string) (json.RawMessage, error) {
+	data := map[string]json.RawMessage{}
+	if len(moduleData) > 0 {
+		if err := json.Unmarshal(moduleData, &data); err != nil {
+			return nil, errors.ErrInternal.WithMsgf("invalid module data").WithCausef("%s", err.Error())
+		}
+	}
+
+	stepsJSON, err := json.Marshal(steps)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	data["pending_steps"] = stepsJSON
+
+	updated, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return updated, nil
+}
+
+func stepsValue(steps []string) []any {
+	var values []any
+	for _, step := range steps {
+		values = append(values, step)
+	}
+	return values
+}
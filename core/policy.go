@@ -0,0 +1,234 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	auditActionPolicyWarning = "policy_warning"
+	violationTypePolicy      = "POLICY"
+)
+
+// CreatePolicy stores an admission policy after compiling its expression.
+func (svc *Service) CreatePolicy(ctx context.Context, p policy.Policy) (*policy.Policy, error) {
+	policyStore, err := svc.policyStore()
+	if err != nil {
+		return nil, err
+	}
+
+	// policies apply to every project, only admins may change them.
+	if err := svc.authorize(ctx, "", rbac.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	p.CreatedAt = svc.clock()
+	p.UpdatedAt = p.CreatedAt
+	p.UpdatedBy = p.CreatedBy
+
+	created, err := policyStore.CreatePolicy(ctx, p)
+	if err != nil {
+		if errors.Is(err, errors.ErrConflict) {
+			return nil, errors.ErrConflict.WithMsgf("policy with name '%s' already exists", p.Name)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return created, nil
+}
+
+func (svc *Service) GetPolicy(ctx context.Context, id int64) (*policy.Policy, error) {
+	policyStore, err := svc.policyStore()
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := policyStore.GetPolicy(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("policy with id '%d' not found", id)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, p.Project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// ListPolicies returns the policies that can apply to the resources
+// selected by the filter, including the ones not scoped to a project or
+// kind.
+func (svc *Service) ListPolicies(ctx context.Context, filter policy.Filter) ([]policy.Policy, error) {
+	policyStore, err := svc.policyStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.authorize(ctx, filter.Project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	policies, err := policyStore.ListPolicies(ctx)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	matched := make([]policy.Policy, 0, len(policies))
+	for _, p := range policies {
+		if filter.Matches(p) {
+			matched = append(matched, p)
+		}
+	}
+	return matched, nil
+}
+
+// UpdatePolicy replaces the policy with the given ID.
+func (svc *Service) UpdatePolicy(ctx context.Context, p policy.Policy) (*policy.Policy, error) {
+	policyStore, err := svc.policyStore()
+	if err != nil {
+		return nil, err
+	}
+
+	// policies apply to every project, only admins may change them.
+	if err := svc.authorize(ctx, "", rbac.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	existing, err := svc.GetPolicy(ctx, p.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	p.CreatedAt = existing.CreatedAt
+	p.CreatedBy = existing.CreatedBy
+	p.UpdatedAt = svc.clock()
+
+	if err := policyStore.UpdatePolicy(ctx, p); err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("policy with id '%d' not found", p.ID)
+		} else if errors.Is(err, errors.ErrConflict) {
+			return nil, errors.ErrConflict.WithMsgf("policy with name '%s' already exists", p.Name)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return &p, nil
+}
+
+func (svc *Service) DeletePolicy(ctx context.Context, id int64) error {
+	policyStore, err := svc.policyStore()
+	if err != nil {
+		return err
+	}
+
+	// policies apply to every project, only admins may change them.
+	if err := svc.authorize(ctx, "", rbac.PermissionManage); err != nil {
+		return err
+	}
+
+	if err := policyStore.DeletePolicy(ctx, id); err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return errors.ErrNotFound.WithMsgf("policy with id '%d' not found", id)
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return nil
+}
+
+func (svc *Service) policyStore() (policy.Store, error) {
+	policyStore, ok := svc.store.(policy.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("policies are not supported by the store")
+	}
+	return policyStore, nil
+}
+
+// admit evaluates the policies that apply to the planned change. It fails
+// with the violations of the deny policies and returns the violations of
+// the warn policies. A policy that cannot be evaluated counts as violated.
+func (svc *Service) admit(ctx context.Context, cur, planned resource.Resource, act module.ActionRequest) ([]policy.Violation, error) {
+	policyStore, ok := svc.store.(policy.Store)
+	if !ok {
+		return nil, nil
+	}
+
+	policies, err := policyStore.ListPolicies(ctx)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	in := policy.Input{Planned: planned, Action: act}
+	if !isCreate(act.Name) {
+		in.Current = &cur
+	}
+
+	var denied, warnings []policy.Violation
+	for _, p := range policies {
+		if !p.Applies(planned, act.Name) {
+			continue
+		}
+
+		message := p.Message
+		allowed, err := policy.Evaluate(p.Expression, in)
+		if err != nil {
+			message = "policy could not be evaluated: " + err.Error()
+		} else if allowed {
+			continue
+		}
+
+		v := policy.Violation{PolicyID: p.ID, Policy: p.Name, Mode: p.Mode, Message: message}
+		if p.Mode == policy.ModeDeny {
+			denied = append(denied, v)
+		} else {
+			warnings = append(warnings, v)
+		}
+	}
+
+	if len(denied) == 0 {
+		return warnings, nil
+	}
+
+	details := make([]errors.Violation, 0, len(denied))
+	for _, v := range denied {
+		details = append(details, errors.Violation{
+			Type:        violationTypePolicy,
+			Subject:     v.Policy,
+			Description: v.Message,
+		})
+	}
+	return nil, errors.ErrInvalid.
+		WithMsgf("'%s' on resource '%s' denied by policy '%s': %s", act.Name, planned.URN, denied[0].Policy, denied[0].Message).
+		WithViolations(details...)
+}
+
+// recordPolicyWarnings records the warn policies violated by an applied
+// change.
+func (svc *Service) recordPolicyWarnings(ctx context.Context, res resource.Resource, userID string, warnings []policy.Violation) {
+	policyIDs := make([]string, 0, len(warnings))
+	for _, v := range warnings {
+		policyIDs = append(policyIDs, strconv.FormatInt(v.PolicyID, 10))
+	}
+
+	zap.L().Warn("change violates warn policies",
+		zap.String("resource_urn", res.URN),
+		zap.Strings("policy_ids", policyIDs),
+	)
+
+	params, _ := json.Marshal(map[string]any{"violations": warnings})
+	svc.recordAudit(ctx, res, userID, auditActionPolicyWarning, params, nil)
+}
@@ -0,0 +1,166 @@
+package policy
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/common/types"
+	"github.com/google/cel-go/common/types/ref"
+	"github.com/google/cel-go/ext"
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// evalCostLimit bounds the work a single evaluation may do, so that a
+// policy cannot stall every change.
+const evalCostLimit = 100000
+
+var (
+	envOnce sync.Once
+	env     *cel.Env
+	envErr  error
+
+	// programs caches the compiled programs by expression.
+	programs sync.Map
+)
+
+// Input is what a policy is evaluated against.
+type Input struct {
+	// Planned is the resource as it would be stored after the change.
+	Planned resource.Resource
+
+	// Current is the resource before the change, nil on create.
+	Current *resource.Resource
+
+	Action module.ActionRequest
+}
+
+// Evaluate returns true if the input satisfies the policy. The expression
+// sees the variables:
+//
+//   - resource: the planned resource (urn, kind, name, project, labels,
+//     configs, dependencies).
+//   - current: the resource before the change, null on create.
+//   - action: the requested action (name, params, labels, user_id).
+//
+// Besides the standard CEL functions and the string extensions,
+// quantity(string) parses a Kubernetes quantity (e.g. "500m", "1Gi") into
+// a number so that cpu and memory values can be compared.
+func Evaluate(expression string, in Input) (bool, error) {
+	prg, err := compile(expression)
+	if err != nil {
+		return false, err
+	}
+
+	var current any
+	if in.Current != nil {
+		current = resourceVars(*in.Current)
+	}
+
+	out, _, err := prg.Eval(map[string]any{
+		"resource": resourceVars(in.Planned),
+		"current":  current,
+		"action": map[string]any{
+			"name":    in.Action.Name,
+			"params":  decodeJSON(in.Action.Params),
+			"labels":  stringMap(in.Action.Labels),
+			"user_id": in.Action.UserID,
+		},
+	})
+	if err != nil {
+		return false, err
+	}
+
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		return false, errors.ErrInvalid.WithMsgf("expression did not evaluate to a bool")
+	}
+	return allowed, nil
+}
+
+func compile(expression string) (cel.Program, error) {
+	if prg, ok := programs.Load(expression); ok {
+		return prg.(cel.Program), nil
+	}
+
+	envOnce.Do(func() { env, envErr = newEnv() })
+	if envErr != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", envErr.Error())
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues != nil && issues.Err() != nil {
+		return nil, errors.ErrInvalid.WithMsgf("invalid expression: %s", issues.Err().Error())
+	} else if ast.OutputType() != cel.BoolType && ast.OutputType() != cel.DynType {
+		return nil, errors.ErrInvalid.WithMsgf("expression must evaluate to a bool, not %s", ast.OutputType())
+	}
+
+	prg, err := env.Program(ast, cel.CostLimit(evalCostLimit))
+	if err != nil {
+		return nil, errors.ErrInvalid.WithMsgf("invalid expression: %s", err.Error())
+	}
+
+	programs.Store(expression, prg)
+	return prg, nil
+}
+
+func newEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.Variable("resource", cel.DynType),
+		cel.Variable("current", cel.DynType),
+		cel.Variable("action", cel.DynType),
+		cel.CrossTypeNumericComparisons(true),
+		ext.Strings(),
+		cel.Function("quantity",
+			cel.Overload("quantity_string", []*cel.Type{cel.StringType}, cel.DoubleType,
+				cel.UnaryBinding(parseQuantity),
+			),
+		),
+	)
+}
+
+func parseQuantity(arg ref.Val) ref.Val {
+	s, ok := arg.Value().(string)
+	if !ok {
+		return types.MaybeNoSuchOverloadErr(arg)
+	}
+
+	q, err := k8sresource.ParseQuantity(s)
+	if err != nil {
+		return types.NewErr("invalid quantity '%s'", s)
+	}
+	return types.Double(q.AsApproximateFloat64())
+}
+
+func resourceVars(res resource.Resource) map[string]any {
+	return map[string]any{
+		"urn":          res.URN,
+		"kind":         res.Kind,
+		"name":         res.Name,
+		"project":      res.Project,
+		"labels":       stringMap(res.Labels),
+		"configs":      decodeJSON(res.Spec.Configs),
+		"dependencies": stringMap(res.Spec.Dependencies),
+	}
+}
+
+// decodeJSON returns the JSON value as plain maps, lists and scalars, or
+// an empty map if there is none.
+func decodeJSON(raw json.RawMessage) any {
+	var v any
+	if len(raw) == 0 || json.Unmarshal(raw, &v) != nil || v == nil {
+		return map[string]any{}
+	}
+	return v
+}
+
+func stringMap(m map[string]string) map[string]string {
+	if m == nil {
+		return map[string]string{}
+	}
+	return m
+}
@@ -0,0 +1,103 @@
+package policy
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	// ModeDeny policies reject the changes they are violated by.
+	ModeDeny = "deny"
+
+	// ModeWarn policies only report the changes they are violated by.
+	ModeWarn = "warn"
+)
+
+// Store is implemented by storage backends that can keep admission
+// policies. Changes are checked against policies only if the store
+// implements it.
+type Store interface {
+	// CreatePolicy stores the policy and returns it with the ID assigned.
+	CreatePolicy(ctx context.Context, p Policy) (*Policy, error)
+	GetPolicy(ctx context.Context, id int64) (*Policy, error)
+	ListPolicies(ctx context.Context) ([]Policy, error)
+	UpdatePolicy(ctx context.Context, p Policy) error
+	DeletePolicy(ctx context.Context, id int64) error
+}
+
+// Policy is a rule that every planned change to the resources in its
+// scope must satisfy. Expression is a CEL expression that evaluates to
+// true if the change is allowed (see Evaluate for the variables). An empty
+// Project, Kind or Actions matches all of them, except that delete actions
+// are checked only if listed explicitly.
+type Policy struct {
+	ID          int64     `json:"id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description,omitempty"`
+	Project     string    `json:"project,omitempty"`
+	Kind        string    `json:"kind,omitempty"`
+	Actions     []string  `json:"actions,omitempty"`
+	Expression  string    `json:"expression"`
+	Message     string    `json:"message"`
+	Mode        string    `json:"mode"`
+	Disabled    bool      `json:"disabled,omitempty"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+	CreatedBy   string    `json:"created_by"`
+	UpdatedBy   string    `json:"updated_by"`
+}
+
+// Filter selects the policies that can apply to the resources of a
+// project and kind. Empty fields match every policy.
+type Filter struct {
+	Project string `json:"project,omitempty"`
+	Kind    string `json:"kind,omitempty"`
+}
+
+// Violation is a policy that a change does not satisfy.
+type Violation struct {
+	PolicyID int64  `json:"policy_id"`
+	Policy   string `json:"policy"`
+	Mode     string `json:"mode"`
+	Message  string `json:"message"`
+}
+
+// Validate checks the policy and compiles its expression.
+func (p Policy) Validate() error {
+	if p.Name == "" {
+		return errors.ErrInvalid.WithMsgf("name must be set")
+	} else if p.Expression == "" {
+		return errors.ErrInvalid.WithMsgf("expression must be set")
+	} else if p.Mode != ModeDeny && p.Mode != ModeWarn {
+		return errors.ErrInvalid.WithMsgf("mode must be one of '%s' and '%s'", ModeDeny, ModeWarn)
+	}
+
+	_, err := compile(p.Expression)
+	return err
+}
+
+// Applies returns true if the policy checks the action on the resource.
+func (p Policy) Applies(res resource.Resource, action string) bool {
+	if p.Disabled {
+		return false
+	} else if p.Project != "" && p.Project != res.Project {
+		return false
+	} else if p.Kind != "" && p.Kind != res.Kind {
+		return false
+	}
+
+	if len(p.Actions) == 0 {
+		return action != module.DeleteAction
+	}
+	return slices.Contains(p.Actions, action)
+}
+
+func (f Filter) Matches(p Policy) bool {
+	return (f.Project == "" || p.Project == "" || f.Project == p.Project) &&
+		(f.Kind == "" || p.Kind == "" || f.Kind == p.Kind)
+}
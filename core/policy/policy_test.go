@@ -0,0 +1,127 @@
+package policy_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestEvaluate(t *testing.T) {
+	t.Parallel()
+
+	planned := resource.Resource{
+		URN:     "orn:entropy:dagger:project:job",
+		Kind:    "dagger",
+		Name:    "job",
+		Project: "project",
+		Labels:  map[string]string{"env": "production"},
+		Spec: resource.Spec{
+			Configs: []byte(`{"replicas":12,"requests":{"memory":"2Gi"},"chart_values":{"image_tag":"v1.2"}}`),
+		},
+	}
+	current := planned
+	current.Spec.Configs = []byte(`{"replicas":4}`)
+
+	tests := []struct {
+		name       string
+		expression string
+		in         policy.Input
+		want       bool
+		wantErr    bool
+	}{
+		{
+			name:       "Quantity",
+			expression: `quantity(resource.configs.requests.memory) >= quantity("1Gi")`,
+			in:         policy.Input{Planned: planned},
+			want:       true,
+		},
+		{
+			name:       "NumericComparison",
+			expression: `resource.configs.replicas <= 8`,
+			in:         policy.Input{Planned: planned},
+			want:       false,
+		},
+		{
+			name:       "StringFunctions",
+			expression: `!resource.configs.chart_values.image_tag.endsWith("latest")`,
+			in:         policy.Input{Planned: planned},
+			want:       true,
+		},
+		{
+			name:       "CurrentAndAction",
+			expression: `action.name == "scale" && resource.configs.replicas <= current.configs.replicas * 4.0`,
+			in:         policy.Input{Planned: planned, Current: &current, Action: module.ActionRequest{Name: "scale"}},
+			want:       true,
+		},
+		{
+			name:       "NoCurrentOnCreate",
+			expression: `current == null && resource.labels.env == "production"`,
+			in:         policy.Input{Planned: planned},
+			want:       true,
+		},
+		{
+			name:       "MissingField",
+			expression: `resource.configs.requests.cpu == "1"`,
+			in:         policy.Input{Planned: planned},
+			wantErr:    true,
+		},
+		{
+			name:       "InvalidQuantity",
+			expression: `quantity("lots") > 1.0`,
+			in:         policy.Input{Planned: planned},
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := policy.Evaluate(tt.expression, tt.in)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPolicy_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := policy.Policy{Name: "p", Expression: "resource.kind == 'firehose'", Mode: policy.ModeDeny}
+	assert.NoError(t, valid.Validate())
+
+	for name, p := range map[string]policy.Policy{
+		"NoName":    {Expression: "true", Mode: policy.ModeDeny},
+		"NoMode":    {Name: "p", Expression: "true"},
+		"Syntax":    {Name: "p", Expression: "resource.", Mode: policy.ModeWarn},
+		"NotBool":   {Name: "p", Expression: "1 + 2", Mode: policy.ModeWarn},
+		"UnknownFn": {Name: "p", Expression: "frobnicate(resource)", Mode: policy.ModeWarn},
+	} {
+		assert.ErrorIs(t, p.Validate(), errors.ErrInvalid, name)
+	}
+}
+
+func TestPolicy_Applies(t *testing.T) {
+	t.Parallel()
+
+	res := resource.Resource{Kind: "firehose", Project: "production"}
+
+	assert.True(t, policy.Policy{}.Applies(res, module.CreateAction))
+	assert.False(t, policy.Policy{}.Applies(res, module.DeleteAction))
+	assert.True(t, policy.Policy{Actions: []string{module.DeleteAction}}.Applies(res, module.DeleteAction))
+	assert.False(t, policy.Policy{Actions: []string{"scale"}}.Applies(res, module.UpdateAction))
+	assert.False(t, policy.Policy{Kind: "dagger"}.Applies(res, module.CreateAction))
+	assert.False(t, policy.Policy{Project: "staging"}.Applies(res, module.CreateAction))
+	assert.False(t, policy.Policy{Disabled: true}.Applies(res, module.CreateAction))
+}
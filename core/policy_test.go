@@ -0,0 +1,161 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_Policies(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			if act.Name == module.CreateAction || act.Name == module.UpdateAction {
+				planned.Spec.Configs = act.Params
+			}
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+	mod.EXPECT().
+		DescribeEffects(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil)
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	ctx := context.Background()
+	_, err = svc.CreatePolicy(ctx, policy.Policy{
+		Name:       "min-memory",
+		Project:    "production",
+		Kind:       "firehose",
+		Expression: `quantity(resource.configs.requests.memory) >= quantity("1Gi")`,
+		Message:    "firehoses must request at least 1Gi of memory",
+		Mode:       policy.ModeDeny,
+		CreatedBy:  "john",
+	})
+	require.NoError(t, err)
+
+	noLatest, err := svc.CreatePolicy(ctx, policy.Policy{
+		Name:       "no-latest",
+		Expression: `!resource.configs.image.endsWith(":latest")`,
+		Message:    "images should be pinned",
+		Mode:       policy.ModeWarn,
+		CreatedBy:  "john",
+	})
+	require.NoError(t, err)
+
+	firehose := func(name, configs string) resource.Resource {
+		return resource.Resource{
+			Kind:    "firehose",
+			Name:    name,
+			Project: "production",
+			Spec:    resource.Spec{Configs: []byte(configs)},
+		}
+	}
+
+	t.Run("Deny", func(t *testing.T) {
+		_, err := svc.CreateResource(ctx, firehose("small", `{"image":"app:1.0","requests":{"memory":"512Mi"}}`))
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+		assert.Contains(t, err.Error(), "denied by policy 'min-memory'")
+
+		var e errors.Error
+		require.True(t, errors.As(err, &e))
+		assert.Equal(t, []errors.Violation{{
+			Type:        "POLICY",
+			Subject:     "min-memory",
+			Description: "firehoses must request at least 1Gi of memory",
+		}}, e.Violations)
+
+		// the policy is scoped to the production project.
+		staging := firehose("small", `{"image":"app:1.0","requests":{"memory":"512Mi"}}`)
+		staging.Project = "staging"
+		_, err = svc.CreateResource(ctx, staging)
+		assert.NoError(t, err)
+	})
+
+	t.Run("Warn", func(t *testing.T) {
+		plan := &core.Plan{}
+		_, err := svc.CreateResource(ctx, firehose("big", `{"image":"app:latest","requests":{"memory":"2Gi"}}`), core.WithPlan(plan))
+		require.NoError(t, err)
+		assert.Equal(t, []policy.Violation{{
+			PolicyID: noLatest.ID,
+			Policy:   "no-latest",
+			Mode:     policy.ModeWarn,
+			Message:  "images should be pinned",
+		}}, plan.Warnings)
+
+		created, err := svc.CreateResource(ctx, firehose("big", `{"image":"app:latest","requests":{"memory":"2Gi"}}`))
+		require.NoError(t, err)
+
+		events, err := svc.ListAuditEvents(ctx, audit.Filter{URN: created.URN})
+		require.NoError(t, err)
+		var actions []string
+		for _, ev := range events {
+			actions = append(actions, ev.Action)
+		}
+		assert.Contains(t, actions, "policy_warning")
+
+		// deletes are checked only by the policies that list them.
+		assert.NoError(t, svc.DeleteResource(ctx, created.URN))
+	})
+
+	t.Run("EvaluationError", func(t *testing.T) {
+		// a missing field cannot be evaluated and counts as a violation.
+		_, err := svc.CreateResource(ctx, firehose("unset", `{"image":"app:1.0"}`))
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+		assert.Contains(t, err.Error(), "policy could not be evaluated")
+	})
+
+	t.Run("CRUD", func(t *testing.T) {
+		_, err := svc.CreatePolicy(ctx, policy.Policy{Name: "no-latest", Expression: "true", Mode: policy.ModeWarn})
+		assert.ErrorIs(t, err, errors.ErrConflict)
+
+		_, err = svc.CreatePolicy(ctx, policy.Policy{Name: "broken", Expression: "resource.", Mode: policy.ModeDeny})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		policies, err := svc.ListPolicies(ctx, policy.Filter{Project: "staging"})
+		require.NoError(t, err)
+		require.Len(t, policies, 1)
+		assert.Equal(t, "no-latest", policies[0].Name)
+
+		update := *noLatest
+		update.Disabled = true
+		update.UpdatedBy = "jane"
+		updated, err := svc.UpdatePolicy(ctx, update)
+		require.NoError(t, err)
+		assert.True(t, updated.Disabled)
+		assert.Equal(t, "john", updated.CreatedBy)
+
+		require.NoError(t, svc.DeletePolicy(ctx, noLatest.ID))
+		_, err = svc.GetPolicy(ctx, noLatest.ID)
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.ListPolicies(ctx, policy.Filter{})
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+}
@@ -0,0 +1,77 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+)
+
+const reasonDependencyChanged = "dependency_changed"
+
+// propagateOutputChange notifies the direct dependents of the resource that
+// its output has changed. Dependents of kinds that opted in through their
+// module descriptor are either re-synced or get the module-defined action
+// applied. The changed output must already be persisted so that dependents
+// resolve the new value. Failures are logged and do not stop propagation to
+// the remaining dependents.
+func (svc *Service) propagateOutputChange(ctx context.Context, res resource.Resource) {
+	// whoever caused the change may not be allowed to act on the
+	// dependents, the reaction is on behalf of the system.
+	ctx = rbac.WithoutPrincipal(ctx)
+	logEntry := zap.L().With(zap.String("resource_urn", res.URN))
+
+	dependents, err := svc.GetDependents(ctx, res.URN, false)
+	if err != nil {
+		logEntry.Warn("failed to list dependents for output change", zap.Error(err))
+		return
+	}
+
+	for _, dep := range dependents {
+		if err := svc.notifyDependent(ctx, res, dep); err != nil {
+			logEntry.Warn("failed to propagate output change to dependent",
+				zap.String("dependent_urn", dep.URN),
+				zap.Error(err),
+			)
+		}
+	}
+}
+
+func (svc *Service) notifyDependent(ctx context.Context, changed, dep resource.Resource) error {
+	policy, err := svc.moduleSvc.GetDependencyChangePolicy(ctx, module.ExpandedResource{Resource: dep})
+	if err != nil {
+		return err
+	} else if policy == nil {
+		return nil
+	}
+
+	if !dep.State.IsTerminal() {
+		// an ongoing sync resolves dependencies afresh and will pick up
+		// the new output anyway.
+		return nil
+	}
+
+	if policy.Action != "" {
+		_, err := svc.execAction(ctx, dep, module.ActionRequest{
+			Name:   policy.Action,
+			UserID: changed.UpdatedBy,
+		}, Options{})
+		return err
+	}
+
+	now := svc.clock()
+	dep.State.Status = resource.StatusPending
+	dep.State.NextSyncAt = &now
+	return svc.upsert(ctx, dep, false, false, reasonDependencyChanged)
+}
+
+// outputChanged returns true if a previously set output differs from the
+// new one. Setting the output for the first time is not considered a change.
+func outputChanged(prev, cur json.RawMessage) bool {
+	return len(prev) > 0 && !bytes.Equal(prev, cur)
+}
@@ -0,0 +1,193 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+)
+
+func TestService_PropagateOutputChange(t *testing.T) {
+	t.Parallel()
+
+	const clusterURN = "orn:entropy:kubernetes:project:cluster"
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	fixtures := []resource.Resource{
+		{URN: clusterURN, Kind: "kubernetes", Name: "cluster", Project: "project"},
+		{URN: "orn:entropy:firehose:project:fh", Kind: "firehose", Name: "fh", Project: "project"},
+		{URN: "orn:entropy:dagger:project:dg", Kind: "dagger", Name: "dg", Project: "project"},
+		{URN: "orn:entropy:job:project:job", Kind: "job", Name: "job", Project: "project"},
+	}
+	for i, res := range fixtures {
+		res.State = resource.State{Status: resource.StatusCompleted, Output: json.RawMessage(`{"token":"old"}`)}
+		if i > 0 {
+			res.Spec.Dependencies = map[string]string{"kube_cluster": clusterURN}
+		}
+		require.NoError(t, store.Create(ctx, res))
+	}
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource) (json.RawMessage, error) {
+			return res.State.Output, nil
+		})
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			if act.Name == module.UpdateAction {
+				planned.State.Output = json.RawMessage(`{"token":"new"}`)
+				return &planned, nil
+			}
+			planned.State = resource.State{Status: resource.StatusPending, Output: res.State.Output}
+			return &planned, nil
+		})
+	mod.EXPECT().
+		GetDependencyChangePolicy(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource) (*module.DependencyChangePolicy, error) {
+			switch res.Kind {
+			case "firehose":
+				return &module.DependencyChangePolicy{Action: "dependency_changed"}, nil
+			case "dagger":
+				return &module.DependencyChangePolicy{}, nil
+			default:
+				return nil, nil
+			}
+		})
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	_, err = svc.ApplyAction(ctx, clusterURN, module.ActionRequest{
+		Name:   module.UpdateAction,
+		Params: json.RawMessage(`{}`),
+		UserID: "john",
+	})
+	require.NoError(t, err)
+
+	// firehose opted in with an action.
+	fh, err := store.GetByURN(ctx, "orn:entropy:firehose:project:fh")
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusPending, fh.State.Status)
+	assert.Equal(t, "john", fh.UpdatedBy)
+
+	revs, err := store.Revisions(ctx, resource.RevisionsSelector{URN: fh.URN})
+	require.NoError(t, err)
+	assert.Equal(t, "action:dependency_changed", revs[0].Reason)
+
+	// dagger opted in for a plain re-sync.
+	dg, err := store.GetByURN(ctx, "orn:entropy:dagger:project:dg")
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusPending, dg.State.Status)
+	require.NotNil(t, dg.State.NextSyncAt)
+	assert.Equal(t, frozenTime, *dg.State.NextSyncAt)
+
+	// job did not opt in.
+	job, err := store.GetByURN(ctx, "orn:entropy:job:project:job")
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusCompleted, job.State.Status)
+}
+
+func TestService_PropagateOutputChange_Sync(t *testing.T) {
+	t.Parallel()
+
+	const clusterURN = "orn:entropy:kubernetes:project:cluster"
+	const daggerURN = "orn:entropy:dagger:project:dg"
+
+	store, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, store.Create(ctx, resource.Resource{
+		URN: clusterURN, Kind: "kubernetes", Name: "cluster", Project: "project",
+		State: resource.State{
+			Status:     resource.StatusPending,
+			Output:     json.RawMessage(`{"token":"old"}`),
+			NextSyncAt: &frozenTime,
+		},
+	}))
+	require.NoError(t, store.Create(ctx, resource.Resource{
+		URN: daggerURN, Kind: "dagger", Name: "dg", Project: "project",
+		Spec:  resource.Spec{Dependencies: map[string]string{"kube_cluster": clusterURN}},
+		State: resource.State{Status: resource.StatusCompleted, Output: json.RawMessage(`{}`)},
+	}))
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource) (json.RawMessage, error) {
+			return res.State.Output, nil
+		})
+	mod.EXPECT().
+		GetDependencyChangePolicy(mock.Anything, mock.Anything).
+		Return(&module.DependencyChangePolicy{}, nil)
+
+	// the output changes on the first step, the dependent is notified only
+	// after the last one.
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.MatchedBy(func(res module.ExpandedResource) bool { return res.URN == clusterURN })).
+		Return(&resource.State{
+			Status:     resource.StatusPending,
+			Output:     json.RawMessage(`{"token":"new"}`),
+			NextSyncAt: &frozenTime,
+		}, nil).
+		Once()
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.MatchedBy(func(res module.ExpandedResource) bool { return res.URN == clusterURN })).
+		RunAndReturn(func(_ context.Context, _ module.ExpandedResource) (*resource.State, error) {
+			dg, err := store.GetByURN(ctx, daggerURN)
+			require.NoError(t, err)
+			assert.Equal(t, resource.StatusCompleted, dg.State.Status)
+			return &resource.State{Status: resource.StatusCompleted, Output: json.RawMessage(`{"token":"new"}`)}, nil
+		}).
+		Once()
+	var daggerSynced atomic.Bool
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.MatchedBy(func(res module.ExpandedResource) bool { return res.URN == daggerURN })).
+		RunAndReturn(func(_ context.Context, _ module.ExpandedResource) (*resource.State, error) {
+			// the dependent is notified once the completed cluster is saved.
+			cluster, err := store.GetByURN(ctx, clusterURN)
+			require.NoError(t, err)
+			assert.Equal(t, resource.StatusCompleted, cluster.State.Status)
+			daggerSynced.Store(true)
+			return &resource.State{Status: resource.StatusCompleted, Output: json.RawMessage(`{}`)}, nil
+		}).
+		Once()
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eg := &errgroup.Group{}
+	svc.RunSyncer(syncCtx, 1, 5*time.Millisecond, time.Second, nil, eg)
+
+	assert.Eventually(t, daggerSynced.Load, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, eg.Wait(), context.Canceled)
+	mod.AssertExpectations(t)
+
+	cluster, err := store.GetByURN(ctx, clusterURN)
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusCompleted, cluster.State.Status)
+	assert.False(t, cluster.State.OutputChanged)
+	// every sync saves the cluster once.
+	assert.Equal(t, int64(3), cluster.Version)
+}
@@ -0,0 +1,179 @@
+package core
+
+import (
+	"context"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const quotaListPageSize = 100
+
+// WithQuotas enforces the quotas on every change planned by the service.
+func WithQuotas(cfg quota.Config) ServiceOption {
+	return func(svc *Service) {
+		svc.quotas = &cfg
+	}
+}
+
+// GetProjectQuota returns the usage of the project against its limits.
+// Limits are zero (unlimited) if no quotas are configured.
+func (svc *Service) GetProjectQuota(ctx context.Context, project string) (*quota.Report, error) {
+	var limits quota.ProjectLimits
+	if svc.quotas != nil {
+		limits = svc.quotas.ForProject(project)
+	}
+
+	resources, err := svc.projectResources(ctx, project)
+	if err != nil {
+		return nil, err
+	}
+
+	total, byKind, err := measureAll(resources)
+	if err != nil {
+		return nil, err
+	}
+
+	report := &quota.Report{
+		Project: project,
+		Limit:   limits.Limit,
+		Usage:   total,
+		Kinds:   map[string]quota.Kind{},
+	}
+	for kind, usage := range byKind {
+		report.Kinds[kind] = quota.Kind{Limit: limits.Kinds[kind], Usage: usage}
+	}
+	for kind, limit := range limits.Kinds {
+		if _, ok := report.Kinds[kind]; !ok {
+			report.Kinds[kind] = quota.Kind{Limit: limit}
+		}
+	}
+	return report, nil
+}
+
+// checkQuota rejects the planned change if it takes the project over one
+// of its limits. Deletes are never rejected.
+func (svc *Service) checkQuota(ctx context.Context, cur, planned resource.Resource, actionName string) error {
+	if svc.quotas == nil || actionName == module.DeleteAction {
+		return nil
+	}
+
+	limits := svc.quotas.ForProject(planned.Project)
+	kindLimit := limits.Kinds[planned.Kind]
+	if limits.Limit.IsZero() && kindLimit.IsZero() {
+		return nil
+	}
+
+	resources, err := svc.projectResources(ctx, planned.Project)
+	if err != nil {
+		return err
+	}
+
+	var before, after []resource.Resource
+	for _, res := range resources {
+		if res.URN != planned.URN {
+			before = append(before, res)
+			after = append(after, res)
+		}
+	}
+	if !isCreate(actionName) {
+		before = append(before, cur)
+	}
+	after = append(after, planned)
+
+	totalBefore, kindsBefore, err := measureAll(before)
+	if err != nil {
+		return err
+	}
+
+	totalAfter, kindsAfter, err := measureAll(after)
+	if err != nil {
+		return err
+	}
+
+	// the replica limit applies to every resource on its own, so only the
+	// replicas of the changed resource are checked.
+	replicasBefore := 0
+	if !isCreate(actionName) {
+		curUsage, err := quota.Measure(cur)
+		if err != nil {
+			return err
+		}
+		replicasBefore = curUsage.Replicas
+	}
+	plannedUsage, err := quota.Measure(planned)
+	if err != nil {
+		return err
+	}
+
+	kindBefore, kindAfter := kindsBefore[planned.Kind], kindsAfter[planned.Kind]
+	totalBefore.Replicas, kindBefore.Replicas = replicasBefore, replicasBefore
+	totalAfter.Replicas, kindAfter.Replicas = plannedUsage.Replicas, plannedUsage.Replicas
+
+	if err := limits.Limit.Check("project '"+planned.Project+"'", totalBefore, totalAfter); err != nil {
+		return err
+	}
+	return kindLimit.Check(planned.Kind+" resources of project '"+planned.Project+"'", kindBefore, kindAfter)
+}
+
+// enforceQuota returns the hooks that check the quotas again within the
+// write of the planned change, with the writes to the project held back
+// so that concurrent changes cannot together exceed them. No hooks are
+// returned if the store cannot hold the writes back, leaving the check of
+// planChange as the only one.
+func (svc *Service) enforceQuota(cur, planned resource.Resource, actionName string) []resource.MutationHook {
+	quotaStore, ok := svc.store.(quota.Store)
+	if !ok || svc.quotas == nil || actionName == module.DeleteAction {
+		return nil
+	}
+
+	limits := svc.quotas.ForProject(planned.Project)
+	if limits.Limit.IsZero() && limits.Kinds[planned.Kind].IsZero() {
+		return nil
+	}
+
+	recheck := func(ctx context.Context) error {
+		if err := quotaStore.LockProject(ctx, planned.Project); err != nil {
+			return errors.ErrInternal.WithMsgf("failed to lock project").WithCausef("%s", err.Error())
+		}
+		return svc.checkQuota(ctx, cur, planned, actionName)
+	}
+	return []resource.MutationHook{recheck}
+}
+
+func (svc *Service) projectResources(ctx context.Context, project string) ([]resource.Resource, error) {
+	filter := resource.Filter{
+		Project:  project,
+		PageSize: quotaListPageSize,
+	}
+
+	var resources []resource.Resource
+	for {
+		page, err := svc.ListResources(ctx, filter, true)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, page.Resources...)
+
+		if page.NextPageToken == "" {
+			return resources, nil
+		}
+		filter.PageToken = page.NextPageToken
+	}
+}
+
+func measureAll(resources []resource.Resource) (quota.Usage, map[string]quota.Usage, error) {
+	var total quota.Usage
+	byKind := map[string]quota.Usage{}
+	for _, res := range resources {
+		usage, err := quota.Measure(res)
+		if err != nil {
+			return quota.Usage{}, nil, err
+		}
+		total = total.Add(usage)
+		byKind[res.Kind] = byKind[res.Kind].Add(usage)
+	}
+	return total, byKind, nil
+}
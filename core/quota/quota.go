@@ -0,0 +1,312 @@
+package quota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	k8sresource "k8s.io/apimachinery/pkg/api/resource"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	kindFirehose = "firehose"
+	kindDagger   = "dagger"
+	kindJob      = "job"
+)
+
+// Store is implemented by stores that can serialise the writes to a
+// project. Quotas are checked again within the write only if the store
+// implements it, otherwise concurrent changes may together exceed them.
+type Store interface {
+	// LockProject holds back the writes to the project that lock it too
+	// until the write whose hooks call it is committed.
+	LockProject(ctx context.Context, project string) error
+}
+
+// Config holds the quotas of all projects. Limits of a project override
+// the default limits field by field, and the limits of a kind within a
+// project override the default limits of that kind.
+type Config struct {
+	Default  ProjectLimits            `mapstructure:"default" json:"default"`
+	Projects map[string]ProjectLimits `mapstructure:"projects" json:"projects,omitempty"`
+}
+
+// ProjectLimits are the limits on all the resources of a project along
+// with the limits on the resources of each kind.
+type ProjectLimits struct {
+	Limit `mapstructure:",squash"`
+	Kinds map[string]Limit `mapstructure:"kinds" json:"kinds,omitempty"`
+}
+
+// Limit caps a group of resources. MaxReplicas applies to every resource
+// on its own while the others apply to the group as a whole. CPU and memory
+// are Kubernetes quantities (e.g. "500m", "4Gi") summed over the requests
+// of the resources. Zero values are unlimited.
+type Limit struct {
+	MaxResources int    `mapstructure:"max_resources" json:"max_resources,omitempty"`
+	MaxReplicas  int    `mapstructure:"max_replicas" json:"max_replicas,omitempty"`
+	MaxCPU       string `mapstructure:"max_cpu" json:"max_cpu,omitempty"`
+	MaxMemory    string `mapstructure:"max_memory" json:"max_memory,omitempty"`
+}
+
+// Usage is what a group of resources consumes. Replicas is the largest
+// replica count of a single resource.
+type Usage struct {
+	Resources   int   `json:"resources"`
+	Replicas    int   `json:"replicas"`
+	CPUMillis   int64 `json:"cpu_millis"`
+	MemoryBytes int64 `json:"memory_bytes"`
+}
+
+// Report is the usage of a project against its limits, in total and per
+// kind.
+type Report struct {
+	Project string          `json:"project"`
+	Limit   Limit           `json:"limit"`
+	Usage   Usage           `json:"usage"`
+	Kinds   map[string]Kind `json:"kinds,omitempty"`
+}
+
+type Kind struct {
+	Limit Limit `json:"limit"`
+	Usage Usage `json:"usage"`
+}
+
+func (c Config) Validate() error {
+	if err := c.Default.validate(); err != nil {
+		return errors.ErrInvalid.WithMsgf("default quota: %s", err.Error())
+	}
+	for project, limits := range c.Projects {
+		if err := limits.validate(); err != nil {
+			return errors.ErrInvalid.WithMsgf("quota of project '%s': %s", project, err.Error())
+		}
+	}
+	return nil
+}
+
+// ForProject returns the effective limits of the project.
+func (c Config) ForProject(project string) ProjectLimits {
+	limits := ProjectLimits{
+		Limit: c.Default.Limit,
+		Kinds: map[string]Limit{},
+	}
+	for kind, l := range c.Default.Kinds {
+		limits.Kinds[kind] = l
+	}
+
+	override, ok := c.Projects[project]
+	if !ok {
+		return limits
+	}
+
+	limits.Limit = limits.Limit.merge(override.Limit)
+	for kind, l := range override.Kinds {
+		limits.Kinds[kind] = limits.Kinds[kind].merge(l)
+	}
+	return limits
+}
+
+func (pl ProjectLimits) validate() error {
+	if err := pl.Limit.validate(); err != nil {
+		return err
+	}
+	for kind, l := range pl.Kinds {
+		if err := l.validate(); err != nil {
+			return fmt.Errorf("kind '%s': %w", kind, err)
+		}
+	}
+	return nil
+}
+
+// IsZero returns true if the limit does not cap anything.
+func (l Limit) IsZero() bool {
+	return l == Limit{}
+}
+
+// Check returns ErrQuotaExceeded if going from the usage before to after
+// breaks the limit. A usage that is already over the limit is accepted as
+// long as it does not grow, so that lowering a limit does not block the
+// changes bringing a project back under it.
+func (l Limit) Check(scope string, before, after Usage) error {
+	exceeded := func(what string, limit, prev, next int64, format func(int64) string) error {
+		if limit <= 0 || next <= limit || next <= prev {
+			return nil
+		}
+		return errors.ErrQuotaExceeded.
+			WithMsgf("%s quota exceeded for %s: %s requested, limit is %s", what, scope, format(next), format(limit))
+	}
+
+	plain := func(v int64) string { return fmt.Sprint(v) }
+	cpu := func(v int64) string { return k8sresource.NewMilliQuantity(v, k8sresource.DecimalSI).String() }
+	memory := func(v int64) string { return k8sresource.NewQuantity(v, k8sresource.BinarySI).String() }
+
+	maxCPU, _ := parseMillis(l.MaxCPU)
+	maxMemory, _ := parseBytes(l.MaxMemory)
+
+	checks := []error{
+		exceeded("resource count", int64(l.MaxResources), int64(before.Resources), int64(after.Resources), plain),
+		exceeded("replica", int64(l.MaxReplicas), int64(before.Replicas), int64(after.Replicas), plain),
+		exceeded("cpu", maxCPU, before.CPUMillis, after.CPUMillis, cpu),
+		exceeded("memory", maxMemory, before.MemoryBytes, after.MemoryBytes, memory),
+	}
+	for _, err := range checks {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l Limit) validate() error {
+	if l.MaxResources < 0 || l.MaxReplicas < 0 {
+		return errors.New("limits must not be negative")
+	}
+	if _, err := parseMillis(l.MaxCPU); err != nil {
+		return err
+	}
+	_, err := parseBytes(l.MaxMemory)
+	return err
+}
+
+func (l Limit) merge(override Limit) Limit {
+	if override.MaxResources != 0 {
+		l.MaxResources = override.MaxResources
+	}
+	if override.MaxReplicas != 0 {
+		l.MaxReplicas = override.MaxReplicas
+	}
+	if override.MaxCPU != "" {
+		l.MaxCPU = override.MaxCPU
+	}
+	if override.MaxMemory != "" {
+		l.MaxMemory = override.MaxMemory
+	}
+	return l
+}
+
+// Add returns the usage of both groups of resources together.
+func (u Usage) Add(other Usage) Usage {
+	return Usage{
+		Resources:   u.Resources + other.Resources,
+		Replicas:    max(u.Replicas, other.Replicas),
+		CPUMillis:   u.CPUMillis + other.CPUMillis,
+		MemoryBytes: u.MemoryBytes + other.MemoryBytes,
+	}
+}
+
+// CPU returns the cpu usage as a Kubernetes quantity.
+func (u Usage) CPU() string {
+	return k8sresource.NewMilliQuantity(u.CPUMillis, k8sresource.DecimalSI).String()
+}
+
+// Memory returns the memory usage as a Kubernetes quantity.
+func (u Usage) Memory() string {
+	return k8sresource.NewQuantity(u.MemoryBytes, k8sresource.BinarySI).String()
+}
+
+type computeConfig struct {
+	Stopped    bool           `json:"stopped"`
+	Replicas   int            `json:"replicas"`
+	Requests   computeUnits   `json:"requests"`
+	Resources  daggerCompute  `json:"resources"`
+	Containers []jobContainer `json:"containers"`
+}
+
+type jobContainer struct {
+	Requests computeUnits `json:"requests"`
+}
+
+type daggerCompute struct {
+	TaskManager computeUnits `json:"taskmanager"`
+	JobManager  computeUnits `json:"jobmanager"`
+}
+
+type computeUnits struct {
+	CPU    string `json:"cpu"`
+	Memory string `json:"memory"`
+}
+
+// Measure returns the usage of a single resource, computed from the spec
+// configs of firehose, dagger and job resources. Resources of the other
+// kinds only count towards the resource count.
+//
+// Firehoses request replicas * requests, a stopped firehose nothing. Jobs
+// request replicas * the requests of all their containers. Daggers request
+// their job manager plus a task manager for each unit of parallelism
+// (replicas).
+func Measure(res resource.Resource) (Usage, error) {
+	usage := Usage{Resources: 1}
+	if res.Kind != kindFirehose && res.Kind != kindDagger && res.Kind != kindJob {
+		return usage, nil
+	}
+
+	var conf computeConfig
+	if len(res.Spec.Configs) > 0 {
+		if err := json.Unmarshal(res.Spec.Configs, &conf); err != nil {
+			return Usage{}, errors.ErrInvalid.WithMsgf("invalid configs of resource '%s'", res.URN).WithCausef("%s", err.Error())
+		}
+	}
+	usage.Replicas = max(conf.Replicas, 1)
+
+	var units []computeUnits
+	var counts []int64
+	switch res.Kind {
+	case kindFirehose:
+		if conf.Stopped {
+			return usage, nil
+		}
+		units = []computeUnits{conf.Requests}
+		counts = []int64{int64(usage.Replicas)}
+
+	case kindJob:
+		for _, c := range conf.Containers {
+			units = append(units, c.Requests)
+			counts = append(counts, int64(usage.Replicas))
+		}
+
+	case kindDagger:
+		units = []computeUnits{conf.Resources.JobManager, conf.Resources.TaskManager}
+		counts = []int64{1, int64(usage.Replicas)}
+	}
+
+	for i, u := range units {
+		cpu, err := parseMillis(u.CPU)
+		if err != nil {
+			return Usage{}, errors.ErrInvalid.WithMsgf("invalid cpu request of resource '%s'", res.URN).WithCausef("%s", err.Error())
+		}
+
+		memory, err := parseBytes(u.Memory)
+		if err != nil {
+			return Usage{}, errors.ErrInvalid.WithMsgf("invalid memory request of resource '%s'", res.URN).WithCausef("%s", err.Error())
+		}
+
+		usage.CPUMillis += counts[i] * cpu
+		usage.MemoryBytes += counts[i] * memory
+	}
+	return usage, nil
+}
+
+func parseMillis(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	q, err := k8sresource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid cpu quantity '%s'", s)
+	}
+	return q.MilliValue(), nil
+}
+
+func parseBytes(s string) (int64, error) {
+	if s == "" {
+		return 0, nil
+	}
+	q, err := k8sresource.ParseQuantity(s)
+	if err != nil {
+		return 0, fmt.Errorf("invalid memory quantity '%s'", s)
+	}
+	return q.Value(), nil
+}
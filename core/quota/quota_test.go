@@ -0,0 +1,145 @@
+package quota_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestMeasure(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		res     resource.Resource
+		want    quota.Usage
+		wantErr error
+	}{
+		{
+			name: "Firehose",
+			res: resource.Resource{
+				Kind: "firehose",
+				Spec: resource.Spec{Configs: []byte(`{"replicas":3,"requests":{"cpu":"200m","memory":"512Mi"}}`)},
+			},
+			want: quota.Usage{Resources: 1, Replicas: 3, CPUMillis: 600, MemoryBytes: 3 * 512 << 20},
+		},
+		{
+			name: "StoppedFirehose",
+			res: resource.Resource{
+				Kind: "firehose",
+				Spec: resource.Spec{Configs: []byte(`{"stopped":true,"replicas":3,"requests":{"cpu":"200m","memory":"512Mi"}}`)},
+			},
+			want: quota.Usage{Resources: 1, Replicas: 3},
+		},
+		{
+			name: "Dagger",
+			res: resource.Resource{
+				Kind: "dagger",
+				Spec: resource.Spec{Configs: []byte(`{"replicas":2,"resources":{"jobmanager":{"cpu":"1","memory":"1Gi"},"taskmanager":{"cpu":"2","memory":"4Gi"}}}`)},
+			},
+			want: quota.Usage{Resources: 1, Replicas: 2, CPUMillis: 5000, MemoryBytes: 9 << 30},
+		},
+		{
+			name: "Job",
+			res: resource.Resource{
+				Kind: "job",
+				Spec: resource.Spec{Configs: []byte(`{
+					"replicas": 2,
+					"namespace": "default",
+					"containers": [
+						{"name": "main", "image": "busybox", "requests": {"cpu": "500m", "memory": "256Mi"}},
+						{"name": "sidecar", "image": "telegraf", "requests": {"cpu": "100m", "memory": "64Mi"}}
+					]
+				}`)},
+			},
+			want: quota.Usage{Resources: 1, Replicas: 2, CPUMillis: 1200, MemoryBytes: 2 * 320 << 20},
+		},
+		{
+			name: "OtherKind",
+			res: resource.Resource{
+				Kind: "kafka",
+				Spec: resource.Spec{Configs: []byte(`{"replicas":30}`)},
+			},
+			want: quota.Usage{Resources: 1},
+		},
+		{
+			name: "InvalidQuantity",
+			res: resource.Resource{
+				Kind: "job",
+				Spec: resource.Spec{Configs: []byte(`{
+					"replicas": 1,
+					"containers": [{"name": "main", "image": "busybox", "requests": {"cpu": "lots", "memory": "256Mi"}}]
+				}`)},
+			},
+			wantErr: errors.ErrInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := quota.Measure(tt.res)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConfig_ForProject(t *testing.T) {
+	t.Parallel()
+
+	cfg := quota.Config{
+		Default: quota.ProjectLimits{
+			Limit: quota.Limit{MaxResources: 10, MaxCPU: "4"},
+			Kinds: map[string]quota.Limit{"firehose": {MaxReplicas: 5, MaxResources: 3}},
+		},
+		Projects: map[string]quota.ProjectLimits{
+			"big": {
+				Limit: quota.Limit{MaxCPU: "40"},
+				Kinds: map[string]quota.Limit{"firehose": {MaxReplicas: 50}},
+			},
+		},
+	}
+	require.NoError(t, cfg.Validate())
+
+	small := cfg.ForProject("small")
+	assert.Equal(t, quota.Limit{MaxResources: 10, MaxCPU: "4"}, small.Limit)
+	assert.Equal(t, quota.Limit{MaxReplicas: 5, MaxResources: 3}, small.Kinds["firehose"])
+
+	big := cfg.ForProject("big")
+	assert.Equal(t, quota.Limit{MaxResources: 10, MaxCPU: "40"}, big.Limit)
+	assert.Equal(t, quota.Limit{MaxReplicas: 50, MaxResources: 3}, big.Kinds["firehose"])
+
+	invalid := quota.Config{Default: quota.ProjectLimits{Limit: quota.Limit{MaxMemory: "much"}}}
+	assert.ErrorIs(t, invalid.Validate(), errors.ErrInvalid)
+}
+
+func TestLimit_Check(t *testing.T) {
+	t.Parallel()
+
+	limit := quota.Limit{MaxResources: 2, MaxCPU: "1"}
+
+	assert.NoError(t, limit.Check("project 'foo'", quota.Usage{Resources: 1}, quota.Usage{Resources: 2, CPUMillis: 1000}))
+
+	err := limit.Check("project 'foo'", quota.Usage{Resources: 2}, quota.Usage{Resources: 3})
+	assert.ErrorIs(t, err, errors.ErrQuotaExceeded)
+	assert.EqualError(t, err, "quota_exceeded: resource count quota exceeded for project 'foo': 3 requested, limit is 2")
+
+	err = limit.Check("project 'foo'", quota.Usage{CPUMillis: 800}, quota.Usage{CPUMillis: 1500})
+	assert.EqualError(t, err, "quota_exceeded: cpu quota exceeded for project 'foo': 1500m requested, limit is 1")
+
+	// already over the limit, but not growing.
+	assert.NoError(t, limit.Check("project 'foo'", quota.Usage{Resources: 5}, quota.Usage{Resources: 4}))
+	assert.NoError(t, quota.Limit{}.Check("project 'foo'", quota.Usage{}, quota.Usage{Resources: 100}))
+}
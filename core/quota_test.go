@@ -0,0 +1,170 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_Quotas(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	// the planned configs are the params of a create, or the configs of
+	// the resource with the replicas of a scale applied.
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			switch act.Name {
+			case module.CreateAction:
+				planned.Spec.Configs = act.Params
+			case "scale":
+				var conf map[string]any
+				_ = json.Unmarshal(planned.Spec.Configs, &conf)
+				_ = json.Unmarshal(act.Params, &conf)
+				planned.Spec.Configs, _ = json.Marshal(conf)
+			}
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName,
+		core.WithQuotas(quota.Config{
+			Default: quota.ProjectLimits{
+				Limit: quota.Limit{MaxResources: 3, MaxCPU: "2"},
+				Kinds: map[string]quota.Limit{"firehose": {MaxReplicas: 4}},
+			},
+		}))
+
+	ctx := context.Background()
+	firehose := func(name string) resource.Resource {
+		return resource.Resource{
+			Kind:    "firehose",
+			Name:    name,
+			Project: "project",
+			Spec:    resource.Spec{Configs: []byte(`{"replicas":2,"requests":{"cpu":"250m","memory":"256Mi"}}`)},
+		}
+	}
+
+	first, err := svc.CreateResource(ctx, firehose("first"))
+	require.NoError(t, err)
+	_, err = svc.CreateResource(ctx, firehose("second"))
+	require.NoError(t, err)
+
+	t.Run("Replicas", func(t *testing.T) {
+		_, err := svc.ApplyAction(ctx, first.URN, module.ActionRequest{
+			Name:   "scale",
+			Params: json.RawMessage(`{"replicas":5}`),
+		})
+		assert.ErrorIs(t, err, errors.ErrQuotaExceeded)
+
+		_, err = svc.ApplyAction(ctx, first.URN, module.ActionRequest{
+			Name:   "scale",
+			Params: json.RawMessage(`{"replicas":4}`),
+		})
+		assert.NoError(t, err)
+	})
+
+	t.Run("CPU", func(t *testing.T) {
+		// 4 x 250m + 2 x 250m + 4 x 250m is over 2 cpus.
+		third := firehose("third")
+		third.Spec.Configs = []byte(`{"replicas":4,"requests":{"cpu":"250m"}}`)
+		_, err := svc.CreateResource(ctx, third)
+		assert.ErrorIs(t, err, errors.ErrQuotaExceeded)
+		assert.Contains(t, err.Error(), "cpu quota exceeded for project 'project'")
+	})
+
+	t.Run("ResourceCount", func(t *testing.T) {
+		_, err := svc.CreateResource(ctx, resource.Resource{Kind: "kafka", Name: "third", Project: "project"})
+		require.NoError(t, err)
+
+		_, err = svc.CreateResource(ctx, resource.Resource{Kind: "kafka", Name: "fourth", Project: "project"})
+		assert.ErrorIs(t, err, errors.ErrQuotaExceeded)
+
+		// other projects have their own quota.
+		_, err = svc.CreateResource(ctx, resource.Resource{Kind: "kafka", Name: "fourth", Project: "other"})
+		assert.NoError(t, err)
+	})
+
+	t.Run("Report", func(t *testing.T) {
+		report, err := svc.GetProjectQuota(ctx, "project")
+		require.NoError(t, err)
+
+		assert.Equal(t, quota.Limit{MaxResources: 3, MaxCPU: "2"}, report.Limit)
+		assert.Equal(t, 3, report.Usage.Resources)
+		assert.Equal(t, "1500m", report.Usage.CPU())
+		assert.Equal(t, "1536Mi", report.Usage.Memory())
+
+		assert.Equal(t, quota.Limit{MaxReplicas: 4}, report.Kinds["firehose"].Limit)
+		assert.Equal(t, 4, report.Kinds["firehose"].Usage.Replicas)
+		assert.Equal(t, 1, report.Kinds["kafka"].Usage.Resources)
+	})
+
+	t.Run("ConcurrentChange", func(t *testing.T) {
+		racy := &racyQuotaStore{Store: store}
+		svc := core.New(racy, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName,
+			core.WithQuotas(quota.Config{Default: quota.ProjectLimits{Limit: quota.Limit{MaxResources: 1}}}))
+
+		_, err := svc.CreateResource(ctx, resource.Resource{Kind: "kafka", Name: "first", Project: "racy"})
+		require.NoError(t, err)
+
+		// the resource created above is missed by the check of the plan, as
+		// if it was created concurrently, and found once the project is
+		// locked.
+		racy.locked = false
+		_, err = svc.CreateResource(ctx, resource.Resource{Kind: "kafka", Name: "second", Project: "racy"})
+		assert.ErrorIs(t, err, errors.ErrQuotaExceeded)
+
+		_, err = store.GetByURN(ctx, "orn:entropy:kafka:racy:second")
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("Unconfigured", func(t *testing.T) {
+		unlimited := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+		report, err := unlimited.GetProjectQuota(ctx, "project")
+		require.NoError(t, err)
+		assert.True(t, report.Limit.IsZero())
+
+		_, err = unlimited.CreateResource(ctx, resource.Resource{Kind: "kafka", Name: "fifth", Project: "project"})
+		assert.NoError(t, err)
+	})
+}
+
+// racyQuotaStore lists no resources until the project is locked.
+type racyQuotaStore struct {
+	*inmemory.Store
+	locked bool
+}
+
+func (st *racyQuotaStore) LockProject(ctx context.Context, project string) error {
+	st.locked = true
+	return st.Store.LockProject(ctx, project)
+}
+
+func (st *racyQuotaStore) List(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error) {
+	if !st.locked {
+		return resource.PagedResource{}, nil
+	}
+	return st.Store.List(ctx, filter, withSpecConfigs)
+}
@@ -0,0 +1,118 @@
+package core
+
+import (
+	"context"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// WithAuthorizer makes the service check the permissions of the callers
+// of every request. Calls without a caller in the context are not checked.
+func WithAuthorizer(authorizer *rbac.Authorizer) ServiceOption {
+	return func(svc *Service) {
+		svc.authorizer = authorizer
+	}
+}
+
+// CreateRoleBinding grants a role on a project. Only admins of the project
+// can manage its bindings.
+func (svc *Service) CreateRoleBinding(ctx context.Context, b rbac.Binding) (*rbac.Binding, error) {
+	bindingStore, err := svc.roleBindingStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := b.Validate(); err != nil {
+		return nil, err
+	} else if err := svc.authorize(ctx, b.Project, rbac.PermissionManage); err != nil {
+		return nil, err
+	}
+	b.CreatedAt = svc.clock()
+
+	created, err := bindingStore.CreateRoleBinding(ctx, b)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return created, nil
+}
+
+// ListRoleBindings returns the bindings of the project, or of all projects
+// if project is empty.
+func (svc *Service) ListRoleBindings(ctx context.Context, project string) ([]rbac.Binding, error) {
+	bindingStore, err := svc.roleBindingStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.authorize(ctx, project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	bindings, err := bindingStore.ListRoleBindings(ctx, project)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return bindings, nil
+}
+
+func (svc *Service) DeleteRoleBinding(ctx context.Context, id int64) error {
+	bindingStore, err := svc.roleBindingStore()
+	if err != nil {
+		return err
+	}
+
+	b, err := bindingStore.GetRoleBinding(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return errors.ErrNotFound.WithMsgf("role binding with id '%d' not found", id)
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, b.Project, rbac.PermissionManage); err != nil {
+		return err
+	}
+
+	if err := bindingStore.DeleteRoleBinding(ctx, id); err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return errors.ErrNotFound.WithMsgf("role binding with id '%d' not found", id)
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return nil
+}
+
+func (svc *Service) roleBindingStore() (rbac.Store, error) {
+	bindingStore, ok := svc.store.(rbac.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("role bindings are not supported by the store")
+	}
+	return bindingStore, nil
+}
+
+// authorize checks that the caller holds the permission on the project, an
+// empty project requires an admin.
+func (svc *Service) authorize(ctx context.Context, project, permission string) error {
+	if svc.authorizer == nil {
+		return nil
+	}
+	return svc.authorizer.Authorize(ctx, project, permission)
+}
+
+// authorizeURN is authorize on the project of the resource. Unknown
+// resources are left for the caller to report.
+func (svc *Service) authorizeURN(ctx context.Context, urn, permission string) error {
+	if svc.authorizer == nil {
+		return nil
+	} else if _, ok := rbac.PrincipalFrom(ctx); !ok {
+		return nil
+	}
+
+	res, err := svc.store.GetByURN(ctx, urn)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return svc.authorize(ctx, res.Project, permission)
+}
@@ -0,0 +1,88 @@
+package rbac
+
+import (
+	"context"
+	"slices"
+
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// Principal identifies the caller of an API request.
+type Principal struct {
+	UserID string
+	Groups []string
+}
+
+type principalKey struct{}
+
+// WithPrincipal returns a copy of ctx carrying the caller. Requests without
+// a principal are internal (e.g., syncs and scheduled actions) and are not
+// checked.
+func WithPrincipal(ctx context.Context, p Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, &p)
+}
+
+// WithoutPrincipal returns a copy of ctx without the caller, for the work
+// that is done on behalf of the system rather than the caller.
+func WithoutPrincipal(ctx context.Context) context.Context {
+	return context.WithValue(ctx, principalKey{}, (*Principal)(nil))
+}
+
+// PrincipalFrom returns the caller carried by ctx, if any.
+func PrincipalFrom(ctx context.Context) (Principal, bool) {
+	p, _ := ctx.Value(principalKey{}).(*Principal)
+	if p == nil {
+		return Principal{}, false
+	}
+	return *p, true
+}
+
+// Authorizer checks the permissions of the callers against their role
+// bindings.
+type Authorizer struct {
+	cfg   Config
+	store Store
+}
+
+func NewAuthorizer(cfg Config, store Store) *Authorizer {
+	return &Authorizer{cfg: cfg, store: store}
+}
+
+// Authorize returns ErrForbidden unless the caller in ctx holds the
+// permission on the project. An empty project stands for the settings
+// shared by all projects, which only admins may change.
+func (a *Authorizer) Authorize(ctx context.Context, project, permission string) error {
+	p, ok := PrincipalFrom(ctx)
+	if !ok || a.IsAdmin(p) {
+		return nil
+	} else if p.UserID == "" {
+		return errors.ErrForbidden.WithMsgf("caller is not authenticated")
+	} else if project == "" {
+		return errors.ErrForbidden.WithMsgf("user '%s' is not allowed to '%s' outside of a project", p.UserID, permission)
+	}
+
+	bindings, err := a.store.ListRoleBindings(ctx, project)
+	if err != nil {
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	for _, b := range bindings {
+		if b.Matches(p) && b.Grants(permission) {
+			return nil
+		}
+	}
+	return errors.ErrForbidden.WithMsgf("user '%s' is not allowed to '%s' in project '%s'", p.UserID, permission, project)
+}
+
+// IsAdmin returns true if the principal is an admin of every project.
+func (a *Authorizer) IsAdmin(p Principal) bool {
+	if p.UserID != "" && slices.Contains(a.cfg.Admins, p.UserID) {
+		return true
+	}
+	for _, group := range p.Groups {
+		if slices.Contains(a.cfg.AdminGroups, group) {
+			return true
+		}
+	}
+	return false
+}
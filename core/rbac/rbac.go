@@ -0,0 +1,128 @@
+package rbac
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	// RoleViewer can read the resources and modules of the project.
+	RoleViewer = "viewer"
+
+	// RoleOperator can also apply module actions to the resources, all
+	// the actions other than create, update and delete or only the ones
+	// listed on the binding.
+	RoleOperator = "operator"
+
+	// RoleAdmin can do everything in the project, including changing its
+	// modules and role bindings.
+	RoleAdmin = "admin"
+)
+
+// Permissions checked besides the action names.
+const (
+	PermissionRead   = "read"
+	PermissionManage = "manage"
+)
+
+// Store is implemented by storage backends that can keep role bindings.
+type Store interface {
+	// CreateRoleBinding stores the binding and returns it with the ID
+	// assigned.
+	CreateRoleBinding(ctx context.Context, b Binding) (*Binding, error)
+	GetRoleBinding(ctx context.Context, id int64) (*Binding, error)
+
+	// ListRoleBindings returns the bindings of the project, or all of them
+	// if project is empty.
+	ListRoleBindings(ctx context.Context, project string) ([]Binding, error)
+	DeleteRoleBinding(ctx context.Context, id int64) error
+}
+
+// Config enables authorization of the API requests.
+type Config struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Admins and AdminGroups have the admin role on every project. They
+	// are also the only ones allowed to change settings that are not
+	// scoped to a project, e.g., admission policies.
+	Admins      []string `mapstructure:"admins"`
+	AdminGroups []string `mapstructure:"admin_groups"`
+}
+
+// Binding grants a role on a project to a user or a group.
+type Binding struct {
+	ID      int64  `json:"id"`
+	Project string `json:"project"`
+	User    string `json:"user,omitempty"`
+	Group   string `json:"group,omitempty"`
+	Role    string `json:"role"`
+
+	// Actions if set, limits an operator to these actions.
+	Actions []string `json:"actions,omitempty"`
+
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+}
+
+func (b Binding) Validate() error {
+	if b.Project == "" {
+		return errors.ErrInvalid.WithMsgf("project must be set")
+	} else if (b.User == "") == (b.Group == "") {
+		return errors.ErrInvalid.WithMsgf("exactly one of user and group must be set")
+	}
+
+	switch b.Role {
+	case RoleViewer, RoleAdmin:
+		if len(b.Actions) > 0 {
+			return errors.ErrInvalid.WithMsgf("actions can only be set for the '%s' role", RoleOperator)
+		}
+	case RoleOperator:
+		for _, action := range b.Actions {
+			if action == "" || action == PermissionRead || action == PermissionManage {
+				return errors.ErrInvalid.WithMsgf("invalid action '%s'", action)
+			}
+		}
+	default:
+		return errors.ErrInvalid.WithMsgf("role must be one of '%s', '%s' and '%s'", RoleViewer, RoleOperator, RoleAdmin)
+	}
+	return nil
+}
+
+// Matches returns true if the binding applies to the principal.
+func (b Binding) Matches(p Principal) bool {
+	if b.User != "" {
+		return b.User == p.UserID
+	}
+	return slices.Contains(p.Groups, b.Group)
+}
+
+// Grants returns true if the role of the binding includes the permission,
+// which is either PermissionRead, PermissionManage or an action name.
+func (b Binding) Grants(permission string) bool {
+	switch b.Role {
+	case RoleAdmin:
+		return true
+
+	case RoleOperator:
+		switch permission {
+		case PermissionRead:
+			return true
+		case PermissionManage:
+			return false
+		}
+		if len(b.Actions) > 0 {
+			return slices.Contains(b.Actions, permission)
+		}
+		return permission != module.CreateAction &&
+			permission != module.UpdateAction &&
+			permission != module.DeleteAction
+
+	case RoleViewer:
+		return permission == PermissionRead
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package rbac_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestBinding_Grants(t *testing.T) {
+	t.Parallel()
+
+	viewer := rbac.Binding{Role: rbac.RoleViewer}
+	assert.True(t, viewer.Grants(rbac.PermissionRead))
+	assert.False(t, viewer.Grants("scale"))
+	assert.False(t, viewer.Grants(rbac.PermissionManage))
+
+	operator := rbac.Binding{Role: rbac.RoleOperator}
+	assert.True(t, operator.Grants(rbac.PermissionRead))
+	assert.True(t, operator.Grants("scale"))
+	assert.False(t, operator.Grants("update"))
+	assert.False(t, operator.Grants("delete"))
+	assert.False(t, operator.Grants(rbac.PermissionManage))
+
+	limited := rbac.Binding{Role: rbac.RoleOperator, Actions: []string{"scale"}}
+	assert.True(t, limited.Grants("scale"))
+	assert.False(t, limited.Grants("reset"))
+
+	admin := rbac.Binding{Role: rbac.RoleAdmin}
+	assert.True(t, admin.Grants("delete"))
+	assert.True(t, admin.Grants(rbac.PermissionManage))
+}
+
+func TestBinding_Validate(t *testing.T) {
+	t.Parallel()
+
+	valid := rbac.Binding{Project: "p", Group: "oncall", Role: rbac.RoleOperator, Actions: []string{"scale"}}
+	assert.NoError(t, valid.Validate())
+
+	for name, b := range map[string]rbac.Binding{
+		"NoProject":      {User: "u", Role: rbac.RoleViewer},
+		"NoSubject":      {Project: "p", Role: rbac.RoleViewer},
+		"UserAndGroup":   {Project: "p", User: "u", Group: "g", Role: rbac.RoleViewer},
+		"UnknownRole":    {Project: "p", User: "u", Role: "owner"},
+		"ViewerActions":  {Project: "p", User: "u", Role: rbac.RoleViewer, Actions: []string{"scale"}},
+		"ReservedAction": {Project: "p", User: "u", Role: rbac.RoleOperator, Actions: []string{rbac.PermissionManage}},
+	} {
+		assert.ErrorIs(t, b.Validate(), errors.ErrInvalid, name)
+	}
+}
+
+func TestAuthorizer_Authorize(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	_, err = store.CreateRoleBinding(ctx, rbac.Binding{Project: "foo", User: "john", Role: rbac.RoleViewer})
+	require.NoError(t, err)
+	_, err = store.CreateRoleBinding(ctx, rbac.Binding{Project: "foo", Group: "oncall", Role: rbac.RoleOperator})
+	require.NoError(t, err)
+
+	authorizer := rbac.NewAuthorizer(rbac.Config{Enabled: true, AdminGroups: []string{"platform"}}, store)
+
+	john := rbac.WithPrincipal(ctx, rbac.Principal{UserID: "john"})
+	oncall := rbac.WithPrincipal(ctx, rbac.Principal{UserID: "jane", Groups: []string{"oncall"}})
+	platform := rbac.WithPrincipal(ctx, rbac.Principal{UserID: "root", Groups: []string{"platform"}})
+
+	assert.NoError(t, authorizer.Authorize(john, "foo", rbac.PermissionRead))
+	assert.ErrorIs(t, authorizer.Authorize(john, "foo", "scale"), errors.ErrForbidden)
+	assert.ErrorIs(t, authorizer.Authorize(john, "bar", rbac.PermissionRead), errors.ErrForbidden)
+
+	assert.NoError(t, authorizer.Authorize(oncall, "foo", "scale"))
+	assert.ErrorIs(t, authorizer.Authorize(oncall, "foo", "delete"), errors.ErrForbidden)
+
+	assert.NoError(t, authorizer.Authorize(platform, "bar", rbac.PermissionManage))
+	assert.NoError(t, authorizer.Authorize(platform, "", rbac.PermissionManage))
+	assert.ErrorIs(t, authorizer.Authorize(john, "", rbac.PermissionRead), errors.ErrForbidden)
+
+	anonymous := rbac.WithPrincipal(ctx, rbac.Principal{})
+	assert.ErrorIs(t, authorizer.Authorize(anonymous, "foo", rbac.PermissionRead), errors.ErrForbidden)
+
+	// internal calls carry no principal and are not checked.
+	assert.NoError(t, authorizer.Authorize(ctx, "foo", "delete"))
+	assert.NoError(t, authorizer.Authorize(rbac.WithoutPrincipal(john), "foo", "delete"))
+}
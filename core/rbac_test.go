@@ -0,0 +1,134 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_RoleBindings(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			if act.Name == module.CreateAction || act.Name == module.UpdateAction {
+				planned.Spec.Configs = act.Params
+			}
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+
+	authorizer := rbac.NewAuthorizer(rbac.Config{Enabled: true, Admins: []string{"root"}}, store)
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName, core.WithAuthorizer(authorizer))
+
+	ctx := context.Background()
+	admin := rbac.WithPrincipal(ctx, rbac.Principal{UserID: "root"})
+	viewer := rbac.WithPrincipal(ctx, rbac.Principal{UserID: "john"})
+	operator := rbac.WithPrincipal(ctx, rbac.Principal{UserID: "jane", Groups: []string{"oncall"}})
+
+	created, err := svc.CreateResource(admin, resource.Resource{
+		Kind:    "firehose",
+		Name:    "orders",
+		Project: "production",
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":1}`)},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateRoleBinding(viewer, rbac.Binding{Project: "production", User: "john", Role: rbac.RoleAdmin})
+	assert.ErrorIs(t, err, errors.ErrForbidden)
+
+	_, err = svc.CreateRoleBinding(admin, rbac.Binding{Project: "production", User: "john", Role: rbac.RoleViewer})
+	require.NoError(t, err)
+	scaleOnly, err := svc.CreateRoleBinding(admin, rbac.Binding{
+		Project: "production",
+		Group:   "oncall",
+		Role:    rbac.RoleOperator,
+		Actions: []string{"scale"},
+	})
+	require.NoError(t, err)
+
+	t.Run("Viewer", func(t *testing.T) {
+		_, err := svc.GetResource(viewer, created.URN)
+		assert.NoError(t, err)
+
+		_, err = svc.ApplyAction(viewer, created.URN, module.ActionRequest{Name: "scale"})
+		assert.ErrorIs(t, err, errors.ErrForbidden)
+
+		// listing across projects is left to the admins.
+		_, err = svc.ListResources(viewer, resource.Filter{}, false)
+		assert.ErrorIs(t, err, errors.ErrForbidden)
+	})
+
+	t.Run("Policies", func(t *testing.T) {
+		production, err := svc.CreatePolicy(admin, policy.Policy{
+			Name: "production-only", Project: "production", Expression: "true", Mode: policy.ModeWarn,
+		})
+		require.NoError(t, err)
+		staging, err := svc.CreatePolicy(admin, policy.Policy{
+			Name: "staging-only", Project: "staging", Expression: "true", Mode: policy.ModeWarn,
+		})
+		require.NoError(t, err)
+
+		_, err = svc.GetPolicy(viewer, production.ID)
+		assert.NoError(t, err)
+		_, err = svc.GetPolicy(viewer, staging.ID)
+		assert.ErrorIs(t, err, errors.ErrForbidden)
+
+		_, err = svc.ListPolicies(viewer, policy.Filter{Project: "production"})
+		assert.NoError(t, err)
+		_, err = svc.ListPolicies(viewer, policy.Filter{Project: "staging"})
+		assert.ErrorIs(t, err, errors.ErrForbidden)
+
+		require.NoError(t, svc.DeletePolicy(admin, production.ID))
+		require.NoError(t, svc.DeletePolicy(admin, staging.ID))
+	})
+
+	t.Run("Operator", func(t *testing.T) {
+		_, err := svc.ApplyAction(operator, created.URN, module.ActionRequest{Name: "scale", Params: []byte(`{}`)})
+		assert.NoError(t, err)
+
+		_, err = svc.ApplyAction(operator, created.URN, module.ActionRequest{Name: "reset"})
+		assert.ErrorIs(t, err, errors.ErrForbidden)
+
+		assert.ErrorIs(t, svc.DeleteResource(operator, created.URN), errors.ErrForbidden)
+		assert.ErrorIs(t, svc.DeleteRoleBinding(operator, scaleOnly.ID), errors.ErrForbidden)
+	})
+
+	t.Run("Admin", func(t *testing.T) {
+		bindings, err := svc.ListRoleBindings(admin, "production")
+		require.NoError(t, err)
+		assert.Len(t, bindings, 2)
+
+		require.NoError(t, svc.DeleteRoleBinding(admin, scaleOnly.ID))
+		_, err = svc.ApplyAction(operator, created.URN, module.ActionRequest{Name: "scale", Params: []byte(`{}`)})
+		assert.ErrorIs(t, err, errors.ErrForbidden)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.ListRoleBindings(ctx, "production")
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+}
@@ -1,10 +1,10 @@
 package core
 
 import (
-	"bytes"
 	"context"
 
 	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/rbac"
 	"github.com/goto/entropy/core/resource"
 	"github.com/goto/entropy/pkg/errors"
 )
@@ -16,6 +16,8 @@ func (svc *Service) GetResource(ctx context.Context, urn string) (*resource.Reso
 			return nil, errors.ErrNotFound.WithMsgf("resource with urn '%s' not found", urn)
 		}
 		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, res.Project, rbac.PermissionRead); err != nil {
+		return nil, err
 	}
 
 	modSpec, err := svc.generateModuleSpec(ctx, *res)
@@ -28,28 +30,78 @@ func (svc *Service) GetResource(ctx context.Context, urn string) (*resource.Reso
 		return nil, err
 	}
 
-	if !bytes.Equal(res.State.Output, output) {
-		res.State.Output = output
-		err = svc.store.Update(ctx, *res, false, "")
-		if err != nil {
-			return nil, err
-		}
-	}
-
+	// the refreshed output is not written back, since that would bump the
+	// version under a concurrent sync. syncs persist the output.
+	res.State.Output = output
 	return res, nil
 }
 
+// ListResources returns the resources matching the filter. Listing across
+// projects is left to admins when authorization is enabled.
 func (svc *Service) ListResources(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error) {
+	if err := svc.authorize(ctx, filter.Project, rbac.PermissionRead); err != nil {
+		return resource.PagedResource{}, err
+	}
+
 	resources, err := svc.store.List(ctx, filter, withSpecConfigs)
 	if err != nil {
+		if errors.Is(err, errors.ErrInvalid) {
+			return resource.PagedResource{}, err
+		}
 		return resource.PagedResource{}, errors.ErrInternal.WithCausef("%s", err.Error())
 	}
+	return resources, nil
+}
 
-	resources = filter.Apply(resources)
-	return resource.PagedResource{
-		Count:     int32(len(resources)),
-		Resources: resources,
-	}, nil
+// GetDependents returns the resources that depend on the resource with the
+// given URN, skipping the ones already deleted. With transitive set, indirect
+// dependents are included too and the result is ordered such that every
+// resource appears before the resources it depends on.
+func (svc *Service) GetDependents(ctx context.Context, urn string, transitive bool) ([]resource.Resource, error) {
+	if err := svc.authorizeURN(ctx, urn, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	var dependents []resource.Resource
+	visited := map[string]bool{urn: true}
+
+	var visit func(urn string) error
+	visit = func(urn string) error {
+		urns, err := svc.store.Dependents(ctx, urn)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				return errors.ErrNotFound.WithMsgf("resource with urn '%s' not found", urn)
+			}
+			return errors.ErrInternal.WithCausef("%s", err.Error())
+		}
+
+		for _, dependentURN := range urns {
+			if visited[dependentURN] {
+				continue
+			}
+			visited[dependentURN] = true
+
+			res, err := svc.store.GetByURN(ctx, dependentURN)
+			if err != nil {
+				return errors.ErrInternal.WithCausef("%s", err.Error())
+			} else if res.State.InDeletion() {
+				continue
+			}
+
+			if transitive {
+				if err := visit(dependentURN); err != nil {
+					return err
+				}
+			}
+			dependents = append(dependents, *res)
+		}
+		return nil
+	}
+
+	if err := visit(urn); err != nil {
+		return nil, err
+	}
+	return dependents, nil
 }
 
 func (svc *Service) GetLog(ctx context.Context, urn string, filter map[string]string) (<-chan module.LogChunk, error) {
@@ -74,9 +126,68 @@ func (svc *Service) GetLog(ctx context.Context, urn string, filter map[string]st
 }
 
 func (svc *Service) GetRevisions(ctx context.Context, selector resource.RevisionsSelector) ([]resource.Revision, error) {
+	if err := svc.authorizeURN(ctx, selector.URN, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
 	revs, err := svc.store.Revisions(ctx, selector)
 	if err != nil {
 		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
 	}
 	return revs, nil
 }
+
+// DiffRevisions compares the spec and labels of two revisions of the resource.
+// A zero toID compares the revision against the current spec of the resource.
+func (svc *Service) DiffRevisions(ctx context.Context, urn string, fromID, toID int64) (*resource.SpecDiff, error) {
+	if err := svc.authorizeURN(ctx, urn, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	var current *resource.Resource
+	if toID == 0 {
+		res, err := svc.store.GetByURN(ctx, urn)
+		if err != nil {
+			if errors.Is(err, errors.ErrNotFound) {
+				return nil, errors.ErrNotFound.WithMsgf("resource with urn '%s' not found", urn)
+			}
+			return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+		}
+		current = res
+	}
+
+	revisions, err := svc.GetRevisions(ctx, resource.RevisionsSelector{URN: urn})
+	if err != nil {
+		return nil, err
+	}
+
+	from, err := findRevision(revisions, urn, fromID)
+	if err != nil {
+		return nil, err
+	}
+
+	to := &resource.Revision{}
+	if current != nil {
+		to.Spec, to.Labels = current.Spec, current.Labels
+	} else if to, err = findRevision(revisions, urn, toID); err != nil {
+		return nil, err
+	}
+
+	diff, err := resource.DiffSpecs(from.Spec, to.Spec, from.Labels, to.Labels)
+	if err != nil {
+		return nil, err
+	}
+	diff.URN = urn
+	diff.FromID = fromID
+	diff.ToID = toID
+	return diff, nil
+}
+
+func findRevision(revisions []resource.Revision, urn string, id int64) (*resource.Revision, error) {
+	for i := range revisions {
+		if revisions[i].ID == id {
+			return &revisions[i], nil
+		}
+	}
+	return nil, errors.ErrNotFound.WithMsgf("revision '%d' not found for resource '%s'", id, urn)
+}
@@ -7,10 +7,13 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/goto/entropy/core"
 	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/rbac"
 	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
 	"github.com/goto/entropy/pkg/errors"
 )
 
@@ -65,6 +68,38 @@ func TestService_GetResource(t *testing.T) {
 			want:    &sampleResource,
 			wantErr: nil,
 		},
+		{
+			name: "OutputRefreshed",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				stored := sampleResource
+				stored.Version = 3
+				stored.State = resource.State{Status: resource.StatusCompleted, Output: []byte(`{"token":"old"}`)}
+
+				// the refreshed output is not written back.
+				repo := &mocks.ResourceStore{}
+				repo.EXPECT().
+					GetByURN(mock.Anything, mock.Anything).
+					Return(&stored, nil).
+					Once()
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return([]byte(`{"token":"new"}`), nil).
+					Once()
+
+				return core.New(repo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn: "foo:bar:baz",
+			want: &resource.Resource{
+				URN:     "foo:bar:baz",
+				Kind:    "foo",
+				Name:    "baz",
+				Project: "bar",
+				Version: 3,
+				State:   resource.State{Status: resource.StatusCompleted, Output: []byte(`{"token":"new"}`)},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -104,7 +139,7 @@ func TestService_ListResources(t *testing.T) {
 				repo := &mocks.ResourceStore{}
 				repo.EXPECT().
 					List(mock.Anything, mock.Anything, false).
-					Return(nil, nil).
+					Return(resource.PagedResource{}, nil).
 					Once()
 				return core.New(repo, nil, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
 			},
@@ -118,13 +153,28 @@ func TestService_ListResources(t *testing.T) {
 				repo := &mocks.ResourceStore{}
 				repo.EXPECT().
 					List(mock.Anything, mock.Anything, false).
-					Return(nil, errStoreFailure).
+					Return(resource.PagedResource{}, errStoreFailure).
 					Once()
 				return core.New(repo, nil, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
 			},
 			want:    resource.PagedResource{},
 			wantErr: errors.ErrInternal,
 		},
+		{
+			name: "InvalidPageToken",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				repo := &mocks.ResourceStore{}
+				repo.EXPECT().
+					List(mock.Anything, mock.Anything, false).
+					Return(resource.PagedResource{}, errors.ErrInvalid.WithMsgf("invalid page token")).
+					Once()
+				return core.New(repo, nil, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			filter:  resource.Filter{PageToken: "foo"},
+			want:    resource.PagedResource{},
+			wantErr: errors.ErrInvalid,
+		},
 		{
 			name: "Success",
 			setup: func(t *testing.T) *core.Service {
@@ -132,13 +182,18 @@ func TestService_ListResources(t *testing.T) {
 				repo := &mocks.ResourceStore{}
 				repo.EXPECT().
 					List(mock.Anything, mock.Anything, false).
-					Return([]resource.Resource{sampleResource}, nil).
+					Return(resource.PagedResource{
+						Count:         3,
+						Resources:     []resource.Resource{sampleResource},
+						NextPageToken: "Mg",
+					}, nil).
 					Once()
 				return core.New(repo, nil, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
 			},
 			want: resource.PagedResource{
-				Count:     1,
-				Resources: []resource.Resource{sampleResource},
+				Count:         3,
+				Resources:     []resource.Resource{sampleResource},
+				NextPageToken: "Mg",
 			},
 			wantErr: nil,
 		},
@@ -161,3 +216,69 @@ func TestService_ListResources(t *testing.T) {
 		})
 	}
 }
+
+func TestService_DiffRevisions(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, store.Create(ctx, resource.Resource{
+		URN:     urn,
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Labels:  map[string]string{"team": "data"},
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":1}`)},
+	}))
+	require.NoError(t, store.Update(ctx, resource.Resource{
+		URN:     urn,
+		Version: 1,
+		Labels:  map[string]string{"team": "data"},
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":3}`)},
+	}, true, "action:update"))
+	require.NoError(t, store.Update(ctx, resource.Resource{
+		URN:     urn,
+		Version: 2,
+		Labels:  map[string]string{"team": "infra"},
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":3}`)},
+	}, false, ""))
+
+	revs, err := store.Revisions(ctx, resource.RevisionsSelector{URN: urn})
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	fromID, toID := revs[0].ID, revs[1].ID
+	if fromID > toID {
+		fromID, toID = toID, fromID
+	}
+
+	svc := core.New(store, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	got, err := svc.DiffRevisions(ctx, urn, fromID, toID)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.Change{
+		{Op: resource.ChangeChanged, Path: "replicas", From: float64(1), To: float64(3)},
+	}, got.Configs)
+	assert.Empty(t, got.Labels)
+
+	got, err = svc.DiffRevisions(ctx, urn, fromID, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.Change{
+		{Op: resource.ChangeChanged, Path: "team", From: "data", To: "infra"},
+	}, got.Labels)
+
+	_, err = svc.DiffRevisions(ctx, urn, toID+100, 0)
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	_, err = svc.DiffRevisions(ctx, "orn:entropy:mock:project:missing", fromID, 0)
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	// the current spec is only compared for readers of the project.
+	authorizer := rbac.NewAuthorizer(rbac.Config{Enabled: true, Admins: []string{"root"}}, store)
+	authorized := core.New(store, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName, core.WithAuthorizer(authorizer))
+	_, err = authorized.DiffRevisions(rbac.WithPrincipal(ctx, rbac.Principal{UserID: "john"}), urn, fromID, 0)
+	assert.ErrorIs(t, err, errors.ErrForbidden)
+}
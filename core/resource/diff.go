@@ -0,0 +1,161 @@
+package resource
+
+import (
+	"encoding/json"
+	"reflect"
+	"sort"
+
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	ChangeAdded   = "added"
+	ChangeRemoved = "removed"
+	ChangeChanged = "changed"
+)
+
+// Change is a single difference between two specs. Path is the dot-separated
+// location of the value within the section being compared and is empty if
+// the section as a whole changed.
+type Change struct {
+	Op   string `json:"op"`
+	Path string `json:"path"`
+	From any    `json:"from,omitempty"`
+	To   any    `json:"to,omitempty"`
+}
+
+// SpecDiff is the structured difference between two revisions of a resource.
+// A zero ToID refers to the current spec of the resource.
+type SpecDiff struct {
+	URN          string   `json:"urn"`
+	FromID       int64    `json:"from_id"`
+	ToID         int64    `json:"to_id"`
+	Configs      []Change `json:"configs"`
+	Labels       []Change `json:"labels"`
+	Dependencies []Change `json:"dependencies"`
+}
+
+// IsEmpty returns true if there is no difference at all.
+func (d SpecDiff) IsEmpty() bool {
+	return len(d.Configs) == 0 && len(d.Labels) == 0 && len(d.Dependencies) == 0
+}
+
+// DiffSpecs computes the difference between two spec and label sets. Configs
+// are compared structurally as JSON documents, so formatting and key order
+// do not produce changes.
+func DiffSpecs(fromSpec, toSpec Spec, fromLabels, toLabels map[string]string) (*SpecDiff, error) {
+	configs, err := DiffJSON(fromSpec.Configs, toSpec.Configs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &SpecDiff{
+		Configs:      configs,
+		Labels:       DiffStringMaps(fromLabels, toLabels),
+		Dependencies: DiffStringMaps(fromSpec.Dependencies, toSpec.Dependencies),
+	}, nil
+}
+
+// DiffJSON computes the structural difference between two JSON documents.
+func DiffJSON(from, to json.RawMessage) ([]Change, error) {
+	fromVal, err := decodeJSON(from)
+	if err != nil {
+		return nil, err
+	}
+
+	toVal, err := decodeJSON(to)
+	if err != nil {
+		return nil, err
+	}
+
+	var changes []Change
+	diffValues(&changes, "", fromVal, toVal)
+	return changes, nil
+}
+
+func decodeJSON(data json.RawMessage) (any, error) {
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	var v any
+	if err := json.Unmarshal(data, &v); err != nil {
+		return nil, errors.ErrInternal.WithMsgf("invalid json document").WithCausef("%s", err.Error())
+	}
+	return v, nil
+}
+
+func diffValues(changes *[]Change, path string, from, to any) {
+	fromMap, fromIsMap := from.(map[string]any)
+	toMap, toIsMap := to.(map[string]any)
+	if fromIsMap && toIsMap {
+		for _, key := range unionKeys(fromMap, toMap) {
+			fromVal, inFrom := fromMap[key]
+			toVal, inTo := toMap[key]
+			keyPath := joinPath(path, key)
+
+			switch {
+			case !inFrom:
+				*changes = append(*changes, Change{Op: ChangeAdded, Path: keyPath, To: toVal})
+			case !inTo:
+				*changes = append(*changes, Change{Op: ChangeRemoved, Path: keyPath, From: fromVal})
+			default:
+				diffValues(changes, keyPath, fromVal, toVal)
+			}
+		}
+		return
+	}
+
+	switch {
+	case reflect.DeepEqual(from, to):
+		return
+	case from == nil:
+		*changes = append(*changes, Change{Op: ChangeAdded, Path: path, To: to})
+	case to == nil:
+		*changes = append(*changes, Change{Op: ChangeRemoved, Path: path, From: from})
+	default:
+		*changes = append(*changes, Change{Op: ChangeChanged, Path: path, From: from, To: to})
+	}
+}
+
+// DiffStringMaps computes the difference between two string maps such as
+// labels. Changes are sorted by key.
+func DiffStringMaps(from, to map[string]string) []Change {
+	var changes []Change
+	for _, key := range unionKeys(from, to) {
+		fromVal, inFrom := from[key]
+		toVal, inTo := to[key]
+
+		switch {
+		case !inFrom:
+			changes = append(changes, Change{Op: ChangeAdded, Path: key, To: toVal})
+		case !inTo:
+			changes = append(changes, Change{Op: ChangeRemoved, Path: key, From: fromVal})
+		case fromVal != toVal:
+			changes = append(changes, Change{Op: ChangeChanged, Path: key, From: fromVal, To: toVal})
+		}
+	}
+	return changes
+}
+
+func unionKeys[V any](a, b map[string]V) []string {
+	seen := map[string]bool{}
+	var keys []string
+	for _, m := range []map[string]V{a, b} {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func joinPath(parent, key string) string {
+	if parent == "" {
+		return key
+	}
+	return parent + "." + key
+}
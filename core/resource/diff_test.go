@@ -0,0 +1,72 @@
+package resource_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/resource"
+)
+
+func TestDiffSpecs(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name       string
+		from, to   resource.Spec
+		fromLabels map[string]string
+		toLabels   map[string]string
+		want       *resource.SpecDiff
+	}{
+		{
+			name: "NoChange",
+			from: resource.Spec{Configs: []byte(`{"replicas": 1, "env": {"A": "x"}}`)},
+			to:   resource.Spec{Configs: []byte(`{"env":{"A":"x"},"replicas":1}`)},
+			want: &resource.SpecDiff{},
+		},
+		{
+			name: "NestedConfigs",
+			from: resource.Spec{Configs: []byte(`{"replicas": 1, "stopped": false, "env": {"A": "x", "B": "y"}}`)},
+			to:   resource.Spec{Configs: []byte(`{"replicas": 3, "env": {"A": "x", "C": "z"}}`)},
+			want: &resource.SpecDiff{
+				Configs: []resource.Change{
+					{Op: resource.ChangeRemoved, Path: "env.B", From: "y"},
+					{Op: resource.ChangeAdded, Path: "env.C", To: "z"},
+					{Op: resource.ChangeChanged, Path: "replicas", From: float64(1), To: float64(3)},
+					{Op: resource.ChangeRemoved, Path: "stopped", From: false},
+				},
+			},
+		},
+		{
+			name:       "LabelsAndDependencies",
+			from:       resource.Spec{Dependencies: map[string]string{"kube_cluster": "orn:entropy:kubernetes:p:a"}},
+			to:         resource.Spec{Dependencies: map[string]string{"kube_cluster": "orn:entropy:kubernetes:p:b"}},
+			fromLabels: map[string]string{"team": "data"},
+			toLabels:   map[string]string{"team": "data", "env": "prod"},
+			want: &resource.SpecDiff{
+				Labels: []resource.Change{
+					{Op: resource.ChangeAdded, Path: "env", To: "prod"},
+				},
+				Dependencies: []resource.Change{
+					{
+						Op:   resource.ChangeChanged,
+						Path: "kube_cluster",
+						From: "orn:entropy:kubernetes:p:a",
+						To:   "orn:entropy:kubernetes:p:b",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			got, err := resource.DiffSpecs(tt.from, tt.to, tt.fromLabels, tt.toLabels)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -19,7 +19,7 @@ var namingPatternStartingWithDigits = regexp.MustCompile(`^\d*[A-Za-z0-9_-]+$`)
 
 type Store interface {
 	GetByURN(ctx context.Context, urn string) (*Resource, error)
-	List(ctx context.Context, filter Filter, withSpecConfigs bool) ([]Resource, error)
+	List(ctx context.Context, filter Filter, withSpecConfigs bool) (PagedResource, error)
 
 	Create(ctx context.Context, r Resource, hooks ...MutationHook) error
 	Update(ctx context.Context, r Resource, saveRevision bool, reason string, hooks ...MutationHook) error
@@ -27,7 +27,26 @@ type Store interface {
 
 	Revisions(ctx context.Context, selector RevisionsSelector) ([]Revision, error)
 
-	SyncOne(ctx context.Context, scope map[string][]string, syncFn SyncFn) error
+	// Dependents returns URNs of the resources that directly depend on
+	// the resource with the given URN.
+	Dependents(ctx context.Context, urn string) ([]string, error)
+
+	// SyncOne syncs the next resource due in the scope with syncFn and saves
+	// the synced resource. The hooks run within that final update.
+	SyncOne(ctx context.Context, scope map[string][]string, syncFn SyncFn, hooks ...MutationHook) error
+}
+
+// SyncNotifier is implemented by stores that can wake up syncers when a
+// resource becomes due for sync, so that they do not need to poll.
+type SyncNotifier interface {
+	// SyncNotifications returns a channel that receives a value whenever a
+	// resource is made due for sync immediately. The channel is closed when
+	// ctx is cancelled.
+	SyncNotifications(ctx context.Context) (<-chan struct{}, error)
+
+	// NextSyncAt returns the earliest next-sync time of the resources in
+	// the scope. Returns nil if no resource is scheduled for sync.
+	NextSyncAt(ctx context.Context, scope map[string][]string) (*time.Time, error)
 }
 
 type SyncFn func(ctx context.Context, res Resource) (*Resource, error)
@@ -48,13 +67,17 @@ type Resource struct {
 	UpdatedAt time.Time         `json:"updated_at"`
 	UpdatedBy string            `json:"updated_by"`
 	CreatedBy string            `json:"created_by"`
+	Version   int64             `json:"version"`
 	Spec      Spec              `json:"spec"`
 	State     State             `json:"state"`
 }
 
+// PagedResource is a single page of resources matching a filter. Count is
+// the total number of matching resources across all pages.
 type PagedResource struct {
-	Count     int32
-	Resources []Resource
+	Count         int32
+	Resources     []Resource
+	NextPageToken string
 }
 
 type Spec struct {
@@ -68,9 +91,15 @@ type Filter struct {
 	Labels   map[string]string `json:"labels"`
 	PageSize int32             `json:"page_size"`
 	PageNum  int32             `json:"page_num"`
+
+	// PageToken if set, continues the listing after the last resource
+	// of a previous page. PageNum is ignored when PageToken is set.
+	PageToken string `json:"page_token"`
 }
 
 type UpdateRequest struct {
+	// Spec holds the new configs and dependencies. Nil dependencies leave
+	// the current ones unchanged, an empty map removes them all.
 	Spec   Spec              `json:"spec"`
 	Labels map[string]string `json:"labels"`
 	UserID string
@@ -119,14 +148,16 @@ func (res *Resource) Validate(isCreate bool) error {
 func (f Filter) Apply(arr []Resource) []Resource {
 	var res []Resource
 	for _, r := range arr {
-		if f.isMatch(r) {
+		if f.Matches(r) {
 			res = append(res, r)
 		}
 	}
 	return res
 }
 
-func (f Filter) isMatch(r Resource) bool {
+// Matches reports whether the resource satisfies the kind, project and
+// label criteria of the filter. Pagination fields are ignored.
+func (f Filter) Matches(r Resource) bool {
 	kindMatch := f.Kind == "" || f.Kind == r.Kind
 	projectMatch := f.Project == "" || f.Project == r.Project
 	if !kindMatch || !projectMatch {
@@ -25,6 +25,19 @@ type State struct {
 
 	NextSyncAt *time.Time `json:"next_sync_at,omitempty"`
 	SyncResult SyncResult `json:"sync_result"`
+
+	// DeleteDeferred is set on resources deleted along with their
+	// dependents. The syncer holds the deletion back until the dependents
+	// are gone, the resource keeps serving them meanwhile.
+	DeleteDeferred bool `json:"delete_deferred,omitempty"`
+
+	// OutputChanged is set when the output changes before the resource is
+	// completed. The dependents are notified of the change once it is.
+	OutputChanged bool `json:"output_changed,omitempty"`
+
+	// PreviousDependencies holds the dependencies replaced by a change,
+	// until the resource is completed.
+	PreviousDependencies map[string]string `json:"previous_dependencies,omitempty"`
 }
 
 // IsTerminal returns true if state is terminal. A terminal state is
@@ -0,0 +1,42 @@
+package resource
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	SyncOutcomeSuccess = "success"
+	SyncOutcomeRetry   = "retry"
+	SyncOutcomeFailure = "failure"
+
+	// SyncStepDefault is the step recorded for syncs of resources without
+	// pending steps.
+	SyncStepDefault = "sync"
+)
+
+// SyncHistoryStore is implemented by stores that keep a record of every
+// sync of a resource.
+type SyncHistoryStore interface {
+	AppendSyncRun(ctx context.Context, run SyncRun) error
+
+	// SyncHistory returns the sync runs of the resource, newest first. All
+	// runs are returned if limit is not positive.
+	SyncHistory(ctx context.Context, urn string, limit int) ([]SyncRun, error)
+}
+
+// SyncRun is a single sync of a resource.
+type SyncRun struct {
+	ID         int64     `json:"id"`
+	URN        string    `json:"urn"`
+	Step       string    `json:"step"`
+	StartedAt  time.Time `json:"started_at"`
+	FinishedAt time.Time `json:"finished_at"`
+	Outcome    string    `json:"outcome"`
+	Error      string    `json:"error,omitempty"`
+	Retries    int       `json:"retries"`
+}
+
+func (run SyncRun) Duration() time.Duration {
+	return run.FinishedAt.Sub(run.StartedAt)
+}
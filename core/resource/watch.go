@@ -0,0 +1,103 @@
+package resource
+
+import (
+	"context"
+	"encoding/base64"
+	"strconv"
+	"time"
+
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	EventCreated = "created"
+	EventUpdated = "updated"
+	EventDeleted = "deleted"
+)
+
+// EventStore is implemented by stores that keep a feed of the mutations of
+// resources. Stores append an event for every create, update and delete.
+type EventStore interface {
+	// ResourceEvents returns up to limit events appended after the event
+	// with the given id, oldest first. Returns ErrInvalid if events after
+	// afterID are not retained anymore.
+	ResourceEvents(ctx context.Context, afterID int64, limit int) ([]Event, error)
+
+	// LastResourceEventID returns the id of the latest event, 0 if there
+	// are no events.
+	LastResourceEventID(ctx context.Context) (int64, error)
+
+	// ResourceEventNotifications returns a channel that receives a value
+	// whenever new events may be available. The channel is closed when ctx
+	// is cancelled.
+	ResourceEventNotifications(ctx context.Context) (<-chan struct{}, error)
+
+	// PruneResourceEvents drops the events appended before the given time
+	// and returns the number of events dropped. The latest event is always
+	// kept.
+	PruneResourceEvents(ctx context.Context, before time.Time) (int, error)
+}
+
+// Event is a single mutation of a resource. Resource is the resource after
+// the mutation (before, for deletions) without its spec configs and module
+// data.
+type Event struct {
+	ID        int64     `json:"id"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Resource  Resource  `json:"resource"`
+}
+
+// WatchSelector selects the resources to watch. Zero values match
+// everything.
+type WatchSelector struct {
+	Project string            `json:"project"`
+	Kind    string            `json:"kind"`
+	Labels  map[string]string `json:"labels"`
+}
+
+// Matches returns true if the resource is selected.
+func (ws WatchSelector) Matches(r Resource) bool {
+	if (ws.Project != "" && ws.Project != r.Project) || (ws.Kind != "" && ws.Kind != r.Kind) {
+		return false
+	}
+
+	for k, v := range ws.Labels {
+		if r.Labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// NewEvent returns an event for the mutation of the resource.
+func NewEvent(eventType string, r Resource, at time.Time) Event {
+	snapshot := r
+	snapshot.Spec.Configs = nil
+	snapshot.State.ModuleData = nil
+	return Event{
+		Type:      eventType,
+		Timestamp: at,
+		Resource:  snapshot,
+	}
+}
+
+// WatchToken returns an opaque token for resuming a watch right after the
+// event.
+func (ev Event) WatchToken() string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(ev.ID, 10)))
+}
+
+// ParseWatchToken returns the id of the event the token resumes after.
+func ParseWatchToken(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.ErrInvalid.WithMsgf("invalid watch token")
+	}
+
+	eventID, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || eventID < 0 {
+		return 0, errors.ErrInvalid.WithMsgf("invalid watch token")
+	}
+	return eventID, nil
+}
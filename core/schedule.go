@@ -0,0 +1,231 @@
+package core
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	scheduleBatchSize = 20
+
+	// scheduleLease is how long a claimed schedule is held back from other
+	// schedulers in case the one running it dies midway.
+	scheduleLease = 5 * time.Minute
+
+	// scheduleDeferInterval is how long a one-off schedule waits when its
+	// resource is still being synced.
+	scheduleDeferInterval = time.Minute
+)
+
+// ScheduleAction stores the action to be applied on the resource at the
+// run-at time or on the cron expression of the schedule.
+func (svc *Service) ScheduleAction(ctx context.Context, s schedule.Schedule) (*schedule.Schedule, error) {
+	scheduleStore, err := svc.scheduleStore()
+	if err != nil {
+		return nil, err
+	}
+
+	now := svc.clock()
+	nextRunAt, err := s.Validate(now)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := svc.store.GetByURN(ctx, s.URN)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("resource with urn '%s' not found", s.URN)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, res.Project, s.Action.Name); err != nil {
+		return nil, err
+	}
+
+	s.Project = res.Project
+	s.Status = schedule.StatusPending
+	s.NextRunAt = nextRunAt
+	s.CreatedAt = now
+	s.Action.UserID = s.CreatedBy
+
+	created, err := scheduleStore.CreateSchedule(ctx, s)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return created, nil
+}
+
+func (svc *Service) ListScheduledActions(ctx context.Context, filter schedule.Filter) ([]schedule.Schedule, error) {
+	scheduleStore, err := svc.scheduleStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if filter.Project == "" && filter.URN != "" {
+		err = svc.authorizeURN(ctx, filter.URN, rbac.PermissionRead)
+	} else {
+		err = svc.authorize(ctx, filter.Project, rbac.PermissionRead)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	schedules, err := scheduleStore.ListSchedules(ctx, filter)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return schedules, nil
+}
+
+// CancelScheduledAction stops a pending schedule from running again.
+func (svc *Service) CancelScheduledAction(ctx context.Context, id int64) (*schedule.Schedule, error) {
+	scheduleStore, err := svc.scheduleStore()
+	if err != nil {
+		return nil, err
+	}
+
+	s, err := scheduleStore.GetSchedule(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("scheduled action with id '%d' not found", id)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, s.Project, s.Action.Name); err != nil {
+		return nil, err
+	} else if s.Status != schedule.StatusPending {
+		return nil, errors.ErrInvalid.WithMsgf("cannot cancel scheduled action in '%s'", s.Status)
+	}
+
+	s.Status = schedule.StatusCancelled
+	if err := scheduleStore.UpdateSchedule(ctx, *s); err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return s, nil
+}
+
+// RunScheduler applies the due scheduled actions every interval until ctx
+// is cancelled. It does nothing if the store does not support scheduling.
+func (svc *Service) RunScheduler(ctx context.Context, interval time.Duration, eg *errgroup.Group) {
+	if _, ok := svc.store.(schedule.Store); !ok {
+		return
+	}
+
+	eg.Go(func() error {
+		tick := time.NewTicker(interval)
+		defer tick.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-tick.C:
+			}
+
+			if _, err := svc.RunDueSchedules(ctx); err != nil {
+				zap.L().Warn("RunDueSchedules() failed", zap.Error(err))
+			}
+		}
+	})
+}
+
+// RunDueSchedules applies the scheduled actions that are due now and
+// returns the number of schedules processed. A schedule whose resource is
+// still being synced is deferred if it is a one-off, and skips the run if
+// it is recurring.
+func (svc *Service) RunDueSchedules(ctx context.Context) (int, error) {
+	scheduleStore, err := svc.scheduleStore()
+	if err != nil {
+		return 0, err
+	}
+
+	now := svc.clock()
+	due, err := scheduleStore.ClaimDueSchedules(ctx, now, scheduleLease, scheduleBatchSize)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, s := range due {
+		ran := svc.runSchedule(ctx, s, now)
+		if err := scheduleStore.UpdateSchedule(ctx, ran); err != nil {
+			zap.L().Warn("failed to update scheduled action",
+				zap.Int64("schedule_id", s.ID),
+				zap.Error(err),
+			)
+		}
+	}
+	return len(due), nil
+}
+
+func (svc *Service) runSchedule(ctx context.Context, s schedule.Schedule, now time.Time) schedule.Schedule {
+	logEntry := zap.L().With(
+		zap.Int64("schedule_id", s.ID),
+		zap.String("resource_urn", s.URN),
+		zap.String("action", s.Action.Name),
+	)
+
+	res, err := svc.store.GetByURN(ctx, s.URN)
+	if err == nil && !res.State.IsTerminal() {
+		if !s.IsRecurring() {
+			logEntry.Info("resource is being synced, deferring scheduled action")
+			s.NextRunAt = now.Add(scheduleDeferInterval)
+			return s
+		}
+		logEntry.Info("resource is being synced, skipping scheduled run")
+		return svc.advanceSchedule(s, now, "skipped: resource in '"+res.State.Status+"'")
+	}
+
+	if err == nil {
+		ctx = audit.WithActor(ctx, audit.Actor{
+			UserID:    s.CreatedBy,
+			RequestID: "schedule-" + strconv.FormatInt(s.ID, 10),
+		})
+		_, err = svc.ApplyAction(ctx, s.URN, s.Action)
+	}
+
+	lastError := ""
+	if err != nil {
+		logEntry.Warn("scheduled action failed", zap.Error(err))
+		lastError = err.Error()
+	}
+
+	s.LastRunAt = &now
+	if !s.IsRecurring() || errors.Is(err, errors.ErrNotFound) {
+		s.LastError = lastError
+		s.Status = schedule.StatusCompleted
+		if err != nil {
+			s.Status = schedule.StatusFailed
+		}
+		return s
+	}
+	return svc.advanceSchedule(s, now, lastError)
+}
+
+// advanceSchedule moves a recurring schedule to its next run.
+func (svc *Service) advanceSchedule(s schedule.Schedule, now time.Time, lastError string) schedule.Schedule {
+	s.LastError = lastError
+
+	next, err := s.NextAfter(now)
+	if err != nil {
+		s.Status = schedule.StatusFailed
+		s.LastError = err.Error()
+		return s
+	}
+	s.NextRunAt = next
+	return s
+}
+
+func (svc *Service) scheduleStore() (schedule.Store, error) {
+	scheduleStore, ok := svc.store.(schedule.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("scheduled actions are not supported by the store")
+	}
+	return scheduleStore, nil
+}
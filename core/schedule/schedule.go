@@ -0,0 +1,109 @@
+package schedule
+
+import (
+	"context"
+	"time"
+
+	"github.com/robfig/cron/v3"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	StatusPending   = "pending"
+	StatusCompleted = "completed"
+	StatusFailed    = "failed"
+	StatusCancelled = "cancelled"
+)
+
+// Store is implemented by storage backends that can keep scheduled actions.
+// Scheduling is available only if the store implements it.
+type Store interface {
+	CreateSchedule(ctx context.Context, s Schedule) (*Schedule, error)
+	GetSchedule(ctx context.Context, id int64) (*Schedule, error)
+	ListSchedules(ctx context.Context, filter Filter) ([]Schedule, error)
+
+	// ClaimDueSchedules returns up to limit pending schedules whose next run
+	// is due at now, oldest first. Claimed schedules are not returned again
+	// before now+lease so that multiple schedulers do not run the same
+	// schedule concurrently.
+	ClaimDueSchedules(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Schedule, error)
+
+	// UpdateSchedule records the status, next run and outcome of the last
+	// run of the schedule.
+	UpdateSchedule(ctx context.Context, s Schedule) error
+}
+
+// Schedule is an action to be applied on a resource at RunAt, or at every
+// time matching the Cron expression.
+type Schedule struct {
+	ID        int64                `json:"id"`
+	URN       string               `json:"urn"`
+	Project   string               `json:"project"`
+	Action    module.ActionRequest `json:"action"`
+	RunAt     time.Time            `json:"run_at,omitempty"`
+	Cron      string               `json:"cron,omitempty"`
+	Status    string               `json:"status"`
+	NextRunAt time.Time            `json:"next_run_at"`
+	LastRunAt *time.Time           `json:"last_run_at,omitempty"`
+	LastError string               `json:"last_error,omitempty"`
+	CreatedAt time.Time            `json:"created_at"`
+	CreatedBy string               `json:"created_by"`
+}
+
+// Filter selects the schedules to list. Empty fields match every schedule.
+type Filter struct {
+	URN     string
+	Project string
+	Status  string
+}
+
+func (f Filter) Matches(s Schedule) bool {
+	return (f.URN == "" || f.URN == s.URN) &&
+		(f.Project == "" || f.Project == s.Project) &&
+		(f.Status == "" || f.Status == s.Status)
+}
+
+// IsRecurring returns true if the schedule runs on a cron expression.
+func (s Schedule) IsRecurring() bool { return s.Cron != "" }
+
+// Validate checks that exactly one of RunAt and Cron is set and returns the
+// first run of the schedule after now.
+func (s Schedule) Validate(now time.Time) (time.Time, error) {
+	if s.Action.Name == "" {
+		return time.Time{}, errors.ErrInvalid.WithMsgf("action must be set")
+	}
+
+	switch {
+	case s.RunAt.IsZero() && s.Cron == "":
+		return time.Time{}, errors.ErrInvalid.WithMsgf("one of run_at and cron must be set")
+
+	case !s.RunAt.IsZero() && s.Cron != "":
+		return time.Time{}, errors.ErrInvalid.WithMsgf("only one of run_at and cron can be set")
+
+	case s.Cron != "":
+		return s.NextAfter(now)
+
+	case !s.RunAt.After(now):
+		return time.Time{}, errors.ErrInvalid.WithMsgf("run_at must be in the future")
+
+	default:
+		return s.RunAt, nil
+	}
+}
+
+// NextAfter returns the first time matching the cron expression of the
+// schedule after t.
+func (s Schedule) NextAfter(t time.Time) (time.Time, error) {
+	sched, err := cron.ParseStandard(s.Cron)
+	if err != nil {
+		return time.Time{}, errors.ErrInvalid.WithMsgf("invalid cron expression '%s'", s.Cron).WithCausef("%s", err.Error())
+	}
+
+	next := sched.Next(t)
+	if next.IsZero() {
+		return time.Time{}, errors.ErrInvalid.WithMsgf("cron expression '%s' never matches", s.Cron)
+	}
+	return next, nil
+}
@@ -0,0 +1,82 @@
+package schedule_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestSchedule_Validate(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, 1, 5, 10, 30, 0, 0, time.UTC)
+	action := module.ActionRequest{Name: "scale"}
+
+	table := []struct {
+		title   string
+		s       schedule.Schedule
+		want    time.Time
+		wantErr error
+	}{
+		{
+			title:   "NoAction",
+			s:       schedule.Schedule{Cron: "@daily"},
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			title:   "NeitherRunAtNorCron",
+			s:       schedule.Schedule{Action: action},
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			title:   "BothRunAtAndCron",
+			s:       schedule.Schedule{Action: action, RunAt: now.Add(time.Hour), Cron: "@daily"},
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			title:   "RunAtInPast",
+			s:       schedule.Schedule{Action: action, RunAt: now.Add(-time.Minute)},
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			title:   "InvalidCron",
+			s:       schedule.Schedule{Action: action, Cron: "0 25 * * *"},
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			title: "RunAt",
+			s:     schedule.Schedule{Action: action, RunAt: now.Add(time.Hour)},
+			want:  now.Add(time.Hour),
+		},
+		{
+			title: "Cron",
+			s:     schedule.Schedule{Action: action, Cron: "0 21 * * *"},
+			want:  time.Date(2024, 1, 5, 21, 0, 0, 0, time.UTC),
+		},
+		{
+			title: "CronWithTimezone",
+			s:     schedule.Schedule{Action: action, Cron: "CRON_TZ=Asia/Jakarta 0 9 * * MON"},
+			want:  time.Date(2024, 1, 8, 2, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := tt.s.Validate(now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+			} else {
+				assert.NoError(t, err)
+				assert.True(t, tt.want.Equal(got), "want %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
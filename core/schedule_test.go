@@ -0,0 +1,167 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_ScheduledActions(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	var applied []string
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			applied = append(applied, act.Name)
+			planned := res.Resource
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+
+	now := frozenTime
+	clock := func() time.Time { return now }
+	svc := core.New(store, mod, clock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	ctx := context.Background()
+	require.NoError(t, store.Create(ctx, resource.Resource{
+		URN:     urn,
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		State:   resource.State{Status: resource.StatusCompleted},
+	}))
+
+	once, err := svc.ScheduleAction(ctx, schedule.Schedule{
+		URN:       urn,
+		Action:    module.ActionRequest{Name: "scale", Params: json.RawMessage(`{"replicas":10}`)},
+		RunAt:     now.Add(30 * time.Minute),
+		CreatedBy: "john",
+	})
+	require.NoError(t, err)
+	assert.Equal(t, "project", once.Project)
+	assert.Equal(t, schedule.StatusPending, once.Status)
+	assert.Equal(t, now.Add(30*time.Minute), once.NextRunAt)
+
+	hourly, err := svc.ScheduleAction(ctx, schedule.Schedule{
+		URN:       urn,
+		Action:    module.ActionRequest{Name: "restart"},
+		Cron:      "@hourly",
+		CreatedBy: "jane",
+	})
+	require.NoError(t, err)
+	firstRun := now.Truncate(time.Hour).Add(time.Hour)
+	assert.Equal(t, firstRun, hourly.NextRunAt)
+
+	ran, err := svc.RunDueSchedules(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, ran)
+
+	now = firstRun
+	ran, err = svc.RunDueSchedules(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, ran)
+	assert.Equal(t, []string{"scale", "restart"}, applied)
+
+	schedules, err := svc.ListScheduledActions(ctx, schedule.Filter{URN: urn})
+	require.NoError(t, err)
+	require.Len(t, schedules, 2)
+	assert.Equal(t, schedule.StatusCompleted, schedules[0].Status)
+	assert.Equal(t, &firstRun, schedules[0].LastRunAt)
+	assert.Equal(t, schedule.StatusPending, schedules[1].Status)
+	assert.Equal(t, firstRun.Add(time.Hour), schedules[1].NextRunAt)
+
+	events, err := svc.ListAuditEvents(ctx, audit.Filter{URN: urn})
+	require.NoError(t, err)
+	require.Len(t, events, 2)
+	assert.Equal(t, "jane", events[0].UserID)
+	assert.Equal(t, "john", events[1].UserID)
+
+	t.Run("NonTerminalResource", func(t *testing.T) {
+		res, err := store.GetByURN(ctx, urn)
+		require.NoError(t, err)
+		res.State.Status = resource.StatusPending
+		require.NoError(t, store.Update(ctx, *res, false, "sync"))
+
+		deferred, err := svc.ScheduleAction(ctx, schedule.Schedule{
+			URN:    urn,
+			Action: module.ActionRequest{Name: "stop"},
+			RunAt:  now.Add(time.Hour),
+		})
+		require.NoError(t, err)
+
+		now = now.Add(time.Hour)
+		ran, err := svc.RunDueSchedules(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 2, ran)
+		assert.Len(t, applied, 2)
+
+		schedules, err := svc.ListScheduledActions(ctx, schedule.Filter{Status: schedule.StatusPending})
+		require.NoError(t, err)
+		require.Len(t, schedules, 2)
+
+		// the recurring schedule skips the run, the one-off waits.
+		assert.Equal(t, hourly.ID, schedules[0].ID)
+		assert.Equal(t, now.Add(time.Hour), schedules[0].NextRunAt)
+		assert.Contains(t, schedules[0].LastError, "skipped")
+		assert.Equal(t, deferred.ID, schedules[1].ID)
+		assert.Equal(t, now.Add(time.Minute), schedules[1].NextRunAt)
+	})
+
+	t.Run("Cancel", func(t *testing.T) {
+		cancelled, err := svc.CancelScheduledAction(ctx, hourly.ID)
+		require.NoError(t, err)
+		assert.Equal(t, schedule.StatusCancelled, cancelled.Status)
+
+		_, err = svc.CancelScheduledAction(ctx, hourly.ID)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		_, err = svc.CancelScheduledAction(ctx, 100)
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("Invalid", func(t *testing.T) {
+		_, err := svc.ScheduleAction(ctx, schedule.Schedule{
+			URN:    urn,
+			Action: module.ActionRequest{Name: "stop"},
+			Cron:   "every day",
+		})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+
+		_, err = svc.ScheduleAction(ctx, schedule.Schedule{
+			URN:    "orn:entropy:mock:project:missing",
+			Action: module.ActionRequest{Name: "stop"},
+			Cron:   "@daily",
+		})
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.ListScheduledActions(ctx, schedule.Filter{})
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+}
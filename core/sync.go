@@ -25,9 +25,25 @@ const (
 )
 
 // RunSyncer runs the syncer thread that keeps performing resource-sync at
-// regular intervals.
-func (svc *Service) RunSyncer(ctx context.Context, workerCount int, interval time.Duration, scope map[string][]string, eg *errgroup.Group) {
+// regular intervals. If the store supports sync notifications, workers sleep
+// until a resource becomes due instead, and poll every fallbackInterval only
+// to catch up on missed notifications.
+func (svc *Service) RunSyncer(ctx context.Context, workerCount int, interval, fallbackInterval time.Duration, scope map[string][]string, eg *errgroup.Group) {
+	notifier, _ := svc.store.(resource.SyncNotifier)
+
 	for i := 0; i < workerCount; i++ {
+		// subscribe before starting the worker so that no notification sent
+		// after RunSyncer returns is missed.
+		var wakeUp <-chan struct{}
+		if notifier != nil {
+			notifications, err := notifier.SyncNotifications(ctx)
+			if err != nil {
+				zap.L().Warn("failed to subscribe to sync notifications, polling instead", zap.Error(err))
+			} else {
+				wakeUp = notifications
+			}
+		}
+
 		eg.Go(func() error {
 			tick := time.NewTimer(interval)
 			defer tick.Stop()
@@ -36,20 +52,143 @@ func (svc *Service) RunSyncer(ctx context.Context, workerCount int, interval tim
 				select {
 				case <-ctx.Done():
 					return ctx.Err()
+				case _, ok := <-wakeUp:
+					if !ok {
+						// store stopped notifying, fall back to polling.
+						wakeUp = nil
+					}
 				case <-tick.C:
-					tick.Reset(interval)
+				}
 
-					err := svc.store.SyncOne(ctx, scope, svc.handleSync)
-					if err != nil {
-						zap.L().Warn("SyncOne() failed", zap.Error(err))
-					}
+				err := svc.syncOne(ctx, scope)
+				if err != nil {
+					zap.L().Warn("SyncOne() failed", zap.Error(err))
+				}
+
+				if wakeUp == nil {
+					tick.Reset(interval)
+				} else {
+					tick.Reset(svc.nextSyncWait(ctx, notifier, scope, fallbackInterval))
 				}
 			}
 		})
 	}
 }
 
-func (svc *Service) handleSync(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
+// nextSyncWait returns how long a notified worker can sleep before the next
+// resource in the scope becomes due for sync.
+func (svc *Service) nextSyncWait(ctx context.Context, notifier resource.SyncNotifier, scope map[string][]string, fallbackInterval time.Duration) time.Duration {
+	nextSyncAt, err := notifier.NextSyncAt(ctx, scope)
+	if err != nil {
+		zap.L().Warn("NextSyncAt() failed", zap.Error(err))
+		return fallbackInterval
+	} else if nextSyncAt == nil {
+		return fallbackInterval
+	}
+
+	wait := time.Until(*nextSyncAt)
+	if wait < 0 {
+		return 0
+	} else if wait > fallbackInterval {
+		return fallbackInterval
+	}
+	return wait
+}
+
+// syncOne syncs the next resource due in the scope. The audit event and the
+// webhook deliveries of a sync that ended in a terminal state are recorded
+// only once the synced state is saved, the deliveries within the same write.
+// The deliveries carry the action of the operation the sync carries out.
+// Dependents are notified of an output change once the completed resource
+// is saved, so that they resolve the new output.
+func (svc *Service) syncOne(ctx context.Context, scope map[string][]string) error {
+	var synced *resource.Resource
+	var action string
+	var propagate bool
+	syncFn := func(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
+		var err error
+		synced, action, err = svc.handleSync(ctx, res)
+		if err == nil && synced != nil && synced.State.OutputChanged && synced.State.Status == resource.StatusCompleted {
+			synced.State.OutputChanged = false
+			propagate = true
+		}
+		return synced, err
+	}
+
+	notify := func(ctx context.Context) error {
+		if !synced.State.IsTerminal() {
+			return nil
+		}
+		for _, hook := range svc.notifySync(*synced, action) {
+			if err := hook(ctx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	err := svc.store.SyncOne(ctx, scope, syncFn, notify)
+	if err != nil || synced == nil || !synced.State.IsTerminal() {
+		return err
+	}
+
+	if propagate {
+		svc.propagateOutputChange(ctx, *synced)
+	}
+
+	var syncErr error
+	if synced.State.Status == resource.StatusError {
+		syncErr = errors.Errorf("%s", synced.State.SyncResult.LastError)
+	}
+	svc.recordAudit(ctx, *synced, "", "sync", nil, syncErr)
+	return nil
+}
+
+// handleSync syncs the resource and returns the synced resource along with
+// the action of the operation tracking the sync.
+func (svc *Service) handleSync(ctx context.Context, res resource.Resource) (*resource.Resource, string, error) {
+	if res.State.DeleteDeferred {
+		held, err := svc.holdDeferredDelete(ctx, res)
+		if err != nil || held != nil {
+			return held, "", err
+		}
+		res.State.DeleteDeferred = false
+	}
+
+	startedAt := svc.clock()
+	synced, err := svc.syncResource(ctx, res)
+	svc.recordSyncRun(ctx, res, synced, startedAt, err)
+	action := svc.trackOperation(ctx, res, synced, err)
+	return synced, action, err
+}
+
+// holdDeferredDelete reschedules the deferred deletion of the resource if
+// some of its dependents are not deleted yet. Returns nil once the deletion
+// can go ahead.
+func (svc *Service) holdDeferredDelete(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
+	urns, err := svc.store.Dependents(ctx, res.URN)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, urn := range urns {
+		dep, err := svc.store.GetByURN(ctx, urn)
+		if errors.Is(err, errors.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return nil, err
+		} else if dep.State.InDeletion() {
+			continue
+		}
+
+		tryAgainAt := svc.clock().Add(svc.syncBackoff)
+		res.State.NextSyncAt = &tryAgainAt
+		return &res, nil
+	}
+	return nil, nil
+}
+
+func (svc *Service) syncResource(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
 	logEntry := zap.L().With(
 		zap.String("resource_urn", res.URN),
 		zap.String("resource_status", res.State.Status),
@@ -113,10 +252,19 @@ func (svc *Service) handleSync(ctx context.Context, res resource.Resource) (*res
 			res.State.NextSyncAt = &tryAgainAt
 		}
 	} else {
+		changed := res.State.OutputChanged || outputChanged(res.State.Output, newState.Output)
+
 		res.State.SyncResult.Retries = 0
 		res.State.SyncResult.LastError = ""
 		res.UpdatedAt = svc.clock()
+		prevDeps := res.State.PreviousDependencies
+
 		res.State = *newState
+		res.State.OutputChanged = changed
+		if res.State.Status != resource.StatusCompleted {
+			// the module may still need to clean up after them.
+			res.State.PreviousDependencies = prevDeps
+		}
 
 		// Increment the completed counter.
 		logEntry.Info("Incrementing completed counter")
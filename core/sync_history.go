@@ -0,0 +1,82 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// GetSyncHistory returns the recorded syncs of the resource, newest first.
+func (svc *Service) GetSyncHistory(ctx context.Context, urn string, limit int) ([]resource.SyncRun, error) {
+	historyStore, ok := svc.store.(resource.SyncHistoryStore)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("sync history is not supported by the store")
+	}
+
+	res, err := svc.store.GetByURN(ctx, urn)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("resource with urn '%s' not found", urn)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, res.Project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	runs, err := historyStore.SyncHistory(ctx, urn, limit)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return runs, nil
+}
+
+// recordSyncRun appends the outcome of a sync to the sync history if the
+// store keeps one. The step is the pending step the sync picked up.
+func (svc *Service) recordSyncRun(ctx context.Context, res resource.Resource, synced *resource.Resource, startedAt time.Time, syncErr error) {
+	historyStore, ok := svc.store.(resource.SyncHistoryStore)
+	if !ok {
+		return
+	}
+
+	step := resource.SyncStepDefault
+	if steps := pendingSteps(res.State.ModuleData); len(steps) > 0 {
+		step = steps[0]
+	}
+
+	run := resource.SyncRun{
+		URN:        res.URN,
+		Step:       step,
+		StartedAt:  startedAt,
+		FinishedAt: svc.clock(),
+		Outcome:    resource.SyncOutcomeSuccess,
+		Retries:    res.State.SyncResult.Retries,
+	}
+
+	switch {
+	case syncErr != nil:
+		run.Outcome = resource.SyncOutcomeFailure
+		run.Error = syncErr.Error()
+
+	case synced.State.Status == resource.StatusError:
+		run.Outcome = resource.SyncOutcomeFailure
+		run.Error = synced.State.SyncResult.LastError
+		run.Retries = synced.State.SyncResult.Retries
+
+	case synced.State.SyncResult.LastError != "":
+		run.Outcome = resource.SyncOutcomeRetry
+		run.Error = synced.State.SyncResult.LastError
+		run.Retries = synced.State.SyncResult.Retries
+	}
+
+	if err := historyStore.AppendSyncRun(ctx, run); err != nil {
+		zap.L().Warn("failed to record sync run",
+			zap.String("resource_urn", res.URN),
+			zap.Error(err),
+		)
+	}
+}
@@ -0,0 +1,100 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_GetSyncHistory(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+	require.NoError(t, err)
+
+	require.NoError(t, store.Create(context.Background(), resource.Resource{
+		URN:     urn,
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		State: resource.State{
+			Status:     resource.StatusPending,
+			ModuleData: []byte(`{"pending_steps":["release_update","consumer_reset"]}`),
+			NextSyncAt: &frozenTime,
+		},
+	}))
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		Return(&resource.State{
+			Status:     resource.StatusPending,
+			ModuleData: []byte(`{"pending_steps":["consumer_reset"]}`),
+			NextSyncAt: &frozenTime,
+		}, nil).
+		Once()
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		Return(nil, errors.ErrInvalid.WithMsgf("bad offset")).
+		Once()
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eg := &errgroup.Group{}
+	svc.RunSyncer(ctx, 1, 5*time.Millisecond, time.Second, nil, eg)
+
+	assert.Eventually(t, func() bool {
+		res, err := store.GetByURN(context.Background(), urn)
+		return err == nil && res.State.Status == resource.StatusError
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, eg.Wait(), context.Canceled)
+
+	runs, err := svc.GetSyncHistory(context.Background(), urn, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []resource.SyncRun{
+		{
+			ID:         2,
+			URN:        urn,
+			Step:       "consumer_reset",
+			StartedAt:  frozenTime,
+			FinishedAt: frozenTime,
+			Outcome:    resource.SyncOutcomeFailure,
+			Error:      "bad_request: bad offset",
+			Retries:    1,
+		},
+		{
+			ID:         1,
+			URN:        urn,
+			Step:       "release_update",
+			StartedAt:  frozenTime,
+			FinishedAt: frozenTime,
+			Outcome:    resource.SyncOutcomeSuccess,
+		},
+	}, runs)
+
+	latest, err := svc.GetSyncHistory(context.Background(), urn, 1)
+	require.NoError(t, err)
+	require.Len(t, latest, 1)
+	assert.Equal(t, int64(2), latest[0].ID)
+
+	_, err = svc.GetSyncHistory(context.Background(), "orn:entropy:mock:project:unknown", 0)
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+}
@@ -1 +1,399 @@
 package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_RunSyncer(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{
+				Status:     resource.StatusPending,
+				NextSyncAt: &frozenTime,
+			}
+			return &planned, nil
+		}).
+		Once()
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		Return(&resource.State{Status: resource.StatusCompleted}, nil).
+		Once()
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	created, err := svc.CreateResource(context.Background(), resource.Resource{
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Spec:    resource.Spec{Configs: []byte(`{}`)},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, resource.StatusPending, created.State.Status)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	eg := &errgroup.Group{}
+	svc.RunSyncer(ctx, 2, 5*time.Millisecond, time.Second, nil, eg)
+
+	assert.Eventually(t, func() bool {
+		res, err := store.GetByURN(context.Background(), created.URN)
+		return err == nil && res.State.Status == resource.StatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, eg.Wait(), context.Canceled)
+	mod.AssertExpectations(t)
+}
+
+func TestService_RunSyncer_Notified(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{
+				Status:     resource.StatusPending,
+				NextSyncAt: &frozenTime,
+			}
+			return &planned, nil
+		}).
+		Once()
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		Return(&resource.State{Status: resource.StatusCompleted}, nil).
+		Once()
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// intervals are long enough that only a notification can trigger the sync.
+	eg := &errgroup.Group{}
+	svc.RunSyncer(ctx, 1, time.Hour, time.Hour, nil, eg)
+
+	created, err := svc.CreateResource(context.Background(), resource.Resource{
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Spec:    resource.Spec{Configs: []byte(`{}`)},
+	})
+	require.NoError(t, err)
+
+	assert.Eventually(t, func() bool {
+		res, err := store.GetByURN(context.Background(), created.URN)
+		return err == nil && res.State.Status == resource.StatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, eg.Wait(), context.Canceled)
+	mod.AssertExpectations(t)
+}
+
+// failingSyncStore fails to save the state of every synced resource.
+type failingSyncStore struct {
+	*inmemory.Store
+	synced atomic.Int32
+}
+
+func (st *failingSyncStore) SyncOne(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn, hooks ...resource.MutationHook) error {
+	return st.Store.SyncOne(ctx, scope, func(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
+		if _, err := syncFn(ctx, res); err != nil {
+			return nil, err
+		}
+		st.synced.Add(1)
+		return nil, errors.ErrInternal.WithMsgf("failed to save state")
+	}, hooks...)
+}
+
+func TestService_RunSyncer_Audit(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	table := []struct {
+		title      string
+		failUpdate bool
+		wantEvents int
+	}{
+		{title: "Saved", wantEvents: 2},
+		{title: "SaveFailed", failUpdate: true, wantEvents: 1},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+
+			mem, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+			require.NoError(t, err)
+
+			mod := &mocks.ModuleService{}
+			mod.EXPECT().
+				PlanAction(mock.Anything, mock.Anything, mock.Anything).
+				RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+					planned := res.Resource
+					planned.Spec.Configs = act.Params
+					planned.State = resource.State{
+						Status:     resource.StatusPending,
+						NextSyncAt: &frozenTime,
+					}
+					return &planned, nil
+				}).
+				Once()
+			mod.EXPECT().
+				SyncState(mock.Anything, mock.Anything).
+				Return(&resource.State{Status: resource.StatusCompleted}, nil)
+
+			var store resource.Store = mem
+			failing := &failingSyncStore{Store: mem}
+			if tt.failUpdate {
+				store = failing
+			}
+
+			svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			_, err = svc.CreateWebhook(context.Background(), webhook.Subscription{Project: "project", URL: "http://localhost/all"})
+			require.NoError(t, err)
+
+			_, err = svc.CreateResource(context.Background(), resource.Resource{
+				Kind:    "mock",
+				Name:    "child",
+				Project: "project",
+				Spec:    resource.Spec{Configs: []byte(`{}`)},
+			})
+			require.NoError(t, err)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			eg := &errgroup.Group{}
+			svc.RunSyncer(ctx, 1, 5*time.Millisecond, time.Second, nil, eg)
+
+			assert.Eventually(t, func() bool {
+				if tt.failUpdate {
+					return failing.synced.Load() > 0
+				}
+				res, err := mem.GetByURN(context.Background(), urn)
+				return err == nil && res.State.Status == resource.StatusCompleted
+			}, 2*time.Second, 10*time.Millisecond)
+
+			cancel()
+			assert.ErrorIs(t, eg.Wait(), context.Canceled)
+
+			// the sync is recorded and notified only once its state is saved.
+			events, err := mem.ListAuditEvents(context.Background(), audit.Filter{URN: urn})
+			require.NoError(t, err)
+			assert.Len(t, events, tt.wantEvents)
+			assert.Equal(t, module.CreateAction, events[len(events)-1].Action)
+
+			deliveries, err := mem.ClaimDeliveries(context.Background(), frozenTime, time.Minute, 0)
+			require.NoError(t, err)
+			assert.Len(t, deliveries, tt.wantEvents)
+			assert.Equal(t, webhook.EventResourceCreated, deliveries[0].Event.Type)
+		})
+	}
+}
+
+func TestService_RunSyncer_DeferredDelete(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	parent := resource.Resource{
+		URN:     "orn:entropy:mock:project:parent",
+		Kind:    "mock",
+		Name:    "parent",
+		Project: "project",
+		State:   resource.State{Status: resource.StatusCompleted},
+	}
+	child := resource.Resource{
+		URN:     "orn:entropy:mock:project:child",
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Spec:    resource.Spec{Dependencies: map[string]string{"parent": parent.URN}},
+		State:   resource.State{Status: resource.StatusCompleted},
+	}
+	require.NoError(t, store.Create(ctx, parent))
+	require.NoError(t, store.Create(ctx, child))
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, _ module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.State = resource.State{
+				Status:     resource.StatusPending,
+				NextSyncAt: &frozenTime,
+			}
+			return &planned, nil
+		})
+	mod.EXPECT().
+		DescribeEffects(mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, nil).
+		Maybe()
+
+	var synced []string
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource) (*resource.State, error) {
+			if res.URN == parent.URN {
+				// the parent is deleted only after its dependents are gone.
+				cur, err := store.GetByURN(ctx, child.URN)
+				require.NoError(t, err)
+				assert.True(t, cur.State.InDeletion())
+			}
+			synced = append(synced, res.Name)
+			return &resource.State{Status: resource.StatusDeleted}, nil
+		}).
+		Times(2)
+
+	// the held deletion is retried after the backoff, which needs a running
+	// clock.
+	svc := core.New(store, mod, time.Now, 10*time.Millisecond, defaultMaxRetries, serviceName)
+	require.NoError(t, svc.DeleteResource(ctx, parent.URN, core.WithCascade(true)))
+
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eg := &errgroup.Group{}
+	svc.RunSyncer(syncCtx, 1, 5*time.Millisecond, time.Second, nil, eg)
+
+	assert.Eventually(t, func() bool {
+		res, err := store.GetByURN(ctx, parent.URN)
+		return err == nil && res.State.InDeletion()
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, eg.Wait(), context.Canceled)
+	assert.Equal(t, []string{"child", "parent"}, synced)
+}
+
+func TestService_RunSyncer_PreviousDependencies(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(10*time.Millisecond, time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	for _, name := range []string{"cluster-a", "cluster-b"} {
+		require.NoError(t, store.Create(ctx, resource.Resource{
+			URN:     "orn:entropy:mock:project:" + name,
+			Kind:    "mock",
+			Name:    name,
+			Project: "project",
+			State: resource.State{
+				Status: resource.StatusCompleted,
+				Output: []byte(`"` + name + `"`),
+			},
+		}))
+	}
+	child := resource.Resource{
+		URN:     "orn:entropy:mock:project:child",
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Spec:    resource.Spec{Dependencies: map[string]string{"cluster": "orn:entropy:mock:project:cluster-a"}},
+		State:   resource.State{Status: resource.StatusCompleted},
+	}
+	require.NoError(t, store.Create(ctx, child))
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource) (json.RawMessage, error) {
+			return res.State.Output, nil
+		})
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, _ module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.State = resource.State{
+				Status:     resource.StatusPending,
+				NextSyncAt: &frozenTime,
+			}
+			return &planned, nil
+		}).
+		Once()
+
+	// the previous cluster is resolved for every sync until the child is
+	// completed.
+	var syncs atomic.Int32
+	mod.EXPECT().
+		SyncState(mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource) (*resource.State, error) {
+			assert.JSONEq(t, `"cluster-b"`, string(res.Dependencies["cluster"].Output))
+			assert.JSONEq(t, `"cluster-a"`, string(res.PreviousDependencies["cluster"].Output))
+
+			if syncs.Add(1) == 1 {
+				return &resource.State{Status: resource.StatusPending, NextSyncAt: &frozenTime}, nil
+			}
+			return &resource.State{Status: resource.StatusCompleted}, nil
+		}).
+		Times(2)
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+	updated, err := svc.UpdateResource(ctx, child.URN, resource.UpdateRequest{
+		Spec: resource.Spec{Dependencies: map[string]string{"cluster": "orn:entropy:mock:project:cluster-b"}},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]string{"cluster": "orn:entropy:mock:project:cluster-a"}, updated.State.PreviousDependencies)
+
+	syncCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	eg := &errgroup.Group{}
+	svc.RunSyncer(syncCtx, 1, 5*time.Millisecond, time.Second, nil, eg)
+
+	assert.Eventually(t, func() bool {
+		res, err := store.GetByURN(ctx, child.URN)
+		return err == nil && res.State.Status == resource.StatusCompleted
+	}, 2*time.Second, 10*time.Millisecond)
+
+	cancel()
+	assert.ErrorIs(t, eg.Wait(), context.Canceled)
+
+	res, err := store.GetByURN(ctx, child.URN)
+	require.NoError(t, err)
+	assert.Nil(t, res.State.PreviousDependencies)
+	mod.AssertExpectations(t)
+}
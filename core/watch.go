@@ -0,0 +1,141 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	watchBatchSize = 100
+
+	// watchPollInterval bounds the delay of events whose notification got
+	// lost, e.g., while the store was reconnecting.
+	watchPollInterval = 10 * time.Second
+)
+
+// WatchResources streams the events of the resources matching the selector.
+// If token is set, the stream resumes right after the event the token was
+// taken from, otherwise only the events from now on are streamed. The
+// channel is closed when ctx is cancelled or reading the events fails.
+func (svc *Service) WatchResources(ctx context.Context, selector resource.WatchSelector, token string) (<-chan resource.Event, error) {
+	eventStore, ok := svc.store.(resource.EventStore)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("watching resources is not supported by the store")
+	} else if err := svc.authorize(ctx, selector.Project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	var afterID int64
+	if token != "" {
+		id, err := resource.ParseWatchToken(token)
+		if err != nil {
+			return nil, err
+		}
+		afterID = id
+	} else {
+		id, err := eventStore.LastResourceEventID(ctx)
+		if err != nil {
+			return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+		}
+		afterID = id
+	}
+
+	// subscribe before the first read so that no event is missed in between.
+	notifications, err := eventStore.ResourceEventNotifications(ctx)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	// the first batch is read right away so that an expired token is
+	// reported to the caller.
+	events, err := eventStore.ResourceEvents(ctx, afterID, watchBatchSize)
+	if err != nil {
+		if errors.Is(err, errors.ErrInvalid) {
+			return nil, err
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	eventCh := make(chan resource.Event)
+	go func() {
+		defer close(eventCh)
+
+		poll := time.NewTicker(watchPollInterval)
+		defer poll.Stop()
+
+		for {
+			for _, ev := range events {
+				afterID = ev.ID
+				if !selector.Matches(ev.Resource) {
+					continue
+				}
+
+				select {
+				case <-ctx.Done():
+					return
+				case eventCh <- ev:
+				}
+			}
+
+			if len(events) < watchBatchSize {
+				select {
+				case <-ctx.Done():
+					return
+				case _, open := <-notifications:
+					if !open {
+						// keep polling if the store stopped notifying.
+						notifications = nil
+					}
+				case <-poll.C:
+				}
+			}
+
+			events, err = eventStore.ResourceEvents(ctx, afterID, watchBatchSize)
+			if err != nil {
+				if ctx.Err() == nil {
+					zap.L().Error("failed to read resource events", zap.Int64("after_id", afterID), zap.Error(err))
+				}
+				return
+			}
+		}
+	}()
+
+	return eventCh, nil
+}
+
+// RunEventPruner drops the resource events older than retention every
+// interval until ctx is cancelled, expiring the watch tokens taken from
+// them. It does nothing if the store does not keep resource events.
+func (svc *Service) RunEventPruner(ctx context.Context, retention, interval time.Duration, eg *errgroup.Group) {
+	eventStore, ok := svc.store.(resource.EventStore)
+	if !ok {
+		return
+	}
+
+	eg.Go(func() error {
+		tick := time.NewTicker(interval)
+		defer tick.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-tick.C:
+			}
+
+			pruned, err := eventStore.PruneResourceEvents(ctx, svc.clock().Add(-retention))
+			if err != nil {
+				zap.L().Warn("PruneResourceEvents() failed", zap.Error(err))
+			} else if pruned > 0 {
+				zap.L().Info("pruned resource events", zap.Int("count", pruned))
+			}
+		}
+	})
+}
@@ -0,0 +1,131 @@
+package core_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sync/errgroup"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_WatchResources(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	newResource := func(project, name string) resource.Resource {
+		return resource.Resource{
+			URN:     "orn:entropy:mock:" + project + ":" + name,
+			Kind:    "mock",
+			Name:    name,
+			Project: project,
+			Labels:  map[string]string{"team": "data"},
+			Spec:    resource.Spec{Configs: []byte(`{"replicas":1}`)},
+			State:   resource.State{Status: resource.StatusPending},
+		}
+	}
+
+	// events before the watch started are not streamed without a token.
+	require.NoError(t, store.Create(ctx, newResource("foo", "before")))
+
+	svc := core.New(store, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	events, err := svc.WatchResources(ctx, resource.WatchSelector{
+		Project: "foo",
+		Labels:  map[string]string{"team": "data"},
+	}, "")
+	require.NoError(t, err)
+
+	bar := newResource("foo", "bar")
+	require.NoError(t, store.Create(ctx, bar))
+	require.NoError(t, store.Create(ctx, newResource("other", "baz")))
+
+	bar.Version = 1
+	bar.State.Status = resource.StatusCompleted
+	require.NoError(t, store.Update(ctx, bar, false, "sync"))
+	require.NoError(t, store.Delete(ctx, bar.URN))
+
+	var got []resource.Event
+	for len(got) < 3 {
+		select {
+		case ev := <-events:
+			got = append(got, ev)
+		case <-time.After(2 * time.Second):
+			t.Fatalf("timed out waiting for events, got %d", len(got))
+		}
+	}
+
+	assert.Equal(t, resource.EventCreated, got[0].Type)
+	assert.Equal(t, bar.URN, got[0].Resource.URN)
+	assert.Nil(t, got[0].Resource.Spec.Configs)
+
+	assert.Equal(t, resource.EventUpdated, got[1].Type)
+	assert.Equal(t, resource.StatusCompleted, got[1].Resource.State.Status)
+	assert.Equal(t, int64(2), got[1].Resource.Version)
+
+	assert.Equal(t, resource.EventDeleted, got[2].Type)
+
+	t.Run("Resume", func(t *testing.T) {
+		resumed, err := svc.WatchResources(ctx, resource.WatchSelector{Project: "foo"}, got[0].WatchToken())
+		require.NoError(t, err)
+
+		for _, want := range got[1:] {
+			select {
+			case ev := <-resumed:
+				assert.Equal(t, want.ID, ev.ID)
+			case <-time.After(2 * time.Second):
+				t.Fatal("timed out waiting for resumed events")
+			}
+		}
+	})
+
+	t.Run("Expired", func(t *testing.T) {
+		pruneCtx, stopPruner := context.WithCancel(ctx)
+		defer stopPruner()
+
+		pruner := core.New(store, &mocks.ModuleService{}, time.Now, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		eg := &errgroup.Group{}
+		pruner.RunEventPruner(pruneCtx, 0, 5*time.Millisecond, eg)
+
+		assert.Eventually(t, func() bool {
+			_, err := svc.WatchResources(ctx, resource.WatchSelector{}, got[0].WatchToken())
+			return errors.Is(err, errors.ErrInvalid)
+		}, 2*time.Second, 10*time.Millisecond)
+
+		stopPruner()
+		assert.ErrorIs(t, eg.Wait(), context.Canceled)
+
+		// the latest event is kept, watches resume after it.
+		_, err := svc.WatchResources(ctx, resource.WatchSelector{}, got[2].WatchToken())
+		assert.NoError(t, err)
+	})
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		_, err := svc.WatchResources(ctx, resource.WatchSelector{}, "!!")
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.WatchResources(ctx, resource.WatchSelector{}, "")
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+
+	cancel()
+	assert.Eventually(t, func() bool {
+		_, open := <-events
+		return !open
+	}, time.Second, 10*time.Millisecond)
+}
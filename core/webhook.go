@@ -0,0 +1,160 @@
+package core
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/rs/xid"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const webhookSecretBytes = 32
+
+// CreateWebhook registers a webhook subscription. If no secret is given, a
+// random one is generated. The secret is returned only on creation.
+func (svc *Service) CreateWebhook(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	webhookStore, err := svc.webhookStore()
+	if err != nil {
+		return nil, err
+	}
+
+	sub = sub.Normalize()
+	if err := sub.Validate(); err != nil {
+		return nil, err
+	} else if err := svc.authorize(ctx, sub.Project, rbac.PermissionManage); err != nil {
+		return nil, err
+	}
+
+	if sub.Secret == "" {
+		secret := make([]byte, webhookSecretBytes)
+		if _, err := rand.Read(secret); err != nil {
+			return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+		}
+		sub.Secret = hex.EncodeToString(secret)
+	}
+	sub.CreatedAt = svc.clock()
+
+	created, err := webhookStore.CreateWebhook(ctx, sub)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return created, nil
+}
+
+// ListWebhooks returns the webhook subscriptions of the project without
+// their secrets.
+func (svc *Service) ListWebhooks(ctx context.Context, project string) ([]webhook.Subscription, error) {
+	webhookStore, err := svc.webhookStore()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := svc.authorize(ctx, project, rbac.PermissionRead); err != nil {
+		return nil, err
+	}
+
+	subs, err := webhookStore.ListWebhooks(ctx, project)
+	if err != nil {
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+
+	for i := range subs {
+		subs[i].Secret = ""
+	}
+	return subs, nil
+}
+
+func (svc *Service) DeleteWebhook(ctx context.Context, id int64) error {
+	webhookStore, err := svc.webhookStore()
+	if err != nil {
+		return err
+	}
+
+	sub, err := webhookStore.GetWebhook(ctx, id)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return errors.ErrNotFound.WithMsgf("webhook with id '%d' not found", id)
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, sub.Project, rbac.PermissionManage); err != nil {
+		return err
+	}
+
+	if err := webhookStore.DeleteWebhook(ctx, id); err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return errors.ErrNotFound.WithMsgf("webhook with id '%d' not found", id)
+		}
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return nil
+}
+
+func (svc *Service) webhookStore() (webhook.Store, error) {
+	webhookStore, ok := svc.store.(webhook.Store)
+	if !ok {
+		return nil, errors.ErrUnsupported.WithMsgf("webhooks are not supported by the store")
+	}
+	return webhookStore, nil
+}
+
+// notifyAction returns the hooks that queue the webhook deliveries for an
+// action along with the write of the resource.
+func (svc *Service) notifyAction(res resource.Resource, action, userID string) []resource.MutationHook {
+	eventType := webhook.EventResourceAction
+	switch action {
+	case module.CreateAction:
+		eventType = webhook.EventResourceCreated
+	case module.UpdateAction:
+		eventType = webhook.EventResourceUpdated
+	}
+
+	return svc.notify(webhook.Event{
+		Type:   eventType,
+		Action: action,
+		UserID: userID,
+	}, res)
+}
+
+// notifySync returns the hooks that queue the webhook deliveries for a sync
+// that ended in a terminal state along with the write of the synced state.
+// action is the action whose operation the sync finished, if any.
+func (svc *Service) notifySync(res resource.Resource, action string) []resource.MutationHook {
+	ev := webhook.Event{Type: webhook.EventSyncCompleted, Action: action}
+	if res.State.Status == resource.StatusError {
+		ev.Type = webhook.EventSyncFailed
+		ev.Error = res.State.SyncResult.LastError
+	}
+	return svc.notify(ev, res)
+}
+
+// notify returns the hooks that queue the webhook deliveries of the event
+// within the write of the resource, so that the deliveries are queued if
+// and only if the write is committed. No hooks are returned if the store
+// does not support webhooks.
+func (svc *Service) notify(ev webhook.Event, res resource.Resource) []resource.MutationHook {
+	webhookStore, ok := svc.store.(webhook.Store)
+	if !ok {
+		return nil
+	}
+
+	publish := func(ctx context.Context) error {
+		ev.ID = xid.New().String()
+		ev.Timestamp = svc.clock()
+		ev.Project = res.Project
+		ev.URN = res.URN
+		ev.Kind = res.Kind
+		ev.Status = res.State.Status
+
+		if err := webhook.Publish(ctx, webhookStore, ev); err != nil {
+			return errors.ErrInternal.WithMsgf("failed to queue webhook deliveries").WithCausef("%s", err.Error())
+		}
+		return nil
+	}
+	return []resource.MutationHook{publish}
+}
@@ -0,0 +1,180 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	HeaderSignature = "X-Entropy-Signature"
+	HeaderTimestamp = "X-Entropy-Timestamp"
+	HeaderEvent     = "X-Entropy-Event"
+	HeaderDelivery  = "X-Entropy-Delivery"
+
+	dispatchBatchSize = 50
+	maxBackoff        = time.Hour
+)
+
+// Dispatcher sends the queued deliveries to the subscribers, retrying
+// failed attempts with exponential backoff.
+type Dispatcher struct {
+	store       Store
+	client      *http.Client
+	clock       func() time.Time
+	maxAttempts int
+	backoff     time.Duration
+}
+
+// NewDispatcher returns a dispatcher that gives up on a delivery after
+// maxAttempts. The wait before the n-th retry is backoff * 2^(n-1), at
+// most an hour.
+func NewDispatcher(store Store, client *http.Client, clock func() time.Time, maxAttempts int, backoff time.Duration) *Dispatcher {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if clock == nil {
+		clock = time.Now
+	}
+
+	return &Dispatcher{
+		store:       store,
+		client:      client,
+		clock:       clock,
+		maxAttempts: maxAttempts,
+		backoff:     backoff,
+	}
+}
+
+// Run dispatches the due deliveries every interval until ctx is cancelled.
+func (d *Dispatcher) Run(ctx context.Context, interval time.Duration, eg *errgroup.Group) {
+	eg.Go(func() error {
+		tick := time.NewTicker(interval)
+		defer tick.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-tick.C:
+			}
+
+			if _, err := d.DispatchDue(ctx); err != nil {
+				zap.L().Warn("DispatchDue() failed", zap.Error(err))
+			}
+		}
+	})
+}
+
+// DispatchDue attempts the deliveries that are due now and returns the
+// number of deliveries attempted. Deliveries are claimed one at a time so
+// that the lease only has to cover a single attempt, and other dispatchers
+// do not send a delivery still waiting behind the others in the batch.
+func (d *Dispatcher) DispatchDue(ctx context.Context) (int, error) {
+	lease := 2 * d.client.Timeout
+	if lease <= 0 {
+		lease = time.Minute
+	}
+
+	attempted := 0
+	for attempted < dispatchBatchSize {
+		claimed, err := d.store.ClaimDeliveries(ctx, d.clock(), lease, 1)
+		if err != nil {
+			return attempted, err
+		} else if len(claimed) == 0 {
+			break
+		}
+
+		del := d.attempt(ctx, claimed[0])
+		if err := d.store.UpdateDelivery(ctx, del); err != nil {
+			zap.L().Warn("failed to update webhook delivery",
+				zap.Int64("delivery_id", del.ID),
+				zap.Error(err),
+			)
+		}
+		attempted++
+	}
+	return attempted, nil
+}
+
+func (d *Dispatcher) attempt(ctx context.Context, del Delivery) Delivery {
+	del.Attempts++
+
+	err := d.send(ctx, del)
+	if err == nil {
+		del.Status = DeliveryDelivered
+		del.LastError = ""
+		return del
+	}
+
+	del.LastError = err.Error()
+	if d.maxAttempts > 0 && del.Attempts >= d.maxAttempts {
+		del.Status = DeliveryFailed
+		zap.L().Warn("giving up on webhook delivery",
+			zap.Int64("delivery_id", del.ID),
+			zap.String("url", del.Subscription.URL),
+			zap.Int("attempts", del.Attempts),
+			zap.Error(err),
+		)
+		return del
+	}
+
+	wait := d.backoff << (del.Attempts - 1)
+	if wait <= 0 || wait > maxBackoff {
+		wait = maxBackoff
+	}
+	del.NextAttemptAt = d.clock().Add(wait)
+	return del
+}
+
+func (d *Dispatcher) send(ctx context.Context, del Delivery) error {
+	payload, err := json.Marshal(del.Event)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, del.Subscription.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	timestamp := d.clock().Unix()
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderEvent, del.Event.Type)
+	req.Header.Set(HeaderDelivery, strconv.FormatInt(del.ID, 10))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(timestamp, 10))
+	req.Header.Set(HeaderSignature, Sign(del.Subscription.Secret, timestamp, payload))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, resp.Body)
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("unexpected response status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the signature sent with a payload at the given unix time. It
+// is the hex encoded HMAC-SHA256 of "<timestamp>.<payload>" keyed with the
+// secret of the subscription, prefixed with "sha256=".
+func Sign(secret string, timestamp int64, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write([]byte(strconv.FormatInt(timestamp, 10) + "."))
+	_, _ = mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,229 @@
+package webhook_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/internal/store/inmemory"
+)
+
+func TestDispatcher_DispatchDue(t *testing.T) {
+	t.Parallel()
+
+	const secret = "s3cr3t"
+
+	var mu sync.Mutex
+	var received []webhook.Event
+	failures := 1
+
+	receiver := httptest.NewServer(http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		payload, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+
+		timestamp, err := strconv.ParseInt(req.Header.Get(webhook.HeaderTimestamp), 10, 64)
+		require.NoError(t, err)
+		if req.Header.Get(webhook.HeaderSignature) != webhook.Sign(secret, timestamp, payload) {
+			wr.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		if failures > 0 {
+			failures--
+			wr.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+
+		var ev webhook.Event
+		require.NoError(t, json.Unmarshal(payload, &ev))
+		assert.Equal(t, ev.Type, req.Header.Get(webhook.HeaderEvent))
+		received = append(received, ev)
+	}))
+	defer receiver.Close()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	clock := func() time.Time { return now }
+
+	sub, err := store.CreateWebhook(ctx, webhook.Subscription{
+		Project: "foo",
+		URL:     receiver.URL,
+		Secret:  secret,
+		Kinds:   []string{"firehose"},
+	})
+	require.NoError(t, err)
+
+	ev := webhook.Event{
+		ID:        "ev-1",
+		Type:      webhook.EventSyncFailed,
+		Timestamp: now,
+		Project:   "foo",
+		URN:       "orn:entropy:firehose:foo:bar",
+		Kind:      "firehose",
+		Status:    resource.StatusError,
+		Error:     "boom",
+	}
+	require.NoError(t, webhook.Publish(ctx, store, ev))
+
+	// filtered out by kind.
+	other := ev
+	other.ID, other.Kind = "ev-2", "kafka"
+	require.NoError(t, webhook.Publish(ctx, store, other))
+
+	dispatcher := webhook.NewDispatcher(store, receiver.Client(), clock, 3, time.Minute)
+
+	// first attempt fails and is retried after the backoff.
+	attempted, err := dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempted)
+
+	attempted, err = dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, attempted)
+
+	now = now.Add(time.Minute)
+	attempted, err = dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 1, attempted)
+
+	mu.Lock()
+	require.Len(t, received, 1)
+	assert.Equal(t, ev.ID, received[0].ID)
+	assert.Equal(t, ev.Error, received[0].Error)
+	mu.Unlock()
+
+	// delivered events are not sent again.
+	now = now.Add(time.Hour)
+	attempted, err = dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 0, attempted)
+
+	t.Run("GivesUp", func(t *testing.T) {
+		mu.Lock()
+		failures = 10
+		mu.Unlock()
+
+		require.NoError(t, webhook.Publish(ctx, store, webhook.Event{ID: "ev-3", Project: "foo", Kind: "firehose", Timestamp: now}))
+
+		for i := 0; i < 3; i++ {
+			attempted, err := dispatcher.DispatchDue(ctx)
+			require.NoError(t, err)
+			assert.Equal(t, 1, attempted)
+			now = now.Add(time.Hour)
+		}
+
+		attempted, err := dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, attempted)
+
+		mu.Lock()
+		assert.Equal(t, 7, failures)
+		mu.Unlock()
+	})
+
+	t.Run("DeletedSubscription", func(t *testing.T) {
+		require.NoError(t, webhook.Publish(ctx, store, webhook.Event{ID: "ev-4", Project: "foo", Kind: "firehose", Timestamp: now}))
+		require.NoError(t, store.DeleteWebhook(ctx, sub.ID))
+
+		attempted, err := dispatcher.DispatchDue(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, 0, attempted)
+	})
+}
+
+func TestDispatcher_DispatchDue_ClaimsOneAtATime(t *testing.T) {
+	t.Parallel()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	now := time.Unix(1700000000, 0)
+	clock := func() time.Time { return now }
+
+	// a claim with no lease leaves the deliveries due, which shows what
+	// another dispatcher could claim while a delivery is being sent.
+	var mu sync.Mutex
+	var claimable []int
+	receiver := httptest.NewServer(http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
+		mu.Lock()
+		defer mu.Unlock()
+
+		due, err := store.ClaimDeliveries(context.Background(), now, 0, 0)
+		require.NoError(t, err)
+		claimable = append(claimable, len(due))
+	}))
+	defer receiver.Close()
+
+	_, err = store.CreateWebhook(ctx, webhook.Subscription{Project: "foo", URL: receiver.URL})
+	require.NoError(t, err)
+	for _, id := range []string{"ev-1", "ev-2", "ev-3"} {
+		require.NoError(t, webhook.Publish(ctx, store, webhook.Event{ID: id, Project: "foo", Timestamp: now}))
+	}
+
+	dispatcher := webhook.NewDispatcher(store, receiver.Client(), clock, 3, time.Minute)
+	attempted, err := dispatcher.DispatchDue(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 3, attempted)
+
+	mu.Lock()
+	assert.Equal(t, []int{2, 1, 0}, claimable)
+	mu.Unlock()
+}
+
+func TestSubscription_Matches(t *testing.T) {
+	t.Parallel()
+
+	ev := webhook.Event{Project: "foo", Kind: "firehose", Status: resource.StatusError, Action: "stop"}
+
+	table := []struct {
+		title string
+		sub   webhook.Subscription
+		want  bool
+	}{
+		{title: "NoFilters", sub: webhook.Subscription{Project: "foo"}, want: true},
+		{title: "OtherProject", sub: webhook.Subscription{Project: "bar"}, want: false},
+		{title: "MatchingFilters", sub: webhook.Subscription{
+			Project:  "foo",
+			Kinds:    []string{"kafka", "firehose"},
+			Statuses: []string{resource.StatusError},
+			Actions:  []string{"stop"},
+		}, want: true},
+		{title: "ShortStatus", sub: webhook.Subscription{Project: "foo", Statuses: []string{"error"}}, want: true},
+		{title: "StatusMismatch", sub: webhook.Subscription{Project: "foo", Statuses: []string{resource.StatusCompleted}}, want: false},
+		{title: "ActionMismatch", sub: webhook.Subscription{Project: "foo", Actions: []string{"start"}}, want: false},
+	}
+
+	for _, tt := range table {
+		tt := tt
+		t.Run(tt.title, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, tt.want, tt.sub.Matches(ev))
+		})
+	}
+
+	t.Run("SyncOfAction", func(t *testing.T) {
+		t.Parallel()
+
+		sync := webhook.Event{Type: webhook.EventSyncCompleted, Project: "foo", Status: resource.StatusCompleted, Action: "reset"}
+		assert.True(t, webhook.Subscription{Project: "foo", Actions: []string{"reset"}}.Matches(sync))
+		assert.False(t, webhook.Subscription{Project: "foo", Actions: []string{"stop"}}.Matches(sync))
+	})
+}
@@ -0,0 +1,171 @@
+package webhook
+
+import (
+	"context"
+	"net/url"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	EventResourceCreated = "resource.created"
+	EventResourceUpdated = "resource.updated"
+	EventResourceAction  = "resource.action"
+	EventSyncCompleted   = "sync.completed"
+	EventSyncFailed      = "sync.failed"
+)
+
+const (
+	DeliveryPending   = "pending"
+	DeliveryDelivered = "delivered"
+	DeliveryFailed    = "failed"
+)
+
+// Store is implemented by storage backends that keep webhook subscriptions
+// and a durable outbox of their deliveries. Notifications are sent only if
+// the store implements it.
+type Store interface {
+	// CreateWebhook stores the subscription and returns it with the ID and
+	// creation time assigned.
+	CreateWebhook(ctx context.Context, sub Subscription) (*Subscription, error)
+	GetWebhook(ctx context.Context, id int64) (*Subscription, error)
+	ListWebhooks(ctx context.Context, project string) ([]Subscription, error)
+
+	// DeleteWebhook deletes the subscription along with its pending
+	// deliveries.
+	DeleteWebhook(ctx context.Context, id int64) error
+
+	// EnqueueDeliveries queues the deliveries. Called from the mutation
+	// hooks of a resource write, it queues them within the write so that
+	// they are committed along with the change, or not at all.
+	EnqueueDeliveries(ctx context.Context, deliveries []Delivery) error
+
+	// ClaimDeliveries returns up to limit pending deliveries that are due
+	// at now, oldest first, with the subscription filled in. Claimed
+	// deliveries are not returned again before now+lease so that multiple
+	// dispatchers do not send the same delivery concurrently.
+	ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]Delivery, error)
+
+	// UpdateDelivery records the outcome of a delivery attempt.
+	UpdateDelivery(ctx context.Context, d Delivery) error
+}
+
+// Subscription registers a URL to receive the events of a project. Events
+// are signed with the secret. Empty filters match every event.
+type Subscription struct {
+	ID        int64     `json:"id"`
+	Project   string    `json:"project"`
+	URL       string    `json:"url"`
+	Secret    string    `json:"-"`
+	Kinds     []string  `json:"kinds,omitempty"`
+	Statuses  []string  `json:"statuses,omitempty"`
+	Actions   []string  `json:"actions,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	CreatedBy string    `json:"created_by"`
+}
+
+// Normalize returns the subscription with the status filters in the form
+// events carry them. Filters may omit the prefix, e.g. ERROR for
+// STATUS_ERROR.
+func (sub Subscription) Normalize() Subscription {
+	statuses := make([]string, 0, len(sub.Statuses))
+	for _, status := range sub.Statuses {
+		status = strings.ToUpper(strings.TrimSpace(status))
+		if !strings.HasPrefix(status, "STATUS_") {
+			status = "STATUS_" + status
+		}
+		statuses = append(statuses, status)
+	}
+	if len(statuses) > 0 {
+		sub.Statuses = statuses
+	}
+	return sub
+}
+
+func (sub Subscription) Validate() error {
+	if sub.Project == "" {
+		return errors.ErrInvalid.WithMsgf("project must be set")
+	}
+
+	u, err := url.Parse(sub.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.ErrInvalid.WithMsgf("url must be an absolute http(s) url")
+	}
+
+	for _, status := range sub.Statuses {
+		switch status {
+		case resource.StatusPending, resource.StatusError, resource.StatusDeleted, resource.StatusCompleted:
+		default:
+			return errors.ErrInvalid.WithMsgf("unknown status filter '%s'", status)
+		}
+	}
+	return nil
+}
+
+// Matches returns true if the event is selected by the filters of the
+// subscription.
+func (sub Subscription) Matches(ev Event) bool {
+	sub = sub.Normalize()
+	return sub.Project == ev.Project &&
+		(len(sub.Kinds) == 0 || slices.Contains(sub.Kinds, ev.Kind)) &&
+		(len(sub.Statuses) == 0 || slices.Contains(sub.Statuses, ev.Status)) &&
+		(len(sub.Actions) == 0 || slices.Contains(sub.Actions, ev.Action))
+}
+
+// Event is the payload sent to the subscribers. ID is unique per event so
+// that receivers can drop duplicates caused by retries.
+type Event struct {
+	ID        string    `json:"id"`
+	Type      string    `json:"type"`
+	Timestamp time.Time `json:"timestamp"`
+	Project   string    `json:"project"`
+	URN       string    `json:"urn"`
+	Kind      string    `json:"kind"`
+	Action    string    `json:"action,omitempty"`
+	Status    string    `json:"status"`
+	UserID    string    `json:"user_id,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// Delivery is an event queued for sending to a subscription.
+type Delivery struct {
+	ID            int64        `json:"id"`
+	Subscription  Subscription `json:"subscription"`
+	Event         Event        `json:"event"`
+	Status        string       `json:"status"`
+	Attempts      int          `json:"attempts"`
+	NextAttemptAt time.Time    `json:"next_attempt_at"`
+	LastError     string       `json:"last_error,omitempty"`
+}
+
+// Publish queues a delivery of the event for every matching subscription
+// of the project.
+func Publish(ctx context.Context, store Store, ev Event) error {
+	subs, err := store.ListWebhooks(ctx, ev.Project)
+	if err != nil {
+		return err
+	}
+
+	var deliveries []Delivery
+	for _, sub := range subs {
+		if !sub.Matches(ev) {
+			continue
+		}
+
+		deliveries = append(deliveries, Delivery{
+			Subscription:  sub,
+			Event:         ev,
+			Status:        DeliveryPending,
+			NextAttemptAt: ev.Timestamp,
+		})
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return store.EnqueueDeliveries(ctx, deliveries)
+}
@@ -0,0 +1,135 @@
+package core_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/mocks"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestService_Webhooks(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+	ctx := context.Background()
+
+	all, err := svc.CreateWebhook(ctx, webhook.Subscription{Project: "project", URL: "http://localhost/all"})
+	require.NoError(t, err)
+	assert.Len(t, all.Secret, 64)
+	assert.Equal(t, frozenTime, all.CreatedAt)
+
+	_, err = svc.CreateWebhook(ctx, webhook.Subscription{
+		Project: "project",
+		URL:     "http://localhost/updates",
+		Actions: []string{module.UpdateAction},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.CreateResource(ctx, resource.Resource{
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":1}`)},
+	})
+	require.NoError(t, err)
+
+	// dry-runs are not notified.
+	_, err = svc.UpdateResource(ctx, urn, resource.UpdateRequest{
+		Spec: resource.Spec{Configs: []byte(`{"replicas":2}`)},
+	}, core.WithDryRun(true))
+	require.NoError(t, err)
+
+	_, err = svc.ApplyAction(ctx, urn, module.ActionRequest{
+		Name:   module.UpdateAction,
+		Params: json.RawMessage(`{"replicas":3}`),
+		UserID: "john",
+	})
+	require.NoError(t, err)
+
+	deliveries, err := store.ClaimDeliveries(ctx, frozenTime, time.Minute, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 3)
+
+	assert.Equal(t, "http://localhost/all", deliveries[0].Subscription.URL)
+	assert.Equal(t, webhook.EventResourceCreated, deliveries[0].Event.Type)
+	assert.Equal(t, urn, deliveries[0].Event.URN)
+	assert.Equal(t, resource.StatusCompleted, deliveries[0].Event.Status)
+
+	for _, d := range deliveries[1:] {
+		assert.Equal(t, webhook.EventResourceUpdated, d.Event.Type)
+		assert.Equal(t, "john", d.Event.UserID)
+	}
+	assert.Equal(t, deliveries[1].Event.ID, deliveries[2].Event.ID)
+
+	t.Run("ListHidesSecrets", func(t *testing.T) {
+		subs, err := svc.ListWebhooks(ctx, "project")
+		require.NoError(t, err)
+		require.Len(t, subs, 2)
+		for _, sub := range subs {
+			assert.Empty(t, sub.Secret)
+		}
+	})
+
+	t.Run("InvalidURL", func(t *testing.T) {
+		_, err := svc.CreateWebhook(ctx, webhook.Subscription{Project: "project", URL: "localhost/hook"})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("StatusFilters", func(t *testing.T) {
+		sub, err := svc.CreateWebhook(ctx, webhook.Subscription{
+			Project:  "project",
+			URL:      "http://localhost/errors",
+			Statuses: []string{"ERROR", resource.StatusCompleted},
+		})
+		require.NoError(t, err)
+		assert.Equal(t, []string{resource.StatusError, resource.StatusCompleted}, sub.Statuses)
+
+		_, err = svc.CreateWebhook(ctx, webhook.Subscription{
+			Project:  "project",
+			URL:      "http://localhost/errors",
+			Statuses: []string{"BROKEN"},
+		})
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("Delete", func(t *testing.T) {
+		require.NoError(t, svc.DeleteWebhook(ctx, all.ID))
+		assert.ErrorIs(t, svc.DeleteWebhook(ctx, all.ID), errors.ErrNotFound)
+	})
+
+	t.Run("Unsupported", func(t *testing.T) {
+		unsupported := core.New(&mocks.ResourceStore{}, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+		_, err := unsupported.ListWebhooks(ctx, "project")
+		assert.ErrorIs(t, err, errors.ErrUnsupported)
+	})
+}
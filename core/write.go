@@ -3,8 +3,12 @@ package core
 import (
 	"context"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/policy"
 	"github.com/goto/entropy/core/resource"
 	"github.com/goto/entropy/pkg/errors"
 	"github.com/goto/entropy/pkg/telemetry"
@@ -14,13 +18,76 @@ import (
 )
 
 type Options struct {
-	DryRun bool
+	DryRun  bool
+	Cascade bool
+
+	// Plan if set, receives the plan of a dry-run.
+	Plan *Plan
+
+	// Operation if set, receives the operation tracking the mutation.
+	Operation *operation.Operation
+
+	// ExpectedVersion if set, makes the mutation fail with ErrConflict
+	// unless the resource is currently at this version.
+	ExpectedVersion int64
+
+	// BreakGlass lets the mutation through locks covering the resource.
+	// Such mutations are recorded in the audit log.
+	BreakGlass bool
+
+	// IdempotencyKey if set, makes retries of the mutation with the same
+	// key return the result of the first one.
+	IdempotencyKey string
+
+	// deferDelete makes the syncer hold the deletion back until the
+	// dependents of the resource are gone.
+	deferDelete bool
 }
 
 func WithDryRun(dryRun bool) Options {
 	return Options{DryRun: dryRun}
 }
 
+// WithCascade makes DeleteResource delete the dependents of the resource
+// first instead of refusing the deletion.
+func WithCascade(cascade bool) Options {
+	return Options{Cascade: cascade}
+}
+
+// WithExpectedVersion makes the mutation conditional on the current version
+// of the resource.
+func WithExpectedVersion(version int64) Options {
+	return Options{ExpectedVersion: version}
+}
+
+// WithBreakGlass lets the mutation go through locks on the resource.
+func WithBreakGlass(breakGlass bool) Options {
+	return Options{BreakGlass: breakGlass}
+}
+
+// collectOptions combines the given options into one.
+func collectOptions(resourceOpts []Options) Options {
+	var opts Options
+	for _, opt := range resourceOpts {
+		opts.DryRun = opts.DryRun || opt.DryRun
+		opts.Cascade = opts.Cascade || opt.Cascade
+		opts.BreakGlass = opts.BreakGlass || opt.BreakGlass
+		if opt.Plan != nil {
+			opts.Plan = opt.Plan
+		}
+		if opt.Operation != nil {
+			opts.Operation = opt.Operation
+		}
+		if opt.ExpectedVersion != 0 {
+			opts.ExpectedVersion = opt.ExpectedVersion
+		}
+		if opt.IdempotencyKey != "" {
+			opts.IdempotencyKey = opt.IdempotencyKey
+		}
+	}
+	return opts
+}
+
 func (svc *Service) CreateResource(ctx context.Context, res resource.Resource, resourceOpts ...Options) (*resource.Resource, error) {
 	if err := res.Validate(true); err != nil {
 		return nil, err
@@ -34,54 +101,321 @@ func (svc *Service) CreateResource(ctx context.Context, res resource.Resource, r
 	}
 	res.Spec.Configs = nil
 
-	dryRun := false
-	for _, opt := range resourceOpts {
-		dryRun = opt.DryRun
-	}
+	opts := collectOptions(resourceOpts)
 
-	return svc.execAction(ctx, res, act, dryRun)
+	return svc.idempotent(ctx, opts, act.Name, []any{res, act}, func(opts Options) (*resource.Resource, error) {
+		return svc.execAction(ctx, res, act, opts)
+	})
 }
 
 func (svc *Service) UpdateResource(ctx context.Context, urn string, req resource.UpdateRequest, resourceOpts ...Options) (*resource.Resource, error) {
-	if len(req.Spec.Dependencies) != 0 {
-		return nil, errors.ErrUnsupported.WithMsgf("updating dependencies is not supported")
-	} else if len(req.Spec.Configs) == 0 {
-		return nil, errors.ErrInvalid.WithMsgf("no config is being updated, nothing to do")
+	if len(req.Spec.Configs) == 0 && req.Spec.Dependencies == nil {
+		return nil, errors.ErrInvalid.WithMsgf("no config or dependency is being updated, nothing to do")
 	}
 
-	return svc.ApplyAction(ctx, urn, module.ActionRequest{
+	opts := collectOptions(resourceOpts)
+
+	return svc.idempotent(ctx, opts, module.UpdateAction, []any{urn, req}, func(opts Options) (*resource.Resource, error) {
+		return svc.updateResource(ctx, urn, req, opts)
+	})
+}
+
+func (svc *Service) updateResource(ctx context.Context, urn string, req resource.UpdateRequest, opts Options) (*resource.Resource, error) {
+	act := module.ActionRequest{
 		Name:   module.UpdateAction,
 		Params: req.Spec.Configs,
 		Labels: req.Labels,
 		UserID: req.UserID,
-	}, resourceOpts...)
+	}
+
+	res, err := svc.getForAction(ctx, urn, act.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Spec.Dependencies != nil && !maps.Equal(req.Spec.Dependencies, res.Spec.Dependencies) {
+		if err := svc.checkDependencyCycle(ctx, urn, req.Spec.Dependencies); err != nil {
+			return nil, err
+		}
+
+		act.PreviousDependencies = res.Spec.Dependencies
+		res.Spec.Dependencies = req.Spec.Dependencies
+	}
+
+	if len(act.Params) == 0 {
+		// only the dependencies are being updated.
+		act.Params = res.Spec.Configs
+	}
+
+	return svc.execAction(ctx, *res, act, opts)
+}
+
+func (svc *Service) DeleteResource(ctx context.Context, urn string, resourceOpts ...Options) error {
+	opts := collectOptions(resourceOpts)
+
+	_, err := svc.idempotent(ctx, opts, module.DeleteAction, []any{urn, opts.Cascade}, func(opts Options) (*resource.Resource, error) {
+		return nil, svc.deleteResource(ctx, urn, opts)
+	})
+	return err
+}
+
+func (svc *Service) deleteResource(ctx context.Context, urn string, opts Options) error {
+	cascade := opts.Cascade
+
+	dependents, err := svc.GetDependents(ctx, urn, cascade)
+	if err != nil {
+		return err
+	}
+
+	act := module.ActionRequest{Name: module.DeleteAction}
+	if len(dependents) == 0 {
+		_, actionErr := svc.ApplyAction(ctx, urn, act, WithBreakGlass(opts.BreakGlass), WithOperation(opts.Operation))
+		return actionErr
+	} else if !cascade {
+		return errors.ErrInvalid.
+			WithMsgf("cannot delete resource '%s': %d resource(s) depend on it, including '%s'", urn, len(dependents), dependents[0].URN)
+	}
+
+	for _, dep := range dependents {
+		if !dep.State.IsTerminal() {
+			return errors.ErrInvalid.
+				WithMsgf("cannot delete dependent resource '%s' in '%s'", dep.URN, dep.State.Status)
+		}
+	}
+
+	res, err := svc.getForAction(ctx, urn, act.Name)
+	if err != nil {
+		return err
+	}
+
+	// dependents are ordered such that every resource comes before the
+	// resources it depends on.
+	targets := append(dependents, *res)
+
+	// make sure the whole graph can be deleted before deleting anything.
+	for _, target := range targets {
+		if _, err := svc.checkLocks(ctx, target, act.Name, opts.BreakGlass); err != nil {
+			return err
+		} else if _, err := svc.execAction(ctx, target, act, Options{DryRun: true}); err != nil {
+			return err
+		}
+	}
+
+	// the resources depended upon are deleted by the syncer only once their
+	// dependents are gone, so that the dependents can be deleted meanwhile.
+	for _, target := range targets {
+		targetOpts := Options{
+			BreakGlass:  opts.BreakGlass,
+			deferDelete: isDependedOn(target.URN, targets),
+		}
+		if target.URN == urn {
+			targetOpts.Operation = opts.Operation
+		}
+
+		if _, err := svc.execAction(ctx, target, act, targetOpts); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
-func (svc *Service) DeleteResource(ctx context.Context, urn string) error {
-	_, actionErr := svc.ApplyAction(ctx, urn, module.ActionRequest{
-		Name: module.DeleteAction,
-	}, WithDryRun(false))
-	return actionErr
+// isDependedOn returns true if any of the resources depends on the one with
+// the given URN.
+func isDependedOn(urn string, resources []resource.Resource) bool {
+	for _, res := range resources {
+		for _, dependsOn := range res.Spec.Dependencies {
+			if dependsOn == urn {
+				return true
+			}
+		}
+	}
+	return false
 }
 
 func (svc *Service) ApplyAction(ctx context.Context, urn string, act module.ActionRequest, resourceOpts ...Options) (*resource.Resource, error) {
+	opts := collectOptions(resourceOpts)
+
+	return svc.idempotent(ctx, opts, act.Name, []any{urn, act}, func(opts Options) (*resource.Resource, error) {
+		res, err := svc.getForAction(ctx, urn, act.Name)
+		if err != nil {
+			return nil, err
+		}
+		return svc.execAction(ctx, *res, act, opts)
+	})
+}
+
+// RollbackResource applies the spec configs recorded in the given revision
+// of the resource again through the update action.
+func (svc *Service) RollbackResource(ctx context.Context, urn string, revisionID int64, userID string, resourceOpts ...Options) (*resource.Resource, error) {
+	revisions, err := svc.GetRevisions(ctx, resource.RevisionsSelector{URN: urn})
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := findRevision(revisions, urn, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := svc.getForAction(ctx, urn, module.UpdateAction)
+	if err != nil {
+		return nil, err
+	}
+
+	opts := collectOptions(resourceOpts)
+
+	act := module.ActionRequest{
+		Name:   module.UpdateAction,
+		Params: target.Spec.Configs,
+		UserID: userID,
+	}
+	reason := fmt.Sprintf("rollback:%d", revisionID)
+	return svc.execActionWithReason(ctx, *res, act, opts, reason)
+}
+
+// RetrySync re-queues a resource that ended up in error state for sync with
+// a fresh retry budget. The pending steps left by the failed sync are kept,
+// if fromStep is set, the steps before it are skipped. Like actions, retries
+// are subject to the locks on the resource and tracked as operations.
+func (svc *Service) RetrySync(ctx context.Context, urn, fromStep, userID string, resourceOpts ...Options) (*resource.Resource, error) {
+	res, err := svc.store.GetByURN(ctx, urn)
+	if err != nil {
+		if errors.Is(err, errors.ErrNotFound) {
+			return nil, errors.ErrNotFound.WithMsgf("resource with urn '%s' not found", urn)
+		}
+		return nil, errors.ErrInternal.WithCausef("%s", err.Error())
+	} else if err := svc.authorize(ctx, res.Project, auditActionRetrySync); err != nil {
+		return nil, err
+	} else if res.State.Status != resource.StatusError {
+		return nil, errors.ErrInvalid.
+			WithMsgf("cannot retry sync of resource in '%s'", res.State.Status)
+	}
+
+	opts := collectOptions(resourceOpts)
+	act := module.ActionRequest{
+		Name:   auditActionRetrySync,
+		Params: retryParams(fromStep),
+		UserID: userID,
+	}
+
+	heldLocks, err := svc.checkLocks(ctx, *res, act.Name, opts.BreakGlass)
+	if err != nil {
+		svc.recordAudit(ctx, *res, userID, act.Name, act.Params, err)
+		return nil, err
+	}
+
+	if fromStep != "" {
+		steps := pendingSteps(res.State.ModuleData)
+		idx := slices.Index(steps, fromStep)
+		if idx < 0 {
+			return nil, errors.ErrInvalid.
+				WithMsgf("step '%s' is not pending, pending steps are %v", fromStep, steps)
+		}
+
+		res.State.ModuleData, err = setPendingSteps(res.State.ModuleData, steps[idx:])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := svc.clock()
+	res.UpdatedAt = now
+	res.UpdatedBy = userID
+	res.State.Status = resource.StatusPending
+	res.State.NextSyncAt = &now
+	res.State.SyncResult = resource.SyncResult{}
+
+	if len(heldLocks) > 0 {
+		svc.recordBreakGlass(ctx, *res, act.Name, userID, heldLocks)
+	}
+
+	var op operation.Operation
+	hooks := append(svc.notifyAction(*res, act.Name, userID), svc.startOperation(*res, act, &op)...)
+	err = svc.upsert(ctx, *res, false, false, "", hooks...)
+	svc.recordAudit(ctx, *res, userID, act.Name, act.Params, err)
+	if err != nil {
+		return nil, err
+	}
+	res.Version++
+
+	if op.ID != 0 && opts.Operation != nil {
+		*opts.Operation = op
+	}
+	return res, nil
+}
+
+// getForAction returns the resource if it is in a state that allows actions.
+func (svc *Service) getForAction(ctx context.Context, urn, actionName string) (*resource.Resource, error) {
 	res, err := svc.GetResource(ctx, urn)
 	if err != nil {
 		return nil, err
 	} else if !res.State.IsTerminal() {
 		return nil, errors.ErrInvalid.
-			WithMsgf("cannot perform '%s' on resource in '%s'", act.Name, res.State.Status)
+			WithMsgf("cannot perform '%s' on resource in '%s'", actionName, res.State.Status)
 	}
+	return res, nil
+}
 
-	dryRun := false
-	for _, opt := range resourceOpts {
-		dryRun = opt.DryRun
+// checkDependencyCycle makes sure the resource does not end up depending on
+// itself through the given dependencies.
+func (svc *Service) checkDependencyCycle(ctx context.Context, urn string, deps map[string]string) error {
+	dependents, err := svc.GetDependents(ctx, urn, true)
+	if err != nil {
+		return err
+	}
+
+	for key, dependsOn := range deps {
+		if dependsOn == urn {
+			return errors.ErrInvalid.WithMsgf("resource cannot depend on itself (dependency '%s')", key)
+		}
+		for _, dep := range dependents {
+			if dep.URN == dependsOn {
+				return errors.ErrInvalid.
+					WithMsgf("dependency '%s' on '%s' would create a cycle", key, dependsOn)
+			}
+		}
+	}
+	return nil
+}
+
+// previousDependencies returns the dependencies replaced by the action, and by
+// earlier changes not completed yet, that the planned resource no longer
+// refers to. The earliest replaced value of a dependency is kept, unless the
+// action reverts the dependency to it.
+func previousDependencies(res resource.Resource, act module.ActionRequest, planned resource.Resource) map[string]string {
+	if planned.State.Status == resource.StatusCompleted {
+		return nil
+	}
+
+	prevDeps := map[string]string{}
+	for key, urn := range act.PreviousDependencies {
+		prevDeps[key] = urn
+	}
+	for key, urn := range res.State.PreviousDependencies {
+		if planned.Spec.Dependencies[key] != urn {
+			prevDeps[key] = urn
+		}
+	}
+
+	for key, urn := range prevDeps {
+		if planned.Spec.Dependencies[key] == urn {
+			delete(prevDeps, key)
+		}
+	}
+
+	if len(prevDeps) == 0 {
+		return nil
 	}
+	return prevDeps
+}
 
-	return svc.execAction(ctx, *res, act, dryRun)
+func (svc *Service) execAction(ctx context.Context, res resource.Resource, act module.ActionRequest, opts Options) (*resource.Resource, error) {
+	return svc.execActionWithReason(ctx, res, act, opts, fmt.Sprintf("action:%s", act.Name))
 }
 
-func (svc *Service) execAction(ctx context.Context, res resource.Resource, act module.ActionRequest, dryRun bool) (*resource.Resource, error) {
+// execActionWithReason is execAction with the given reason recorded on the
+// resulting revision.
+func (svc *Service) execActionWithReason(ctx context.Context, res resource.Resource, act module.ActionRequest, opts Options, reason string) (*resource.Resource, error) {
 	logEntry := zap.L().With(
 		zap.String("resource_urn", res.URN),
 		zap.String("resource_status", res.State.Status),
@@ -89,8 +423,26 @@ func (svc *Service) execAction(ctx context.Context, res resource.Resource, act m
 		zap.String("last_err", res.State.SyncResult.LastError),
 	)
 
-	planned, err := svc.planChange(ctx, res, act)
+	if err := svc.authorize(ctx, res.Project, act.Name); err != nil {
+		return nil, err
+	}
+
+	if opts.ExpectedVersion != 0 && !isCreate(act.Name) && opts.ExpectedVersion != res.Version {
+		return nil, errors.ErrConflict.
+			WithMsgf("resource version mismatch: expected %d, current %d", opts.ExpectedVersion, res.Version)
+	}
+
+	heldLocks, err := svc.checkLocks(ctx, res, act.Name, opts.BreakGlass)
+	if err != nil && !opts.DryRun {
+		svc.recordAudit(ctx, res, act.UserID, act.Name, act.Params, err)
+		return nil, err
+	}
+
+	planned, warnings, err := svc.planChange(ctx, res, act)
 	if err != nil {
+		if !opts.DryRun {
+			svc.recordAudit(ctx, res, act.UserID, act.Name, act.Params, err)
+		}
 		return nil, err
 	}
 
@@ -104,13 +456,52 @@ func (svc *Service) execAction(ctx context.Context, res resource.Resource, act m
 		planned.UpdatedAt = svc.clock()
 		planned.UpdatedBy = act.UserID
 	}
+	planned.Version = res.Version
+	planned.State.DeleteDeferred = opts.deferDelete
+	planned.State.PreviousDependencies = previousDependencies(res, act, *planned)
+
+	// dependents are notified of an output change once the resource is
+	// completed, by the syncer unless the action completes it right away.
+	planned.State.OutputChanged = res.State.OutputChanged || outputChanged(res.State.Output, planned.State.Output)
+	propagate := planned.State.OutputChanged && planned.State.Status == resource.StatusCompleted
+	if propagate {
+		planned.State.OutputChanged = false
+	}
 
-	reason := fmt.Sprintf("action:%s", act.Name)
+	if !opts.DryRun {
+		if len(heldLocks) > 0 {
+			svc.recordBreakGlass(ctx, res, act.Name, act.UserID, heldLocks)
+		}
 
-	if !dryRun {
-		if err := svc.upsert(ctx, *planned, isCreate(act.Name), true, reason); err != nil {
+		var op operation.Operation
+		hooks := svc.enforceQuota(res, *planned, act.Name)
+		hooks = append(hooks, svc.notifyAction(*planned, act.Name, act.UserID)...)
+		hooks = append(hooks, svc.startOperation(*planned, act, &op)...)
+		err := svc.upsert(ctx, *planned, isCreate(act.Name), true, reason, hooks...)
+		svc.recordAudit(ctx, *planned, act.UserID, act.Name, act.Params, err)
+		if err != nil {
 			return nil, err
 		}
+		planned.Version++
+
+		if op.ID != 0 && opts.Operation != nil {
+			*opts.Operation = op
+		}
+
+		if len(warnings) > 0 {
+			svc.recordPolicyWarnings(ctx, *planned, act.UserID, warnings)
+		}
+
+		if propagate {
+			svc.propagateOutputChange(ctx, *planned)
+		}
+	} else if opts.Plan != nil {
+		plan, err := svc.describePlan(ctx, res, *planned, isCreate(act.Name))
+		if err != nil {
+			return nil, err
+		}
+		plan.Warnings = warnings
+		*opts.Plan = *plan
 	}
 
 	meter := telemetry.GetMeter(svc.serviceName)
@@ -126,33 +517,45 @@ func (svc *Service) execAction(ctx context.Context, res resource.Resource, act m
 	return planned, nil
 }
 
-func (svc *Service) planChange(ctx context.Context, res resource.Resource, act module.ActionRequest) (*resource.Resource, error) {
+// planChange plans the action on the resource and checks the planned
+// resource against the admission policies and the quotas. The violations
+// of warn policies are returned along with the planned resource.
+func (svc *Service) planChange(ctx context.Context, res resource.Resource, act module.ActionRequest) (*resource.Resource, []policy.Violation, error) {
 	modSpec, err := svc.generateModuleSpec(ctx, res)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	planned, err := svc.moduleSvc.PlanAction(ctx, *modSpec, act)
 	if err != nil {
 		if errors.Is(err, errors.ErrInvalid) {
-			return nil, err
+			return nil, nil, err
 		}
-		return nil, errors.ErrInternal.WithMsgf("plan() failed").WithCausef("%s", err.Error())
+		return nil, nil, errors.ErrInternal.WithMsgf("plan() failed").WithCausef("%s", err.Error())
 	}
 
 	planned.Labels = mergeLabels(res.Labels, act.Labels)
 	if err := planned.Validate(isCreate(act.Name)); err != nil {
-		return nil, err
+		return nil, nil, err
 	}
-	return planned, nil
+
+	warnings, err := svc.admit(ctx, res, *planned, act)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := svc.checkQuota(ctx, res, *planned, act.Name); err != nil {
+		return nil, nil, err
+	}
+	return planned, warnings, nil
 }
 
-func (svc *Service) upsert(ctx context.Context, res resource.Resource, isCreate bool, saveRevision bool, reason string) error {
+func (svc *Service) upsert(ctx context.Context, res resource.Resource, isCreate bool, saveRevision bool, reason string, hooks ...resource.MutationHook) error {
 	var err error
 	if isCreate {
-		err = svc.store.Create(ctx, res)
+		err = svc.store.Create(ctx, res, hooks...)
 	} else {
-		err = svc.store.Update(ctx, res, saveRevision, reason)
+		err = svc.store.Update(ctx, res, saveRevision, reason, hooks...)
 	}
 
 	if err != nil {
@@ -160,6 +563,11 @@ func (svc *Service) upsert(ctx context.Context, res resource.Resource, isCreate
 			return errors.ErrConflict.WithMsgf("resource with urn '%s' already exists", res.URN)
 		} else if !isCreate && errors.Is(err, errors.ErrNotFound) {
 			return errors.ErrNotFound.WithMsgf("resource with urn '%s' does not exist", res.URN)
+		} else if !isCreate && errors.Is(err, errors.ErrConflict) {
+			return errors.ErrConflict.WithMsgf("resource '%s' was modified concurrently, retry with the latest version", res.URN)
+		} else if errors.Is(err, errors.ErrQuotaExceeded) {
+			// rejected by the check of the quotas within the write.
+			return err
 		}
 		return errors.ErrInternal.WithCausef("%s", err.Error())
 	}
@@ -2,16 +2,22 @@ package core_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/lock"
 	"github.com/goto/entropy/core/mocks"
 	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
 	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/store/inmemory"
 	"github.com/goto/entropy/pkg/errors"
 	"github.com/goto/entropy/pkg/worker"
 )
@@ -276,6 +282,7 @@ func TestService_CreateResource(t *testing.T) {
 				State:     resource.State{Status: resource.StatusCompleted},
 				CreatedAt: frozenTime,
 				UpdatedAt: frozenTime,
+				Version:   1,
 			},
 			wantErr: nil,
 		},
@@ -387,6 +394,67 @@ func TestService_UpdateResource(t *testing.T) {
 			want:    nil,
 			wantErr: errors.ErrNotFound,
 		},
+		{
+			name: "VersionMismatch",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+
+				current := testResource
+				current.Version = 4
+
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:child").
+					Return(&current, nil).
+					Once()
+
+				return core.New(resourceRepo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn: "orn:entropy:mock:project:child",
+			update: resource.UpdateRequest{
+				Spec: resource.Spec{Configs: []byte(`{"foo": "bar"}`)},
+			},
+			options: []core.Options{core.WithExpectedVersion(3)},
+			want:    nil,
+			wantErr: errors.ErrConflict,
+		},
+		{
+			name: "ConcurrentUpdate",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					PlanAction(mock.Anything, mock.Anything, mock.Anything).
+					Return(&testResource, nil).Once()
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:child").
+					Return(&testResource, nil).
+					Once()
+				resourceRepo.EXPECT().
+					Update(mock.Anything, mock.Anything, true, "action:update").
+					Return(errors.ErrConflict).
+					Once()
+
+				return core.New(resourceRepo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn: "orn:entropy:mock:project:child",
+			update: resource.UpdateRequest{
+				Spec: resource.Spec{Configs: []byte(`{"foo": "bar"}`)},
+			},
+			want:    nil,
+			wantErr: errors.ErrConflict,
+		},
 		{
 			name: "ModuleValidationError",
 			setup: func(t *testing.T) *core.Service {
@@ -513,6 +581,7 @@ func TestService_UpdateResource(t *testing.T) {
 				Project:   "project",
 				CreatedAt: frozenTime,
 				UpdatedAt: frozenTime,
+				Version:   1,
 				State:     resource.State{Status: resource.StatusPending},
 				Labels:    map[string]string{"created_by": "test_user", "group": "test_group"},
 				Spec: resource.Spec{
@@ -521,6 +590,278 @@ func TestService_UpdateResource(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "DependencyCycle",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return(nil, nil).
+					Once()
+
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:child").
+					Return(&testResource, nil).Once()
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:project:child").
+					Return([]string{"orn:entropy:mock:project:grandchild"}, nil).Once()
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:grandchild").
+					Return(&resource.Resource{
+						URN:   "orn:entropy:mock:project:grandchild",
+						State: resource.State{Status: resource.StatusCompleted},
+					}, nil).Once()
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:project:grandchild").
+					Return(nil, nil).Once()
+
+				return core.New(resourceRepo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn: "orn:entropy:mock:project:child",
+			update: resource.UpdateRequest{
+				Spec: resource.Spec{
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:grandchild"},
+				},
+			},
+			want:    nil,
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			name: "DependencyUpdate",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				// a copy, testResource is changed by the cases running in parallel.
+				current := testResource
+				current.Labels = nil
+				current.Spec = resource.Spec{
+					Configs:      []byte(`{"foo": "bar"}`),
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-a"},
+				}
+
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					PlanAction(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+						assert.Equal(t, map[string]string{"parent": "orn:entropy:mock:project:parent-a"}, act.PreviousDependencies)
+						assert.Equal(t, map[string]string{"parent": "orn:entropy:mock:project:parent-b"}, res.Spec.Dependencies)
+						assert.JSONEq(t, `{"foo": "bar"}`, string(act.Params))
+
+						planned := res.Resource
+						planned.State = resource.State{Status: resource.StatusPending}
+						return &planned, nil
+					}).Once()
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return(nil, nil)
+
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:child").
+					Return(&current, nil).Once()
+				for _, parentURN := range []string{"orn:entropy:mock:project:parent-a", "orn:entropy:mock:project:parent-b"} {
+					resourceRepo.EXPECT().
+						GetByURN(mock.Anything, parentURN).
+						Return(&resource.Resource{
+							URN:     parentURN,
+							Kind:    "mock",
+							Project: "project",
+							State:   resource.State{Status: resource.StatusCompleted},
+						}, nil).Once()
+				}
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:project:child").
+					Return(nil, nil).Once()
+				resourceRepo.EXPECT().
+					Update(mock.Anything, mock.Anything, true, "action:update", mock.Anything).
+					Return(nil).
+					Once()
+
+				return core.New(resourceRepo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn: "orn:entropy:mock:project:child",
+			update: resource.UpdateRequest{
+				Spec: resource.Spec{
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-b"},
+				},
+			},
+			want: &resource.Resource{
+				URN:       "orn:entropy:mock:project:child",
+				Kind:      "mock",
+				Name:      "child",
+				Project:   "project",
+				CreatedAt: frozenTime,
+				UpdatedAt: frozenTime,
+				Version:   1,
+				State: resource.State{
+					Status:               resource.StatusPending,
+					PreviousDependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-a"},
+				},
+				Spec: resource.Spec{
+					Configs:      []byte(`{"foo": "bar"}`),
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-b"},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "DependencyRevert",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				// a copy, testResource is changed by the cases running in parallel.
+				current := testResource
+				current.Labels = nil
+				// the move from parent-a to parent-b failed.
+				current.Spec = resource.Spec{
+					Configs:      []byte(`{"foo": "bar"}`),
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-b"},
+				}
+				current.State = resource.State{
+					Status:               resource.StatusError,
+					PreviousDependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-a"},
+				}
+
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					PlanAction(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+						assert.Equal(t, map[string]string{"parent": "orn:entropy:mock:project:parent-b"}, act.PreviousDependencies)
+						assert.Equal(t, map[string]string{"parent": "orn:entropy:mock:project:parent-a"}, res.Spec.Dependencies)
+						assert.JSONEq(t, `{"foo": "bar"}`, string(act.Params))
+
+						planned := res.Resource
+						planned.State = resource.State{Status: resource.StatusPending}
+						return &planned, nil
+					}).Once()
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return(nil, nil)
+
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:child").
+					Return(&current, nil).Once()
+				for _, parentURN := range []string{"orn:entropy:mock:project:parent-a", "orn:entropy:mock:project:parent-b"} {
+					resourceRepo.EXPECT().
+						GetByURN(mock.Anything, parentURN).
+						Return(&resource.Resource{
+							URN:     parentURN,
+							Kind:    "mock",
+							Project: "project",
+							State:   resource.State{Status: resource.StatusCompleted},
+						}, nil)
+				}
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:project:child").
+					Return(nil, nil).Once()
+				resourceRepo.EXPECT().
+					Update(mock.Anything, mock.Anything, true, "action:update", mock.Anything).
+					Return(nil).
+					Once()
+
+				return core.New(resourceRepo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn: "orn:entropy:mock:project:child",
+			update: resource.UpdateRequest{
+				Spec: resource.Spec{
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-a"},
+				},
+			},
+			want: &resource.Resource{
+				URN:       "orn:entropy:mock:project:child",
+				Kind:      "mock",
+				Name:      "child",
+				Project:   "project",
+				CreatedAt: frozenTime,
+				UpdatedAt: frozenTime,
+				Version:   1,
+				// the dependency the failed move left behind is cleaned up.
+				State: resource.State{
+					Status:               resource.StatusPending,
+					PreviousDependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-b"},
+				},
+				Spec: resource.Spec{
+					Configs:      []byte(`{"foo": "bar"}`),
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-a"},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			name: "DependencyRemoval",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				// a copy, testResource is changed by the cases running in parallel.
+				current := testResource
+				current.Labels = nil
+				current.Spec = resource.Spec{
+					Configs:      []byte(`{"foo": "bar"}`),
+					Dependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-a"},
+				}
+
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					PlanAction(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+						assert.Equal(t, map[string]string{"parent": "orn:entropy:mock:project:parent-a"}, act.PreviousDependencies)
+						assert.Empty(t, res.Spec.Dependencies)
+
+						planned := res.Resource
+						planned.State = resource.State{Status: resource.StatusPending}
+						return &planned, nil
+					}).Once()
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return(nil, nil)
+
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:child").
+					Return(&current, nil).Once()
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:project:parent-a").
+					Return(&resource.Resource{
+						URN:     "orn:entropy:mock:project:parent-a",
+						Kind:    "mock",
+						Project: "project",
+						State:   resource.State{Status: resource.StatusCompleted},
+					}, nil)
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:project:child").
+					Return(nil, nil).Once()
+				resourceRepo.EXPECT().
+					Update(mock.Anything, mock.Anything, true, "action:update", mock.Anything).
+					Return(nil).
+					Once()
+
+				return core.New(resourceRepo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn: "orn:entropy:mock:project:child",
+			update: resource.UpdateRequest{
+				Spec: resource.Spec{
+					Dependencies: map[string]string{},
+				},
+			},
+			want: &resource.Resource{
+				URN:       "orn:entropy:mock:project:child",
+				Kind:      "mock",
+				Name:      "child",
+				Project:   "project",
+				CreatedAt: frozenTime,
+				UpdatedAt: frozenTime,
+				Version:   1,
+				State: resource.State{
+					Status:               resource.StatusPending,
+					PreviousDependencies: map[string]string{"parent": "orn:entropy:mock:project:parent-a"},
+				},
+				Spec: resource.Spec{
+					Configs:      []byte(`{"foo": "bar"}`),
+					Dependencies: map[string]string{},
+				},
+			},
+			wantErr: nil,
+		},
 		{
 			name: "SuccessWithDryRun",
 			setup: func(t *testing.T) *core.Service {
@@ -601,6 +942,7 @@ func TestService_DeleteResource(t *testing.T) {
 		name    string
 		setup   func(t *testing.T) *core.Service
 		urn     string
+		opts    []core.Options
 		wantErr error
 	}{
 		{
@@ -608,6 +950,10 @@ func TestService_DeleteResource(t *testing.T) {
 			setup: func(t *testing.T) *core.Service {
 				t.Helper()
 				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:foo:bar").
+					Return(nil, nil).
+					Once()
 				resourceRepo.EXPECT().
 					GetByURN(mock.Anything, "orn:entropy:mock:foo:bar").
 					Return(nil, testErr).
@@ -640,6 +986,10 @@ func TestService_DeleteResource(t *testing.T) {
 					Once()
 
 				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:foo:bar").
+					Return(nil, nil).
+					Once()
 				resourceRepo.EXPECT().
 					GetByURN(mock.Anything, "orn:entropy:mock:foo:bar").
 					Return(&resource.Resource{
@@ -685,6 +1035,10 @@ func TestService_DeleteResource(t *testing.T) {
 					Once()
 
 				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:foo:bar").
+					Return(nil, nil).
+					Once()
 				resourceRepo.EXPECT().
 					GetByURN(mock.Anything, "orn:entropy:mock:foo:bar").
 					Return(&resource.Resource{
@@ -708,6 +1062,123 @@ func TestService_DeleteResource(t *testing.T) {
 			urn:     "orn:entropy:mock:foo:bar",
 			wantErr: nil,
 		},
+		{
+			name: "HasDependents",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:foo:bar").
+					Return([]string{"orn:entropy:mock:foo:deleted", "orn:entropy:mock:foo:child"}, nil).
+					Once()
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:foo:deleted").
+					Return(&resource.Resource{
+						URN:   "orn:entropy:mock:foo:deleted",
+						State: resource.State{Status: resource.StatusDeleted},
+					}, nil).
+					Once()
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:foo:child").
+					Return(&resource.Resource{
+						URN:   "orn:entropy:mock:foo:child",
+						State: resource.State{Status: resource.StatusCompleted},
+					}, nil).
+					Once()
+
+				return core.New(resourceRepo, nil, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn:     "orn:entropy:mock:foo:bar",
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			name: "CascadeWithPendingDependent",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:foo:bar").
+					Return([]string{"orn:entropy:mock:foo:child"}, nil).
+					Once()
+				resourceRepo.EXPECT().
+					Dependents(mock.Anything, "orn:entropy:mock:foo:child").
+					Return(nil, nil).
+					Once()
+				resourceRepo.EXPECT().
+					GetByURN(mock.Anything, "orn:entropy:mock:foo:child").
+					Return(&resource.Resource{
+						URN:   "orn:entropy:mock:foo:child",
+						State: resource.State{Status: resource.StatusPending},
+					}, nil).
+					Once()
+
+				return core.New(resourceRepo, nil, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn:     "orn:entropy:mock:foo:bar",
+			opts:    []core.Options{core.WithCascade(true)},
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			name: "Cascade",
+			setup: func(t *testing.T) *core.Service {
+				t.Helper()
+				mod := &mocks.ModuleService{}
+				mod.EXPECT().
+					PlanAction(mock.Anything, mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, res module.ExpandedResource, _ module.ActionRequest) (*resource.Resource, error) {
+						planned := res.Resource
+						planned.State = resource.State{Status: resource.StatusPending}
+						return &planned, nil
+					}).
+					Times(6)
+				mod.EXPECT().
+					GetOutput(mock.Anything, mock.Anything).
+					Return(nil, nil)
+
+				newRes := func(name string, deps map[string]string) *resource.Resource {
+					return &resource.Resource{
+						URN:     "orn:entropy:mock:foo:" + name,
+						Kind:    "mock",
+						Name:    name,
+						Project: "foo",
+						Spec:    resource.Spec{Dependencies: deps},
+						State:   resource.State{Status: resource.StatusCompleted},
+					}
+				}
+				bar := newRes("bar", nil)
+				child := newRes("child", map[string]string{"parent": bar.URN})
+				grandchild := newRes("grandchild", map[string]string{"parent": child.URN})
+
+				resourceRepo := &mocks.ResourceStore{}
+				resourceRepo.EXPECT().Dependents(mock.Anything, bar.URN).Return([]string{child.URN}, nil).Once()
+				resourceRepo.EXPECT().Dependents(mock.Anything, child.URN).Return([]string{grandchild.URN}, nil).Once()
+				resourceRepo.EXPECT().Dependents(mock.Anything, grandchild.URN).Return(nil, nil).Once()
+				for _, r := range []*resource.Resource{bar, child, grandchild} {
+					resourceRepo.EXPECT().GetByURN(mock.Anything, r.URN).Return(r, nil)
+				}
+
+				// only the leaf is deleted right away, the others wait for
+				// their dependents.
+				deferred := map[string]bool{}
+				var deleted []string
+				resourceRepo.EXPECT().
+					Update(mock.Anything, mock.Anything, true, "action:delete").
+					RunAndReturn(func(_ context.Context, r resource.Resource, _ bool, _ string, _ ...resource.MutationHook) error {
+						deleted = append(deleted, r.Name)
+						deferred[r.Name] = r.State.DeleteDeferred
+						return nil
+					}).
+					Times(3)
+				t.Cleanup(func() {
+					assert.Equal(t, []string{"grandchild", "child", "bar"}, deleted)
+					assert.Equal(t, map[string]bool{"grandchild": false, "child": true, "bar": true}, deferred)
+				})
+
+				return core.New(resourceRepo, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+			},
+			urn:  "orn:entropy:mock:foo:bar",
+			opts: []core.Options{core.WithCascade(true)},
+		},
 	}
 
 	for _, tt := range tests {
@@ -716,7 +1187,7 @@ func TestService_DeleteResource(t *testing.T) {
 			t.Parallel()
 			svc := tt.setup(t)
 
-			err := svc.DeleteResource(context.Background(), tt.urn)
+			err := svc.DeleteResource(context.Background(), tt.urn, tt.opts...)
 			if tt.wantErr != nil {
 				assert.Error(t, err)
 				assert.True(t, errors.Is(err, tt.wantErr))
@@ -869,6 +1340,7 @@ func TestService_ApplyAction(t *testing.T) {
 				State:     resource.State{Status: resource.StatusPending},
 				CreatedAt: frozenTime,
 				UpdatedAt: frozenTime,
+				Version:   1,
 			},
 			wantErr: nil,
 		},
@@ -939,3 +1411,267 @@ func TestService_ApplyAction(t *testing.T) {
 		})
 	}
 }
+
+func TestService_RollbackResource(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{Status: resource.StatusCompleted}
+			return &planned, nil
+		})
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+	ctx := context.Background()
+
+	_, err = svc.CreateResource(ctx, resource.Resource{
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":1}`)},
+	})
+	require.NoError(t, err)
+
+	_, err = svc.UpdateResource(ctx, urn, resource.UpdateRequest{
+		Spec: resource.Spec{Configs: []byte(`{"replicas":5}`)},
+	})
+	require.NoError(t, err)
+
+	revs, err := store.Revisions(ctx, resource.RevisionsSelector{URN: urn})
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+
+	var first resource.Revision
+	for _, rev := range revs {
+		if rev.Reason == "action:create" {
+			first = rev
+		}
+	}
+
+	_, err = svc.RollbackResource(ctx, urn, first.ID+100, "john")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	dryRun, err := svc.RollbackResource(ctx, urn, first.ID, "john", core.WithDryRun(true))
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"replicas":1}`, string(dryRun.Spec.Configs))
+
+	cur, err := store.GetByURN(ctx, urn)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"replicas":5}`, string(cur.Spec.Configs))
+
+	got, err := svc.RollbackResource(ctx, urn, first.ID, "john")
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"replicas":1}`, string(got.Spec.Configs))
+	assert.Equal(t, "john", got.UpdatedBy)
+
+	revs, err = store.Revisions(ctx, resource.RevisionsSelector{URN: urn})
+	require.NoError(t, err)
+	require.Len(t, revs, 3)
+
+	var reasons []string
+	for _, rev := range revs {
+		reasons = append(reasons, rev.Reason)
+	}
+	assert.Contains(t, reasons, fmt.Sprintf("rollback:%d", first.ID))
+}
+
+func TestService_DryRunPlan(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	ctx := context.Background()
+	require.NoError(t, store.Create(ctx, resource.Resource{
+		URN:     urn,
+		Kind:    "mock",
+		Name:    "child",
+		Project: "project",
+		Labels:  map[string]string{"team": "data"},
+		Spec:    resource.Spec{Configs: []byte(`{"replicas":1}`)},
+		State:   resource.State{Status: resource.StatusCompleted},
+	}))
+
+	effects := []module.Effect{{Kind: "helm_release", Target: "ns/child"}}
+
+	mod := &mocks.ModuleService{}
+	mod.EXPECT().
+		GetOutput(mock.Anything, mock.Anything).
+		Return(nil, nil)
+	mod.EXPECT().
+		PlanAction(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, res module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
+			planned := res.Resource
+			planned.Spec.Configs = act.Params
+			planned.State = resource.State{
+				Status:     resource.StatusPending,
+				ModuleData: []byte(`{"pending_steps":["release_update"]}`),
+			}
+			return &planned, nil
+		}).Once()
+	mod.EXPECT().
+		DescribeEffects(mock.Anything, mock.Anything, mock.Anything).
+		RunAndReturn(func(_ context.Context, cur module.ExpandedResource, planned resource.Resource) ([]module.Effect, error) {
+			assert.JSONEq(t, `{"replicas":1}`, string(cur.Spec.Configs))
+			assert.JSONEq(t, `{"replicas":3}`, string(planned.Spec.Configs))
+			return effects, nil
+		}).Once()
+
+	svc := core.New(store, mod, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+	var plan core.Plan
+	_, err = svc.UpdateResource(ctx, urn, resource.UpdateRequest{
+		Spec:   resource.Spec{Configs: []byte(`{"replicas":3}`)},
+		Labels: map[string]string{"env": "prod"},
+	}, core.WithPlan(&plan))
+	require.NoError(t, err)
+
+	assert.Equal(t, []resource.Change{
+		{Op: resource.ChangeChanged, Path: "spec.configs.replicas", From: float64(1), To: float64(3)},
+		{Op: resource.ChangeAdded, Path: "labels.env", To: "prod"},
+		{Op: resource.ChangeChanged, Path: "state.status", From: resource.StatusCompleted, To: resource.StatusPending},
+		{Op: resource.ChangeAdded, Path: "state.module_data.pending_steps", To: []any{"release_update"}},
+	}, plan.Changes)
+	assert.Equal(t, effects, plan.Effects)
+
+	// dry-run must not change the stored resource.
+	cur, err := store.GetByURN(ctx, urn)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"replicas":1}`, string(cur.Spec.Configs))
+	mod.AssertExpectations(t)
+}
+
+func TestService_RetrySync(t *testing.T) {
+	t.Parallel()
+
+	const urn = "orn:entropy:mock:project:child"
+
+	failedState := resource.State{
+		Status:     resource.StatusError,
+		ModuleData: []byte(`{"pending_steps":["release_update","consumer_reset"],"reset_offset_to":"latest"}`),
+		SyncResult: resource.SyncResult{Retries: 5, LastError: "kube is down"},
+	}
+
+	tests := []struct {
+		name       string
+		state      resource.State
+		fromStep   string
+		locked     bool
+		breakGlass bool
+		want       resource.State
+		wantErr    error
+	}{
+		{
+			name:    "NotInError",
+			state:   resource.State{Status: resource.StatusCompleted},
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			name:     "StepNotPending",
+			state:    failedState,
+			fromStep: "release_create",
+			wantErr:  errors.ErrInvalid,
+		},
+		{
+			name:  "RemainingSteps",
+			state: failedState,
+			want: resource.State{
+				Status:     resource.StatusPending,
+				ModuleData: failedState.ModuleData,
+				NextSyncAt: &frozenTime,
+			},
+		},
+		{
+			name:    "Locked",
+			state:   failedState,
+			locked:  true,
+			wantErr: errors.ErrInvalid,
+		},
+		{
+			name:       "BreakGlass",
+			state:      failedState,
+			locked:     true,
+			breakGlass: true,
+			want: resource.State{
+				Status:     resource.StatusPending,
+				ModuleData: failedState.ModuleData,
+				NextSyncAt: &frozenTime,
+			},
+		},
+		{
+			name:     "FromStep",
+			state:    failedState,
+			fromStep: "consumer_reset",
+			want: resource.State{
+				Status:     resource.StatusPending,
+				ModuleData: []byte(`{"pending_steps":["consumer_reset"],"reset_offset_to":"latest"}`),
+				NextSyncAt: &frozenTime,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+			require.NoError(t, err)
+			require.NoError(t, store.Create(context.Background(), resource.Resource{
+				URN:     urn,
+				Kind:    "mock",
+				Name:    "child",
+				Project: "project",
+				State:   tt.state,
+			}))
+
+			if tt.locked {
+				_, err := store.CreateLock(context.Background(), lock.Lock{
+					Project:   "project",
+					URN:       urn,
+					Reason:    "incident",
+					CreatedBy: "jane",
+				})
+				require.NoError(t, err)
+			}
+
+			svc := core.New(store, &mocks.ModuleService{}, deadClock, defaultSyncBackoff, defaultMaxRetries, serviceName)
+
+			var op operation.Operation
+			got, err := svc.RetrySync(context.Background(), urn, tt.fromStep, "john",
+				core.WithBreakGlass(tt.breakGlass), core.WithOperation(&op))
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, "john", got.UpdatedBy)
+
+			stored, err := store.GetByURN(context.Background(), urn)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want.Status, stored.State.Status)
+			assert.JSONEq(t, string(tt.want.ModuleData), string(stored.State.ModuleData))
+			assert.Equal(t, tt.want.NextSyncAt, stored.State.NextSyncAt)
+			assert.Equal(t, resource.SyncResult{}, stored.State.SyncResult)
+
+			// the retry is tracked like an action.
+			assert.NotZero(t, op.ID)
+			assert.Equal(t, "retry_sync", op.Action)
+			assert.Equal(t, operation.StatusPending, op.Status)
+		})
+	}
+}
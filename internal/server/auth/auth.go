@@ -0,0 +1,176 @@
+// Package auth verifies the bearer tokens presented by the API clients.
+package auth
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	defaultUserClaim   = "sub"
+	defaultGroupsClaim = "groups"
+	defaultRefresh     = time.Hour
+)
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// Config enables authentication of the API requests with bearer JWTs.
+type Config struct {
+	Enabled bool `mapstructure:"enabled"`
+
+	// Issuer is matched against the iss claim of the tokens. Unless
+	// JWKSFile is set, the signing keys are fetched from the OpenID
+	// discovery document of the issuer.
+	Issuer   string `mapstructure:"issuer"`
+	Audience string `mapstructure:"audience"`
+	JWKSFile string `mapstructure:"jwks_file"`
+
+	// UserClaim and GroupsClaim name the claims that carry the id and the
+	// groups of the caller.
+	UserClaim   string `mapstructure:"user_claim" default:"sub"`
+	GroupsClaim string `mapstructure:"groups_claim" default:"groups"`
+
+	// AllowHeaderFallback lets requests without a token through, with the
+	// caller taken from the user-id and user-groups headers as when
+	// authentication is disabled. It is meant for migrating clients.
+	AllowHeaderFallback bool `mapstructure:"allow_header_fallback"`
+
+	// KeysRefreshInterval is how long the keys of the issuer are cached.
+	KeysRefreshInterval time.Duration `mapstructure:"keys_refresh_interval" default:"1h"`
+
+	// Leeway is the clock skew tolerated on the exp, nbf and iat claims.
+	Leeway time.Duration `mapstructure:"leeway" default:"1m"`
+}
+
+// Identity is the caller of a request as stated by a verified token.
+type Identity struct {
+	UserID string
+	Groups []string
+}
+
+// Authenticator verifies bearer tokens against the configured keys.
+type Authenticator struct {
+	cfg  Config
+	keys keySource
+	now  func() time.Time
+}
+
+// New returns an authenticator for the config. Keys of a JWKS file are
+// loaded right away, keys of an issuer on the first request.
+func New(cfg Config) (*Authenticator, error) {
+	if cfg.UserClaim == "" {
+		cfg.UserClaim = defaultUserClaim
+	}
+	if cfg.GroupsClaim == "" {
+		cfg.GroupsClaim = defaultGroupsClaim
+	}
+	if cfg.KeysRefreshInterval <= 0 {
+		cfg.KeysRefreshInterval = defaultRefresh
+	}
+
+	var keys keySource
+	switch {
+	case cfg.JWKSFile != "":
+		fileKeys, err := loadKeyFile(cfg.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keys = fileKeys
+
+	case cfg.Issuer != "":
+		keys = newIssuerKeys(cfg.Issuer, cfg.KeysRefreshInterval)
+
+	default:
+		return nil, errors.ErrInvalid.WithMsgf("one of issuer and jwks_file must be set")
+	}
+
+	return &Authenticator{cfg: cfg, keys: keys, now: time.Now}, nil
+}
+
+// AllowsHeaderFallback returns true if requests without a token may be
+// identified by their headers.
+func (a *Authenticator) AllowsHeaderFallback() bool {
+	return a.cfg.AllowHeaderFallback
+}
+
+// Authenticate verifies the signature and the claims of the token and
+// returns the caller it identifies.
+func (a *Authenticator) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	tok, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return nil, errors.ErrUnauthenticated.WithMsgf("malformed token").WithCausef("%s", err.Error())
+	}
+
+	keys, err := a.keys.keys(ctx, tok.Headers[0].KeyID)
+	if err != nil {
+		return nil, errors.ErrInternal.WithMsgf("failed to get token signing keys").WithCausef("%s", err.Error())
+	}
+
+	var claims jwt.Claims
+	var extra map[string]any
+	verified := false
+	for _, key := range keys {
+		if err := tok.Claims(key, &claims, &extra); err == nil {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, errors.ErrUnauthenticated.WithMsgf("token signature could not be verified")
+	} else if claims.Expiry == nil {
+		return nil, errors.ErrUnauthenticated.WithMsgf("token has no expiry")
+	}
+
+	expected := jwt.Expected{Issuer: a.cfg.Issuer, Time: a.now()}
+	if a.cfg.Audience != "" {
+		expected.AnyAudience = jwt.Audience{a.cfg.Audience}
+	}
+	if err := claims.ValidateWithLeeway(expected, a.cfg.Leeway); err != nil {
+		if errors.Is(err, jwt.ErrExpired) {
+			return nil, errors.ErrUnauthenticated.WithMsgf("token has expired")
+		}
+		return nil, errors.ErrUnauthenticated.WithMsgf("invalid token claims").WithCausef("%s", err.Error())
+	}
+
+	userID, _ := extra[a.cfg.UserClaim].(string)
+	if userID == "" {
+		return nil, errors.ErrUnauthenticated.WithMsgf("token has no '%s' claim", a.cfg.UserClaim)
+	}
+
+	return &Identity{
+		UserID: userID,
+		Groups: claimStrings(extra[a.cfg.GroupsClaim]),
+	}, nil
+}
+
+// claimStrings accepts both a list of strings and a single string, as
+// issuers differ in how they encode the groups.
+func claimStrings(v any) []string {
+	switch val := v.(type) {
+	case string:
+		if val == "" {
+			return nil
+		}
+		return []string{val}
+
+	case []any:
+		var res []string
+		for _, item := range val {
+			if s, ok := item.(string); ok && s != "" {
+				res = append(res, s)
+			}
+		}
+		return res
+	}
+	return nil
+}
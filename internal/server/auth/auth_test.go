@@ -0,0 +1,234 @@
+package auth_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/internal/server/auth"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	issuer   = "https://accounts.example.com"
+	audience = "entropy"
+)
+
+func TestAuthenticator_Authenticate(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	jwksFile := writeJWKS(t, jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: rsaKey.Public(), KeyID: "rsa", Algorithm: string(jose.RS256), Use: "sig"},
+		{Key: ecKey.Public(), KeyID: "ec", Algorithm: string(jose.ES256), Use: "sig"},
+	}})
+
+	authn, err := auth.New(auth.Config{
+		Issuer:      issuer,
+		Audience:    audience,
+		JWKSFile:    jwksFile,
+		UserClaim:   "email",
+		GroupsClaim: "groups",
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	valid := jwt.Claims{
+		Issuer:   issuer,
+		Subject:  "1234",
+		Audience: jwt.Audience{audience},
+		Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		IssuedAt: jwt.NewNumericDate(now),
+	}
+	user := map[string]any{"email": "john.doe@goto.com", "groups": []string{"oncall", "platform"}}
+
+	withClaims := func(mutate func(c *jwt.Claims)) jwt.Claims {
+		c := valid
+		mutate(&c)
+		return c
+	}
+
+	tests := []struct {
+		name    string
+		token   string
+		want    *auth.Identity
+		wantErr error
+	}{
+		{
+			name:  "RSA",
+			token: mint(t, jose.RS256, rsaKey, "rsa", valid, user),
+			want:  &auth.Identity{UserID: "john.doe@goto.com", Groups: []string{"oncall", "platform"}},
+		},
+		{
+			name:  "ECDSAWithSingleGroup",
+			token: mint(t, jose.ES256, ecKey, "ec", valid, map[string]any{"email": "jane@goto.com", "groups": "oncall"}),
+			want:  &auth.Identity{UserID: "jane@goto.com", Groups: []string{"oncall"}},
+		},
+		{
+			name:  "NoKeyID",
+			token: mint(t, jose.RS256, rsaKey, "", valid, user),
+			want:  &auth.Identity{UserID: "john.doe@goto.com", Groups: []string{"oncall", "platform"}},
+		},
+		{
+			name:    "Malformed",
+			token:   "not-a-token",
+			wantErr: errors.ErrUnauthenticated,
+		},
+		{
+			name:    "UnknownKey",
+			token:   mint(t, jose.RS256, otherKey, "other", valid, user),
+			wantErr: errors.ErrUnauthenticated,
+		},
+		{
+			name:    "ForgedSignature",
+			token:   mint(t, jose.RS256, otherKey, "rsa", valid, user),
+			wantErr: errors.ErrUnauthenticated,
+		},
+		{
+			name:    "Expired",
+			token:   mint(t, jose.RS256, rsaKey, "rsa", withClaims(func(c *jwt.Claims) { c.Expiry = jwt.NewNumericDate(now.Add(-time.Hour)) }), user),
+			wantErr: errors.ErrUnauthenticated,
+		},
+		{
+			name:    "NoExpiry",
+			token:   mint(t, jose.RS256, rsaKey, "rsa", withClaims(func(c *jwt.Claims) { c.Expiry = nil }), user),
+			wantErr: errors.ErrUnauthenticated,
+		},
+		{
+			name:    "WrongIssuer",
+			token:   mint(t, jose.RS256, rsaKey, "rsa", withClaims(func(c *jwt.Claims) { c.Issuer = "https://evil.example.com" }), user),
+			wantErr: errors.ErrUnauthenticated,
+		},
+		{
+			name:    "WrongAudience",
+			token:   mint(t, jose.RS256, rsaKey, "rsa", withClaims(func(c *jwt.Claims) { c.Audience = jwt.Audience{"other"} }), user),
+			wantErr: errors.ErrUnauthenticated,
+		},
+		{
+			name:    "NoUserClaim",
+			token:   mint(t, jose.RS256, rsaKey, "rsa", valid, map[string]any{"groups": []string{"oncall"}}),
+			wantErr: errors.ErrUnauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := authn.Authenticate(context.Background(), tt.token)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthenticator_IssuerKeys(t *testing.T) {
+	t.Parallel()
+
+	oldKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	newKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	set := jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: oldKey.Public(), KeyID: "old", Use: "sig"}}}
+
+	var srv *httptest.Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, _ *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": srv.URL, "jwks_uri": srv.URL + "/keys"})
+	})
+	fetches := 0
+	mux.HandleFunc("/keys", func(w http.ResponseWriter, _ *http.Request) {
+		fetches++
+		_ = json.NewEncoder(w).Encode(set)
+	})
+	srv = httptest.NewServer(mux)
+	t.Cleanup(srv.Close)
+
+	authn, err := auth.New(auth.Config{Issuer: srv.URL})
+	require.NoError(t, err)
+
+	claims := jwt.Claims{Issuer: srv.URL, Subject: "john", Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}
+
+	got, err := authn.Authenticate(context.Background(), mint(t, jose.RS256, oldKey, "old", claims, nil))
+	require.NoError(t, err)
+	assert.Equal(t, &auth.Identity{UserID: "john"}, got)
+
+	// keys are cached until they are stale.
+	_, err = authn.Authenticate(context.Background(), mint(t, jose.RS256, oldKey, "old", claims, nil))
+	require.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+
+	// a key rotated in moments ago is not refetched right away.
+	set.Keys = append(set.Keys, jose.JSONWebKey{Key: newKey.Public(), KeyID: "new", Use: "sig"})
+	_, err = authn.Authenticate(context.Background(), mint(t, jose.RS256, newKey, "new", claims, nil))
+	assert.ErrorIs(t, err, errors.ErrUnauthenticated)
+	assert.Equal(t, 1, fetches)
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	_, err := auth.New(auth.Config{Enabled: true})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+
+	_, err = auth.New(auth.Config{Enabled: true, JWKSFile: filepath.Join(t.TempDir(), "missing.json")})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+
+	_, err = auth.New(auth.Config{Enabled: true, JWKSFile: writeJWKS(t, jose.JSONWebKeySet{})})
+	assert.ErrorIs(t, err, errors.ErrInvalid)
+}
+
+func mint(t *testing.T, alg jose.SignatureAlgorithm, key any, kid string, claims jwt.Claims, extra map[string]any) string {
+	t.Helper()
+
+	signer, err := jose.NewSigner(
+		jose.SigningKey{Algorithm: alg, Key: jose.JSONWebKey{Key: key, KeyID: kid}},
+		(&jose.SignerOptions{}).WithType("JWT"),
+	)
+	require.NoError(t, err)
+
+	builder := jwt.Signed(signer).Claims(claims)
+	if extra != nil {
+		builder = builder.Claims(extra)
+	}
+	token, err := builder.Serialize()
+	require.NoError(t, err)
+	return token
+}
+
+func writeJWKS(t *testing.T, set jose.JSONWebKeySet) string {
+	t.Helper()
+
+	data, err := json.Marshal(set)
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+	return path
+}
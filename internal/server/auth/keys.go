@@ -0,0 +1,159 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	fetchTimeout = 10 * time.Second
+
+	// minRefetchInterval limits how often a token with an unknown key id
+	// makes the keys of the issuer to be fetched again.
+	minRefetchInterval = time.Minute
+)
+
+type keySource interface {
+	// keys returns the keys that may have signed a token with the key id.
+	keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error)
+}
+
+type fileKeys struct {
+	set jose.JSONWebKeySet
+}
+
+func loadKeyFile(path string) (*fileKeys, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.ErrInvalid.WithMsgf("failed to read jwks file '%s'", path).WithCausef("%s", err.Error())
+	}
+
+	var set jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, errors.ErrInvalid.WithMsgf("failed to parse jwks file '%s'", path).WithCausef("%s", err.Error())
+	} else if len(set.Keys) == 0 {
+		return nil, errors.ErrInvalid.WithMsgf("jwks file '%s' has no keys", path)
+	}
+	return &fileKeys{set: set}, nil
+}
+
+func (fk *fileKeys) keys(_ context.Context, kid string) ([]jose.JSONWebKey, error) {
+	return lookupKeys(fk.set, kid), nil
+}
+
+// issuerKeys fetches the keys listed by the OpenID discovery document of
+// the issuer, refetching them once they are stale or when a token names a
+// key that is not known yet (i.e., the keys were rotated).
+type issuerKeys struct {
+	issuer  string
+	refresh time.Duration
+	client  *http.Client
+
+	mu        sync.Mutex
+	set       jose.JSONWebKeySet
+	fetchedAt time.Time
+}
+
+func newIssuerKeys(issuer string, refresh time.Duration) *issuerKeys {
+	return &issuerKeys{
+		issuer:  issuer,
+		refresh: refresh,
+		client:  &http.Client{Timeout: fetchTimeout},
+	}
+}
+
+func (ik *issuerKeys) keys(ctx context.Context, kid string) ([]jose.JSONWebKey, error) {
+	ik.mu.Lock()
+	defer ik.mu.Unlock()
+
+	found := lookupKeys(ik.set, kid)
+	age := time.Since(ik.fetchedAt)
+	if age < ik.refresh && (len(found) > 0 || age < minRefetchInterval) {
+		return found, nil
+	}
+
+	if err := ik.fetch(ctx); err != nil {
+		if len(found) > 0 {
+			zap.L().Warn("failed to refresh issuer keys, using cached keys",
+				zap.String("issuer", ik.issuer), zap.Error(err))
+			return found, nil
+		}
+		return nil, err
+	}
+	return lookupKeys(ik.set, kid), nil
+}
+
+func (ik *issuerKeys) fetch(ctx context.Context) error {
+	var discovery struct {
+		Issuer  string `json:"issuer"`
+		JWKSURI string `json:"jwks_uri"`
+	}
+	discoveryURL := strings.TrimSuffix(ik.issuer, "/") + "/.well-known/openid-configuration"
+	if err := ik.getJSON(ctx, discoveryURL, &discovery); err != nil {
+		return err
+	} else if discovery.Issuer != ik.issuer {
+		return fmt.Errorf("discovery document is for issuer '%s', not '%s'", discovery.Issuer, ik.issuer)
+	} else if discovery.JWKSURI == "" {
+		return fmt.Errorf("discovery document of '%s' has no jwks_uri", ik.issuer)
+	}
+
+	var set jose.JSONWebKeySet
+	if err := ik.getJSON(ctx, discovery.JWKSURI, &set); err != nil {
+		return err
+	}
+
+	ik.set = set
+	ik.fetchedAt = time.Now()
+	return nil
+}
+
+func (ik *issuerKeys) getJSON(ctx context.Context, url string, into any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ik.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %s: unexpected status %d", url, resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, into)
+}
+
+// lookupKeys returns the signing keys with the key id, or all of them if
+// the token names none.
+func lookupKeys(set jose.JSONWebKeySet, kid string) []jose.JSONWebKey {
+	if kid != "" {
+		return set.Key(kid)
+	}
+
+	var keys []jose.JSONWebKey
+	for _, key := range set.Keys {
+		if key.Use == "" || key.Use == "sig" {
+			keys = append(keys, key)
+		}
+	}
+	return keys
+}
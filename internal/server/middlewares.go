@@ -34,14 +34,21 @@ func (wr *wrappedWriter) WriteHeader(statusCode int) {
 }
 
 func (wr *wrappedWriter) Write(data []byte) (int, error) {
-	// write to the buffer to capture the response body
-	if wr.ResponseBuffer != nil {
+	// write to the buffer to capture the response body, it is logged only
+	// for non-2xx responses. Successful responses may be long-lived streams.
+	if wr.ResponseBuffer != nil && !is2xx(wr.Status) {
 		wr.ResponseBuffer.Write(data)
 	}
 	// write to the actual ResponseWriter
 	return wr.ResponseWriter.Write(data)
 }
 
+// Unwrap allows http.ResponseController to reach the underlying writer,
+// e.g., for flushing streamed responses.
+func (wr *wrappedWriter) Unwrap() http.ResponseWriter {
+	return wr.ResponseWriter
+}
+
 func withOpenTelemetry() gorillamux.MiddlewareFunc {
 	return func(next http.Handler) http.Handler {
 		return otelhttp.NewHandler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
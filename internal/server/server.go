@@ -21,6 +21,7 @@ import (
 	"google.golang.org/grpc/reflection"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/goto/entropy/internal/server/auth"
 	"github.com/goto/entropy/internal/server/serverutils"
 	modulesv1 "github.com/goto/entropy/internal/server/v1/modules"
 	resourcesv1 "github.com/goto/entropy/internal/server/v1/resources"
@@ -39,8 +40,9 @@ const (
 )
 
 // Serve initialises all the gRPC+HTTP API routes, starts listening for requests at addr, and blocks until server exits.
-// Server exits gracefully when context is cancelled.
-func Serve(ctx context.Context, httpAddr, grpcAddr string, nrApp *newrelic.Application,
+// Server exits gracefully when context is cancelled. Requests are authenticated by authn, or identified by their
+// headers if it is nil.
+func Serve(ctx context.Context, httpAddr, grpcAddr string, nrApp *newrelic.Application, authn *auth.Authenticator,
 	resourceSvc resourcesv1.ResourceService, moduleSvc modulesv1.ModuleService,
 ) error {
 	grpcOpts := []grpc.ServerOption{
@@ -49,7 +51,9 @@ func Serve(ctx context.Context, httpAddr, grpcAddr string, nrApp *newrelic.Appli
 			grpcctxtags.UnaryServerInterceptor(),
 			grpczap.UnaryServerInterceptor(zap.L()),
 			nrgrpc.UnaryServerInterceptor(nrApp),
+			serverutils.UnaryAuthInterceptor(authn),
 		)),
+		grpc.StreamInterceptor(serverutils.StreamAuthInterceptor(authn)),
 		grpc.StatsHandler(otelgrpc.NewServerHandler()),
 	}
 	grpcServer := grpc.NewServer(grpcOpts...)
@@ -92,7 +96,12 @@ func Serve(ctx context.Context, httpAddr, grpcAddr string, nrApp *newrelic.Appli
 	httpRouter.Use(
 		withOpenTelemetry(),
 		nrgorilla.Middleware(nrApp),
+		serverutils.HTTPAuthMiddleware(authn),
 	)
+	// the gateway does not support streaming calls, watches are served as
+	// server-sent events instead.
+	httpRouter.Handle("/api/v1beta1/resources:watch", resourcesv1.NewWatchHandler(resourceSvc)).
+		Methods(http.MethodGet)
 	httpRouter.PathPrefix("/api/").Handler(http.StripPrefix("/api", rpcHTTPGateway))
 	httpRouter.Handle("/ping", http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
 		_, _ = fmt.Fprintf(wr, "pong")
@@ -8,11 +8,24 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
+
+	"github.com/goto/entropy/core/audit"
 )
 
-const userIDHeader = "user-id"
+const (
+	userIDHeader     = "user-id"
+	userGroupsHeader = "user-groups"
+	requestIDHeader  = "x-request-id"
+)
 
+// GetUserIdentifier returns the id of the caller, as verified from its
+// token if the request was authenticated, or as given by the user-id
+// header.
 func GetUserIdentifier(ctx context.Context) (string, error) {
+	if id, ok := identityFrom(ctx); ok {
+		return id.UserID, nil
+	}
+
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
 		return "", status.Errorf(codes.DataLoss, "failed to get metadata")
@@ -31,8 +44,63 @@ func GetUserIdentifier(ctx context.Context) (string, error) {
 	return userID, nil
 }
 
+// GetUserGroups returns the groups of the caller, as verified from its
+// token if the request was authenticated, or as given by the user-groups
+// header in a comma separated list.
+func GetUserGroups(ctx context.Context) []string {
+	if id, ok := identityFrom(ctx); ok {
+		return id.Groups
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	return splitGroups(md[userGroupsHeader])
+}
+
+func splitGroups(values []string) []string {
+	var groups []string
+	for _, v := range values {
+		for _, group := range strings.Split(v, ",") {
+			if group = strings.TrimSpace(group); group != "" {
+				groups = append(groups, group)
+			}
+		}
+	}
+	return groups
+}
+
+// GetRequestID returns the request id set by the HTTP middleware or the
+// gRPC client. Returns empty string if there is none.
+func GetRequestID(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	rid := md[requestIDHeader]
+	if len(rid) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(rid[0])
+}
+
+// WithAuditActor returns a copy of ctx carrying the user and request id of
+// the caller, to be recorded in the audit log.
+func WithAuditActor(ctx context.Context) context.Context {
+	userID, _ := GetUserIdentifier(ctx)
+	return audit.WithActor(ctx, audit.Actor{
+		UserID:    userID,
+		RequestID: GetRequestID(ctx),
+	})
+}
+
 func ExtractRequestMetadata(_ context.Context, request *http.Request) metadata.MD {
-	header := request.Header.Get(userIDHeader)
-	md := metadata.Pairs(userIDHeader, header)
-	return md
+	return metadata.Pairs(
+		userIDHeader, request.Header.Get(userIDHeader),
+		userGroupsHeader, request.Header.Get(userGroupsHeader),
+		requestIDHeader, request.Header.Get(requestIDHeader),
+	)
 }
@@ -1,6 +1,7 @@
 package serverutils
 
 import (
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
@@ -8,7 +9,8 @@ import (
 )
 
 // ToRPCError returns an instance of gRPC Error Status equivalent to the
-// given error value.
+// given error value. Violations of the error are attached as a
+// PreconditionFailure detail.
 func ToRPCError(e error) error {
 	err := errors.E(e)
 
@@ -23,8 +25,35 @@ func ToRPCError(e error) error {
 	case errors.Is(err, errors.ErrInvalid):
 		code = codes.InvalidArgument
 
+	case errors.Is(err, errors.ErrQuotaExceeded):
+		code = codes.ResourceExhausted
+
+	case errors.Is(err, errors.ErrForbidden):
+		code = codes.PermissionDenied
+
+	case errors.Is(err, errors.ErrUnauthenticated):
+		code = codes.Unauthenticated
+
 	default:
 		code = codes.Internal
 	}
-	return status.Error(code, err.Error())
+	st := status.New(code, err.Error())
+	if len(err.Violations) == 0 {
+		return st.Err()
+	}
+
+	failure := &errdetails.PreconditionFailure{}
+	for _, v := range err.Violations {
+		failure.Violations = append(failure.Violations, &errdetails.PreconditionFailure_Violation{
+			Type:        v.Type,
+			Subject:     v.Subject,
+			Description: v.Description,
+		})
+	}
+
+	detailed, detailsErr := st.WithDetails(failure)
+	if detailsErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
 }
@@ -0,0 +1,123 @@
+package serverutils
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	grpcmiddleware "github.com/grpc-ecosystem/go-grpc-middleware"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/internal/server/auth"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+const (
+	authorizationHeader = "authorization"
+	bearerScheme        = "bearer "
+)
+
+type identityKey struct{}
+
+// UnaryAuthInterceptor establishes the caller of the request and attaches
+// it to the context, for the services to authorize. With an authenticator
+// the caller is taken from the bearer token and requests without one are
+// rejected, unless the header fallback is allowed. Otherwise, the caller
+// is named by the user-id and user-groups headers and requests without
+// them get an anonymous caller.
+func UnaryAuthInterceptor(authn *auth.Authenticator) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx, err := authenticateRPC(ctx, authn, info.FullMethod)
+		if err != nil {
+			return nil, ToRPCError(err)
+		}
+		return handler(ctx, req)
+	}
+}
+
+// StreamAuthInterceptor is UnaryAuthInterceptor for streams.
+func StreamAuthInterceptor(authn *auth.Authenticator) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := authenticateRPC(ss.Context(), authn, info.FullMethod)
+		if err != nil {
+			return ToRPCError(err)
+		}
+
+		wrapped := grpcmiddleware.WrapServerStream(ss)
+		wrapped.WrappedContext = ctx
+		return handler(srv, wrapped)
+	}
+}
+
+// HTTPAuthMiddleware is UnaryAuthInterceptor for the requests served over
+// HTTP, which reach the API servers without passing through the gRPC
+// interceptors.
+func HTTPAuthMiddleware(authn *auth.Authenticator) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(wr http.ResponseWriter, req *http.Request) {
+			if req.URL.Path == "/ping" {
+				next.ServeHTTP(wr, req)
+				return
+			}
+
+			ctx, err := authenticate(req.Context(), authn,
+				req.Header.Get(authorizationHeader),
+				strings.TrimSpace(req.Header.Get(userIDHeader)),
+				splitGroups(req.Header.Values(userGroupsHeader)),
+			)
+			if err != nil {
+				st, _ := status.FromError(ToRPCError(err))
+				http.Error(wr, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+				return
+			}
+			next.ServeHTTP(wr, req.WithContext(ctx))
+		})
+	}
+}
+
+func authenticateRPC(ctx context.Context, authn *auth.Authenticator, fullMethod string) (context.Context, error) {
+	// reflection is used by tools like grpcurl before they can make any
+	// authenticated call.
+	if strings.HasPrefix(fullMethod, "/grpc.reflection.") {
+		return ctx, nil
+	}
+
+	var authorization string
+	if md, ok := metadata.FromIncomingContext(ctx); ok && len(md[authorizationHeader]) > 0 {
+		authorization = md[authorizationHeader][0]
+	}
+
+	userID, _ := GetUserIdentifier(ctx)
+	return authenticate(ctx, authn, authorization, userID, GetUserGroups(ctx))
+}
+
+func authenticate(ctx context.Context, authn *auth.Authenticator, authorization, userID string, groups []string) (context.Context, error) {
+	if authn != nil {
+		if authorization != "" {
+			if len(authorization) <= len(bearerScheme) || !strings.EqualFold(authorization[:len(bearerScheme)], bearerScheme) {
+				return nil, errors.ErrUnauthenticated.WithMsgf("authorization must be a bearer token")
+			}
+
+			id, err := authn.Authenticate(ctx, strings.TrimSpace(authorization[len(bearerScheme):]))
+			if err != nil {
+				return nil, err
+			}
+
+			ctx = context.WithValue(ctx, identityKey{}, id)
+			return rbac.WithPrincipal(ctx, rbac.Principal{UserID: id.UserID, Groups: id.Groups}), nil
+		} else if !authn.AllowsHeaderFallback() {
+			return nil, errors.ErrUnauthenticated.WithMsgf("missing bearer token")
+		}
+	}
+
+	return rbac.WithPrincipal(ctx, rbac.Principal{UserID: userID, Groups: groups}), nil
+}
+
+func identityFrom(ctx context.Context) (*auth.Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*auth.Identity)
+	return id, ok && id != nil
+}
@@ -0,0 +1,128 @@
+package serverutils_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/internal/server/auth"
+	"github.com/goto/entropy/internal/server/serverutils"
+)
+
+func TestUnaryAuthInterceptor(t *testing.T) {
+	t.Parallel()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: key.Public(), KeyID: "k1"}}})
+	require.NoError(t, err)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, data, 0o600))
+
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.RS256, Key: jose.JSONWebKey{Key: key, KeyID: "k1"}}, nil)
+	require.NoError(t, err)
+	token, err := jwt.Signed(signer).
+		Claims(jwt.Claims{Subject: "john.doe@goto.com", Expiry: jwt.NewNumericDate(time.Now().Add(time.Hour))}).
+		Claims(map[string]any{"groups": []string{"oncall"}}).
+		Serialize()
+	require.NoError(t, err)
+
+	strict, err := auth.New(auth.Config{Enabled: true, JWKSFile: jwksFile})
+	require.NoError(t, err)
+	fallback, err := auth.New(auth.Config{Enabled: true, JWKSFile: jwksFile, AllowHeaderFallback: true})
+	require.NoError(t, err)
+
+	spoofed := map[string]string{"user-id": "admin@goto.com", "user-groups": "platform"}
+
+	tests := []struct {
+		name      string
+		authn     *auth.Authenticator
+		md        map[string]string
+		token     string
+		want      rbac.Principal
+		wantCode  codes.Code
+		wantError bool
+	}{
+		{
+			name:  "Disabled",
+			md:    spoofed,
+			token: token,
+			want:  rbac.Principal{UserID: "admin@goto.com", Groups: []string{"platform"}},
+		},
+		{
+			name:  "TokenOverridesHeaders",
+			authn: strict,
+			md:    spoofed,
+			token: token,
+			want:  rbac.Principal{UserID: "john.doe@goto.com", Groups: []string{"oncall"}},
+		},
+		{
+			name:      "MissingToken",
+			authn:     strict,
+			md:        spoofed,
+			wantCode:  codes.Unauthenticated,
+			wantError: true,
+		},
+		{
+			name:      "InvalidToken",
+			authn:     fallback,
+			md:        spoofed,
+			token:     token + "x",
+			wantCode:  codes.Unauthenticated,
+			wantError: true,
+		},
+		{
+			name:  "HeaderFallback",
+			authn: fallback,
+			md:    spoofed,
+			want:  rbac.Principal{UserID: "admin@goto.com", Groups: []string{"platform"}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			md := metadata.New(tt.md)
+			if tt.token != "" {
+				md.Set("authorization", "Bearer "+tt.token)
+			}
+			ctx := metadata.NewIncomingContext(context.Background(), md)
+
+			var got rbac.Principal
+			var userID string
+			handler := func(ctx context.Context, _ any) (any, error) {
+				got, _ = rbac.PrincipalFrom(ctx)
+				userID, _ = serverutils.GetUserIdentifier(ctx)
+				return nil, nil
+			}
+
+			interceptor := serverutils.UnaryAuthInterceptor(tt.authn)
+			_, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{FullMethod: "/test.Service/Method"}, handler)
+			if tt.wantError {
+				assert.Equal(t, tt.wantCode, status.Code(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.want.UserID, userID)
+		})
+	}
+}
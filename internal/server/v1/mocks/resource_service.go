@@ -1,16 +1,33 @@
-// Code generated by mockery v2.43.2. DO NOT EDIT.
+// Code generated by mockery v2.42.1. DO NOT EDIT.
 
 package mocks
 
 import (
 	context "context"
 
+	audit "github.com/goto/entropy/core/audit"
+
 	core "github.com/goto/entropy/core"
+
+	lock "github.com/goto/entropy/core/lock"
+
 	mock "github.com/stretchr/testify/mock"
 
 	module "github.com/goto/entropy/core/module"
 
+	operation "github.com/goto/entropy/core/operation"
+
+	policy "github.com/goto/entropy/core/policy"
+
+	quota "github.com/goto/entropy/core/quota"
+
+	rbac "github.com/goto/entropy/core/rbac"
+
 	resource "github.com/goto/entropy/core/resource"
+
+	schedule "github.com/goto/entropy/core/schedule"
+
+	webhook "github.com/goto/entropy/core/webhook"
 )
 
 // ResourceService is an autogenerated mock type for the ResourceService type
@@ -101,36 +118,29 @@ func (_c *ResourceService_ApplyAction_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
-// CreateResource provides a mock function with given fields: ctx, res, resourceOpts
-func (_m *ResourceService) CreateResource(ctx context.Context, res resource.Resource, resourceOpts ...core.Options) (*resource.Resource, error) {
-	_va := make([]interface{}, len(resourceOpts))
-	for _i := range resourceOpts {
-		_va[_i] = resourceOpts[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, ctx, res)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// BulkApplyAction provides a mock function with given fields: ctx, req
+func (_m *ResourceService) BulkApplyAction(ctx context.Context, req core.BulkActionRequest) ([]core.BulkActionResult, error) {
+	ret := _m.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateResource")
+		panic("no return value specified for BulkApplyAction")
 	}
 
-	var r0 *resource.Resource
+	var r0 []core.BulkActionResult
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, ...core.Options) (*resource.Resource, error)); ok {
-		return rf(ctx, res, resourceOpts...)
+	if rf, ok := ret.Get(0).(func(context.Context, core.BulkActionRequest) ([]core.BulkActionResult, error)); ok {
+		return rf(ctx, req)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, ...core.Options) *resource.Resource); ok {
-		r0 = rf(ctx, res, resourceOpts...)
+	if rf, ok := ret.Get(0).(func(context.Context, core.BulkActionRequest) []core.BulkActionResult); ok {
+		r0 = rf(ctx, req)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*resource.Resource)
+			r0 = ret.Get(0).([]core.BulkActionResult)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, resource.Resource, ...core.Options) error); ok {
-		r1 = rf(ctx, res, resourceOpts...)
+	if rf, ok := ret.Get(1).(func(context.Context, core.BulkActionRequest) error); ok {
+		r1 = rf(ctx, req)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -138,113 +148,117 @@ func (_m *ResourceService) CreateResource(ctx context.Context, res resource.Reso
 	return r0, r1
 }
 
-// ResourceService_CreateResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResource'
-type ResourceService_CreateResource_Call struct {
+// ResourceService_BulkApplyAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkApplyAction'
+type ResourceService_BulkApplyAction_Call struct {
 	*mock.Call
 }
 
-// CreateResource is a helper method to define mock.On call
+// BulkApplyAction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - res resource.Resource
-//   - resourceOpts ...core.Options
-func (_e *ResourceService_Expecter) CreateResource(ctx interface{}, res interface{}, resourceOpts ...interface{}) *ResourceService_CreateResource_Call {
-	return &ResourceService_CreateResource_Call{Call: _e.mock.On("CreateResource",
-		append([]interface{}{ctx, res}, resourceOpts...)...)}
+//   - req core.BulkActionRequest
+func (_e *ResourceService_Expecter) BulkApplyAction(ctx interface{}, req interface{}) *ResourceService_BulkApplyAction_Call {
+	return &ResourceService_BulkApplyAction_Call{Call: _e.mock.On("BulkApplyAction", ctx, req)}
 }
 
-func (_c *ResourceService_CreateResource_Call) Run(run func(ctx context.Context, res resource.Resource, resourceOpts ...core.Options)) *ResourceService_CreateResource_Call {
+func (_c *ResourceService_BulkApplyAction_Call) Run(run func(ctx context.Context, req core.BulkActionRequest)) *ResourceService_BulkApplyAction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]core.Options, len(args)-2)
-		for i, a := range args[2:] {
-			if a != nil {
-				variadicArgs[i] = a.(core.Options)
-			}
-		}
-		run(args[0].(context.Context), args[1].(resource.Resource), variadicArgs...)
+		run(args[0].(context.Context), args[1].(core.BulkActionRequest))
 	})
 	return _c
 }
 
-func (_c *ResourceService_CreateResource_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_CreateResource_Call {
+func (_c *ResourceService_BulkApplyAction_Call) Return(_a0 []core.BulkActionResult, _a1 error) *ResourceService_BulkApplyAction_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceService_CreateResource_Call) RunAndReturn(run func(context.Context, resource.Resource, ...core.Options) (*resource.Resource, error)) *ResourceService_CreateResource_Call {
+func (_c *ResourceService_BulkApplyAction_Call) RunAndReturn(run func(context.Context, core.BulkActionRequest) ([]core.BulkActionResult, error)) *ResourceService_BulkApplyAction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteResource provides a mock function with given fields: ctx, urn
-func (_m *ResourceService) DeleteResource(ctx context.Context, urn string) error {
-	ret := _m.Called(ctx, urn)
+// CancelScheduledAction provides a mock function with given fields: ctx, id
+func (_m *ResourceService) CancelScheduledAction(ctx context.Context, id int64) (*schedule.Schedule, error) {
+	ret := _m.Called(ctx, id)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteResource")
+		panic("no return value specified for CancelScheduledAction")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, urn)
+	var r0 *schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*schedule.Schedule, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *schedule.Schedule); ok {
+		r0 = rf(ctx, id)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schedule.Schedule)
+		}
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ResourceService_DeleteResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteResource'
-type ResourceService_DeleteResource_Call struct {
+// ResourceService_CancelScheduledAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CancelScheduledAction'
+type ResourceService_CancelScheduledAction_Call struct {
 	*mock.Call
 }
 
-// DeleteResource is a helper method to define mock.On call
+// CancelScheduledAction is a helper method to define mock.On call
 //   - ctx context.Context
-//   - urn string
-func (_e *ResourceService_Expecter) DeleteResource(ctx interface{}, urn interface{}) *ResourceService_DeleteResource_Call {
-	return &ResourceService_DeleteResource_Call{Call: _e.mock.On("DeleteResource", ctx, urn)}
+//   - id int64
+func (_e *ResourceService_Expecter) CancelScheduledAction(ctx interface{}, id interface{}) *ResourceService_CancelScheduledAction_Call {
+	return &ResourceService_CancelScheduledAction_Call{Call: _e.mock.On("CancelScheduledAction", ctx, id)}
 }
 
-func (_c *ResourceService_DeleteResource_Call) Run(run func(ctx context.Context, urn string)) *ResourceService_DeleteResource_Call {
+func (_c *ResourceService_CancelScheduledAction_Call) Run(run func(ctx context.Context, id int64)) *ResourceService_CancelScheduledAction_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(int64))
 	})
 	return _c
 }
 
-func (_c *ResourceService_DeleteResource_Call) Return(_a0 error) *ResourceService_DeleteResource_Call {
-	_c.Call.Return(_a0)
+func (_c *ResourceService_CancelScheduledAction_Call) Return(_a0 *schedule.Schedule, _a1 error) *ResourceService_CancelScheduledAction_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceService_DeleteResource_Call) RunAndReturn(run func(context.Context, string) error) *ResourceService_DeleteResource_Call {
+func (_c *ResourceService_CancelScheduledAction_Call) RunAndReturn(run func(context.Context, int64) (*schedule.Schedule, error)) *ResourceService_CancelScheduledAction_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetLog provides a mock function with given fields: ctx, urn, filter
-func (_m *ResourceService) GetLog(ctx context.Context, urn string, filter map[string]string) (<-chan module.LogChunk, error) {
-	ret := _m.Called(ctx, urn, filter)
+// CreateLock provides a mock function with given fields: ctx, l
+func (_m *ResourceService) CreateLock(ctx context.Context, l lock.Lock) (*lock.Lock, error) {
+	ret := _m.Called(ctx, l)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetLog")
+		panic("no return value specified for CreateLock")
 	}
 
-	var r0 <-chan module.LogChunk
+	var r0 *lock.Lock
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string) (<-chan module.LogChunk, error)); ok {
-		return rf(ctx, urn, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, lock.Lock) (*lock.Lock, error)); ok {
+		return rf(ctx, l)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string) <-chan module.LogChunk); ok {
-		r0 = rf(ctx, urn, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, lock.Lock) *lock.Lock); ok {
+		r0 = rf(ctx, l)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(<-chan module.LogChunk)
+			r0 = ret.Get(0).(*lock.Lock)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]string) error); ok {
-		r1 = rf(ctx, urn, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, lock.Lock) error); ok {
+		r1 = rf(ctx, l)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -252,59 +266,58 @@ func (_m *ResourceService) GetLog(ctx context.Context, urn string, filter map[st
 	return r0, r1
 }
 
-// ResourceService_GetLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLog'
-type ResourceService_GetLog_Call struct {
+// ResourceService_CreateLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateLock'
+type ResourceService_CreateLock_Call struct {
 	*mock.Call
 }
 
-// GetLog is a helper method to define mock.On call
+// CreateLock is a helper method to define mock.On call
 //   - ctx context.Context
-//   - urn string
-//   - filter map[string]string
-func (_e *ResourceService_Expecter) GetLog(ctx interface{}, urn interface{}, filter interface{}) *ResourceService_GetLog_Call {
-	return &ResourceService_GetLog_Call{Call: _e.mock.On("GetLog", ctx, urn, filter)}
+//   - l lock.Lock
+func (_e *ResourceService_Expecter) CreateLock(ctx interface{}, l interface{}) *ResourceService_CreateLock_Call {
+	return &ResourceService_CreateLock_Call{Call: _e.mock.On("CreateLock", ctx, l)}
 }
 
-func (_c *ResourceService_GetLog_Call) Run(run func(ctx context.Context, urn string, filter map[string]string)) *ResourceService_GetLog_Call {
+func (_c *ResourceService_CreateLock_Call) Run(run func(ctx context.Context, l lock.Lock)) *ResourceService_CreateLock_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string), args[2].(map[string]string))
+		run(args[0].(context.Context), args[1].(lock.Lock))
 	})
 	return _c
 }
 
-func (_c *ResourceService_GetLog_Call) Return(_a0 <-chan module.LogChunk, _a1 error) *ResourceService_GetLog_Call {
+func (_c *ResourceService_CreateLock_Call) Return(_a0 *lock.Lock, _a1 error) *ResourceService_CreateLock_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceService_GetLog_Call) RunAndReturn(run func(context.Context, string, map[string]string) (<-chan module.LogChunk, error)) *ResourceService_GetLog_Call {
+func (_c *ResourceService_CreateLock_Call) RunAndReturn(run func(context.Context, lock.Lock) (*lock.Lock, error)) *ResourceService_CreateLock_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetResource provides a mock function with given fields: ctx, urn
-func (_m *ResourceService) GetResource(ctx context.Context, urn string) (*resource.Resource, error) {
-	ret := _m.Called(ctx, urn)
+// CreatePolicy provides a mock function with given fields: ctx, p
+func (_m *ResourceService) CreatePolicy(ctx context.Context, p policy.Policy) (*policy.Policy, error) {
+	ret := _m.Called(ctx, p)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetResource")
+		panic("no return value specified for CreatePolicy")
 	}
 
-	var r0 *resource.Resource
+	var r0 *policy.Policy
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (*resource.Resource, error)); ok {
-		return rf(ctx, urn)
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Policy) (*policy.Policy, error)); ok {
+		return rf(ctx, p)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) *resource.Resource); ok {
-		r0 = rf(ctx, urn)
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Policy) *policy.Policy); ok {
+		r0 = rf(ctx, p)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*resource.Resource)
+			r0 = ret.Get(0).(*policy.Policy)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, urn)
+	if rf, ok := ret.Get(1).(func(context.Context, policy.Policy) error); ok {
+		r1 = rf(ctx, p)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -312,58 +325,65 @@ func (_m *ResourceService) GetResource(ctx context.Context, urn string) (*resour
 	return r0, r1
 }
 
-// ResourceService_GetResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResource'
-type ResourceService_GetResource_Call struct {
+// ResourceService_CreatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreatePolicy'
+type ResourceService_CreatePolicy_Call struct {
 	*mock.Call
 }
 
-// GetResource is a helper method to define mock.On call
+// CreatePolicy is a helper method to define mock.On call
 //   - ctx context.Context
-//   - urn string
-func (_e *ResourceService_Expecter) GetResource(ctx interface{}, urn interface{}) *ResourceService_GetResource_Call {
-	return &ResourceService_GetResource_Call{Call: _e.mock.On("GetResource", ctx, urn)}
+//   - p policy.Policy
+func (_e *ResourceService_Expecter) CreatePolicy(ctx interface{}, p interface{}) *ResourceService_CreatePolicy_Call {
+	return &ResourceService_CreatePolicy_Call{Call: _e.mock.On("CreatePolicy", ctx, p)}
 }
 
-func (_c *ResourceService_GetResource_Call) Run(run func(ctx context.Context, urn string)) *ResourceService_GetResource_Call {
+func (_c *ResourceService_CreatePolicy_Call) Run(run func(ctx context.Context, p policy.Policy)) *ResourceService_CreatePolicy_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(policy.Policy))
 	})
 	return _c
 }
 
-func (_c *ResourceService_GetResource_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_GetResource_Call {
+func (_c *ResourceService_CreatePolicy_Call) Return(_a0 *policy.Policy, _a1 error) *ResourceService_CreatePolicy_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceService_GetResource_Call) RunAndReturn(run func(context.Context, string) (*resource.Resource, error)) *ResourceService_GetResource_Call {
+func (_c *ResourceService_CreatePolicy_Call) RunAndReturn(run func(context.Context, policy.Policy) (*policy.Policy, error)) *ResourceService_CreatePolicy_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetRevisions provides a mock function with given fields: ctx, selector
-func (_m *ResourceService) GetRevisions(ctx context.Context, selector resource.RevisionsSelector) ([]resource.Revision, error) {
-	ret := _m.Called(ctx, selector)
+// CreateResource provides a mock function with given fields: ctx, res, resourceOpts
+func (_m *ResourceService) CreateResource(ctx context.Context, res resource.Resource, resourceOpts ...core.Options) (*resource.Resource, error) {
+	_va := make([]interface{}, len(resourceOpts))
+	for _i := range resourceOpts {
+		_va[_i] = resourceOpts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, res)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetRevisions")
+		panic("no return value specified for CreateResource")
 	}
 
-	var r0 []resource.Revision
+	var r0 *resource.Resource
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, resource.RevisionsSelector) ([]resource.Revision, error)); ok {
-		return rf(ctx, selector)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, ...core.Options) (*resource.Resource, error)); ok {
+		return rf(ctx, res, resourceOpts...)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, resource.RevisionsSelector) []resource.Revision); ok {
-		r0 = rf(ctx, selector)
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Resource, ...core.Options) *resource.Resource); ok {
+		r0 = rf(ctx, res, resourceOpts...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]resource.Revision)
+			r0 = ret.Get(0).(*resource.Resource)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, resource.RevisionsSelector) error); ok {
-		r1 = rf(ctx, selector)
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Resource, ...core.Options) error); ok {
+		r1 = rf(ctx, res, resourceOpts...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -371,56 +391,66 @@ func (_m *ResourceService) GetRevisions(ctx context.Context, selector resource.R
 	return r0, r1
 }
 
-// ResourceService_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
-type ResourceService_GetRevisions_Call struct {
+// ResourceService_CreateResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateResource'
+type ResourceService_CreateResource_Call struct {
 	*mock.Call
 }
 
-// GetRevisions is a helper method to define mock.On call
+// CreateResource is a helper method to define mock.On call
 //   - ctx context.Context
-//   - selector resource.RevisionsSelector
-func (_e *ResourceService_Expecter) GetRevisions(ctx interface{}, selector interface{}) *ResourceService_GetRevisions_Call {
-	return &ResourceService_GetRevisions_Call{Call: _e.mock.On("GetRevisions", ctx, selector)}
+//   - res resource.Resource
+//   - resourceOpts ...core.Options
+func (_e *ResourceService_Expecter) CreateResource(ctx interface{}, res interface{}, resourceOpts ...interface{}) *ResourceService_CreateResource_Call {
+	return &ResourceService_CreateResource_Call{Call: _e.mock.On("CreateResource",
+		append([]interface{}{ctx, res}, resourceOpts...)...)}
 }
 
-func (_c *ResourceService_GetRevisions_Call) Run(run func(ctx context.Context, selector resource.RevisionsSelector)) *ResourceService_GetRevisions_Call {
+func (_c *ResourceService_CreateResource_Call) Run(run func(ctx context.Context, res resource.Resource, resourceOpts ...core.Options)) *ResourceService_CreateResource_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(resource.RevisionsSelector))
+		variadicArgs := make([]core.Options, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Options)
+			}
+		}
+		run(args[0].(context.Context), args[1].(resource.Resource), variadicArgs...)
 	})
 	return _c
 }
 
-func (_c *ResourceService_GetRevisions_Call) Return(_a0 []resource.Revision, _a1 error) *ResourceService_GetRevisions_Call {
+func (_c *ResourceService_CreateResource_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_CreateResource_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceService_GetRevisions_Call) RunAndReturn(run func(context.Context, resource.RevisionsSelector) ([]resource.Revision, error)) *ResourceService_GetRevisions_Call {
+func (_c *ResourceService_CreateResource_Call) RunAndReturn(run func(context.Context, resource.Resource, ...core.Options) (*resource.Resource, error)) *ResourceService_CreateResource_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListResources provides a mock function with given fields: ctx, filter, withSpecConfigs
-func (_m *ResourceService) ListResources(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error) {
-	ret := _m.Called(ctx, filter, withSpecConfigs)
+// CreateRoleBinding provides a mock function with given fields: ctx, b
+func (_m *ResourceService) CreateRoleBinding(ctx context.Context, b rbac.Binding) (*rbac.Binding, error) {
+	ret := _m.Called(ctx, b)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListResources")
+		panic("no return value specified for CreateRoleBinding")
 	}
 
-	var r0 resource.PagedResource
+	var r0 *rbac.Binding
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) (resource.PagedResource, error)); ok {
-		return rf(ctx, filter, withSpecConfigs)
+	if rf, ok := ret.Get(0).(func(context.Context, rbac.Binding) (*rbac.Binding, error)); ok {
+		return rf(ctx, b)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) resource.PagedResource); ok {
-		r0 = rf(ctx, filter, withSpecConfigs)
+	if rf, ok := ret.Get(0).(func(context.Context, rbac.Binding) *rbac.Binding); ok {
+		r0 = rf(ctx, b)
 	} else {
-		r0 = ret.Get(0).(resource.PagedResource)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*rbac.Binding)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, resource.Filter, bool) error); ok {
-		r1 = rf(ctx, filter, withSpecConfigs)
+	if rf, ok := ret.Get(1).(func(context.Context, rbac.Binding) error); ok {
+		r1 = rf(ctx, b)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -428,66 +458,58 @@ func (_m *ResourceService) ListResources(ctx context.Context, filter resource.Fi
 	return r0, r1
 }
 
-// ResourceService_ListResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListResources'
-type ResourceService_ListResources_Call struct {
+// ResourceService_CreateRoleBinding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateRoleBinding'
+type ResourceService_CreateRoleBinding_Call struct {
 	*mock.Call
 }
 
-// ListResources is a helper method to define mock.On call
+// CreateRoleBinding is a helper method to define mock.On call
 //   - ctx context.Context
-//   - filter resource.Filter
-//   - withSpecConfigs bool
-func (_e *ResourceService_Expecter) ListResources(ctx interface{}, filter interface{}, withSpecConfigs interface{}) *ResourceService_ListResources_Call {
-	return &ResourceService_ListResources_Call{Call: _e.mock.On("ListResources", ctx, filter, withSpecConfigs)}
+//   - b rbac.Binding
+func (_e *ResourceService_Expecter) CreateRoleBinding(ctx interface{}, b interface{}) *ResourceService_CreateRoleBinding_Call {
+	return &ResourceService_CreateRoleBinding_Call{Call: _e.mock.On("CreateRoleBinding", ctx, b)}
 }
 
-func (_c *ResourceService_ListResources_Call) Run(run func(ctx context.Context, filter resource.Filter, withSpecConfigs bool)) *ResourceService_ListResources_Call {
+func (_c *ResourceService_CreateRoleBinding_Call) Run(run func(ctx context.Context, b rbac.Binding)) *ResourceService_CreateRoleBinding_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(resource.Filter), args[2].(bool))
+		run(args[0].(context.Context), args[1].(rbac.Binding))
 	})
 	return _c
 }
 
-func (_c *ResourceService_ListResources_Call) Return(_a0 resource.PagedResource, _a1 error) *ResourceService_ListResources_Call {
+func (_c *ResourceService_CreateRoleBinding_Call) Return(_a0 *rbac.Binding, _a1 error) *ResourceService_CreateRoleBinding_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceService_ListResources_Call) RunAndReturn(run func(context.Context, resource.Filter, bool) (resource.PagedResource, error)) *ResourceService_ListResources_Call {
+func (_c *ResourceService_CreateRoleBinding_Call) RunAndReturn(run func(context.Context, rbac.Binding) (*rbac.Binding, error)) *ResourceService_CreateRoleBinding_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// UpdateResource provides a mock function with given fields: ctx, urn, req, resourceOpts
-func (_m *ResourceService) UpdateResource(ctx context.Context, urn string, req resource.UpdateRequest, resourceOpts ...core.Options) (*resource.Resource, error) {
-	_va := make([]interface{}, len(resourceOpts))
-	for _i := range resourceOpts {
-		_va[_i] = resourceOpts[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, ctx, urn, req)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// CreateWebhook provides a mock function with given fields: ctx, sub
+func (_m *ResourceService) CreateWebhook(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	ret := _m.Called(ctx, sub)
 
 	if len(ret) == 0 {
-		panic("no return value specified for UpdateResource")
+		panic("no return value specified for CreateWebhook")
 	}
 
-	var r0 *resource.Resource
+	var r0 *webhook.Subscription
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, resource.UpdateRequest, ...core.Options) (*resource.Resource, error)); ok {
-		return rf(ctx, urn, req, resourceOpts...)
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Subscription) (*webhook.Subscription, error)); ok {
+		return rf(ctx, sub)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, resource.UpdateRequest, ...core.Options) *resource.Resource); ok {
-		r0 = rf(ctx, urn, req, resourceOpts...)
+	if rf, ok := ret.Get(0).(func(context.Context, webhook.Subscription) *webhook.Subscription); ok {
+		r0 = rf(ctx, sub)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*resource.Resource)
+			r0 = ret.Get(0).(*webhook.Subscription)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, resource.UpdateRequest, ...core.Options) error); ok {
-		r1 = rf(ctx, urn, req, resourceOpts...)
+	if rf, ok := ret.Get(1).(func(context.Context, webhook.Subscription) error); ok {
+		r1 = rf(ctx, sub)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -495,40 +517,1695 @@ func (_m *ResourceService) UpdateResource(ctx context.Context, urn string, req r
 	return r0, r1
 }
 
-// ResourceService_UpdateResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateResource'
-type ResourceService_UpdateResource_Call struct {
+// ResourceService_CreateWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateWebhook'
+type ResourceService_CreateWebhook_Call struct {
 	*mock.Call
 }
 
-// UpdateResource is a helper method to define mock.On call
+// CreateWebhook is a helper method to define mock.On call
 //   - ctx context.Context
-//   - urn string
-//   - req resource.UpdateRequest
-//   - resourceOpts ...core.Options
-func (_e *ResourceService_Expecter) UpdateResource(ctx interface{}, urn interface{}, req interface{}, resourceOpts ...interface{}) *ResourceService_UpdateResource_Call {
-	return &ResourceService_UpdateResource_Call{Call: _e.mock.On("UpdateResource",
-		append([]interface{}{ctx, urn, req}, resourceOpts...)...)}
+//   - sub webhook.Subscription
+func (_e *ResourceService_Expecter) CreateWebhook(ctx interface{}, sub interface{}) *ResourceService_CreateWebhook_Call {
+	return &ResourceService_CreateWebhook_Call{Call: _e.mock.On("CreateWebhook", ctx, sub)}
 }
 
-func (_c *ResourceService_UpdateResource_Call) Run(run func(ctx context.Context, urn string, req resource.UpdateRequest, resourceOpts ...core.Options)) *ResourceService_UpdateResource_Call {
+func (_c *ResourceService_CreateWebhook_Call) Run(run func(ctx context.Context, sub webhook.Subscription)) *ResourceService_CreateWebhook_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		variadicArgs := make([]core.Options, len(args)-3)
-		for i, a := range args[3:] {
-			if a != nil {
-				variadicArgs[i] = a.(core.Options)
-			}
-		}
-		run(args[0].(context.Context), args[1].(string), args[2].(resource.UpdateRequest), variadicArgs...)
+		run(args[0].(context.Context), args[1].(webhook.Subscription))
 	})
 	return _c
 }
 
-func (_c *ResourceService_UpdateResource_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_UpdateResource_Call {
+func (_c *ResourceService_CreateWebhook_Call) Return(_a0 *webhook.Subscription, _a1 error) *ResourceService_CreateWebhook_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *ResourceService_UpdateResource_Call) RunAndReturn(run func(context.Context, string, resource.UpdateRequest, ...core.Options) (*resource.Resource, error)) *ResourceService_UpdateResource_Call {
+func (_c *ResourceService_CreateWebhook_Call) RunAndReturn(run func(context.Context, webhook.Subscription) (*webhook.Subscription, error)) *ResourceService_CreateWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteLock provides a mock function with given fields: ctx, id, userID
+func (_m *ResourceService) DeleteLock(ctx context.Context, id int64, userID string) error {
+	ret := _m.Called(ctx, id, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteLock")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, string) error); ok {
+		r0 = rf(ctx, id, userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResourceService_DeleteLock_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteLock'
+type ResourceService_DeleteLock_Call struct {
+	*mock.Call
+}
+
+// DeleteLock is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+//   - userID string
+func (_e *ResourceService_Expecter) DeleteLock(ctx interface{}, id interface{}, userID interface{}) *ResourceService_DeleteLock_Call {
+	return &ResourceService_DeleteLock_Call{Call: _e.mock.On("DeleteLock", ctx, id, userID)}
+}
+
+func (_c *ResourceService_DeleteLock_Call) Run(run func(ctx context.Context, id int64, userID string)) *ResourceService_DeleteLock_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_DeleteLock_Call) Return(_a0 error) *ResourceService_DeleteLock_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResourceService_DeleteLock_Call) RunAndReturn(run func(context.Context, int64, string) error) *ResourceService_DeleteLock_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeletePolicy provides a mock function with given fields: ctx, id
+func (_m *ResourceService) DeletePolicy(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeletePolicy")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResourceService_DeletePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeletePolicy'
+type ResourceService_DeletePolicy_Call struct {
+	*mock.Call
+}
+
+// DeletePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ResourceService_Expecter) DeletePolicy(ctx interface{}, id interface{}) *ResourceService_DeletePolicy_Call {
+	return &ResourceService_DeletePolicy_Call{Call: _e.mock.On("DeletePolicy", ctx, id)}
+}
+
+func (_c *ResourceService_DeletePolicy_Call) Run(run func(ctx context.Context, id int64)) *ResourceService_DeletePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ResourceService_DeletePolicy_Call) Return(_a0 error) *ResourceService_DeletePolicy_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResourceService_DeletePolicy_Call) RunAndReturn(run func(context.Context, int64) error) *ResourceService_DeletePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteResource provides a mock function with given fields: ctx, urn, resourceOpts
+func (_m *ResourceService) DeleteResource(ctx context.Context, urn string, resourceOpts ...core.Options) error {
+	_va := make([]interface{}, len(resourceOpts))
+	for _i := range resourceOpts {
+		_va[_i] = resourceOpts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, urn)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteResource")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, ...core.Options) error); ok {
+		r0 = rf(ctx, urn, resourceOpts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResourceService_DeleteResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteResource'
+type ResourceService_DeleteResource_Call struct {
+	*mock.Call
+}
+
+// DeleteResource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - resourceOpts ...core.Options
+func (_e *ResourceService_Expecter) DeleteResource(ctx interface{}, urn interface{}, resourceOpts ...interface{}) *ResourceService_DeleteResource_Call {
+	return &ResourceService_DeleteResource_Call{Call: _e.mock.On("DeleteResource",
+		append([]interface{}{ctx, urn}, resourceOpts...)...)}
+}
+
+func (_c *ResourceService_DeleteResource_Call) Run(run func(ctx context.Context, urn string, resourceOpts ...core.Options)) *ResourceService_DeleteResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Options, len(args)-2)
+		for i, a := range args[2:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Options)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ResourceService_DeleteResource_Call) Return(_a0 error) *ResourceService_DeleteResource_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResourceService_DeleteResource_Call) RunAndReturn(run func(context.Context, string, ...core.Options) error) *ResourceService_DeleteResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteRoleBinding provides a mock function with given fields: ctx, id
+func (_m *ResourceService) DeleteRoleBinding(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRoleBinding")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResourceService_DeleteRoleBinding_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteRoleBinding'
+type ResourceService_DeleteRoleBinding_Call struct {
+	*mock.Call
+}
+
+// DeleteRoleBinding is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ResourceService_Expecter) DeleteRoleBinding(ctx interface{}, id interface{}) *ResourceService_DeleteRoleBinding_Call {
+	return &ResourceService_DeleteRoleBinding_Call{Call: _e.mock.On("DeleteRoleBinding", ctx, id)}
+}
+
+func (_c *ResourceService_DeleteRoleBinding_Call) Run(run func(ctx context.Context, id int64)) *ResourceService_DeleteRoleBinding_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ResourceService_DeleteRoleBinding_Call) Return(_a0 error) *ResourceService_DeleteRoleBinding_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResourceService_DeleteRoleBinding_Call) RunAndReturn(run func(context.Context, int64) error) *ResourceService_DeleteRoleBinding_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteWebhook provides a mock function with given fields: ctx, id
+func (_m *ResourceService) DeleteWebhook(ctx context.Context, id int64) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteWebhook")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResourceService_DeleteWebhook_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteWebhook'
+type ResourceService_DeleteWebhook_Call struct {
+	*mock.Call
+}
+
+// DeleteWebhook is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ResourceService_Expecter) DeleteWebhook(ctx interface{}, id interface{}) *ResourceService_DeleteWebhook_Call {
+	return &ResourceService_DeleteWebhook_Call{Call: _e.mock.On("DeleteWebhook", ctx, id)}
+}
+
+func (_c *ResourceService_DeleteWebhook_Call) Run(run func(ctx context.Context, id int64)) *ResourceService_DeleteWebhook_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ResourceService_DeleteWebhook_Call) Return(_a0 error) *ResourceService_DeleteWebhook_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ResourceService_DeleteWebhook_Call) RunAndReturn(run func(context.Context, int64) error) *ResourceService_DeleteWebhook_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DiffRevisions provides a mock function with given fields: ctx, urn, fromID, toID
+func (_m *ResourceService) DiffRevisions(ctx context.Context, urn string, fromID int64, toID int64) (*resource.SpecDiff, error) {
+	ret := _m.Called(ctx, urn, fromID, toID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DiffRevisions")
+	}
+
+	var r0 *resource.SpecDiff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) (*resource.SpecDiff, error)); ok {
+		return rf(ctx, urn, fromID, toID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, int64) *resource.SpecDiff); ok {
+		r0 = rf(ctx, urn, fromID, toID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resource.SpecDiff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, int64) error); ok {
+		r1 = rf(ctx, urn, fromID, toID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_DiffRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DiffRevisions'
+type ResourceService_DiffRevisions_Call struct {
+	*mock.Call
+}
+
+// DiffRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - fromID int64
+//   - toID int64
+func (_e *ResourceService_Expecter) DiffRevisions(ctx interface{}, urn interface{}, fromID interface{}, toID interface{}) *ResourceService_DiffRevisions_Call {
+	return &ResourceService_DiffRevisions_Call{Call: _e.mock.On("DiffRevisions", ctx, urn, fromID, toID)}
+}
+
+func (_c *ResourceService_DiffRevisions_Call) Run(run func(ctx context.Context, urn string, fromID int64, toID int64)) *ResourceService_DiffRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(int64))
+	})
+	return _c
+}
+
+func (_c *ResourceService_DiffRevisions_Call) Return(_a0 *resource.SpecDiff, _a1 error) *ResourceService_DiffRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_DiffRevisions_Call) RunAndReturn(run func(context.Context, string, int64, int64) (*resource.SpecDiff, error)) *ResourceService_DiffRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependents provides a mock function with given fields: ctx, urn, transitive
+func (_m *ResourceService) GetDependents(ctx context.Context, urn string, transitive bool) ([]resource.Resource, error) {
+	ret := _m.Called(ctx, urn, transitive)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependents")
+	}
+
+	var r0 []resource.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]resource.Resource, error)); ok {
+		return rf(ctx, urn, transitive)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []resource.Resource); ok {
+		r0 = rf(ctx, urn, transitive)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, urn, transitive)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetDependents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependents'
+type ResourceService_GetDependents_Call struct {
+	*mock.Call
+}
+
+// GetDependents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - transitive bool
+func (_e *ResourceService_Expecter) GetDependents(ctx interface{}, urn interface{}, transitive interface{}) *ResourceService_GetDependents_Call {
+	return &ResourceService_GetDependents_Call{Call: _e.mock.On("GetDependents", ctx, urn, transitive)}
+}
+
+func (_c *ResourceService_GetDependents_Call) Run(run func(ctx context.Context, urn string, transitive bool)) *ResourceService_GetDependents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetDependents_Call) Return(_a0 []resource.Resource, _a1 error) *ResourceService_GetDependents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetDependents_Call) RunAndReturn(run func(context.Context, string, bool) ([]resource.Resource, error)) *ResourceService_GetDependents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLog provides a mock function with given fields: ctx, urn, filter
+func (_m *ResourceService) GetLog(ctx context.Context, urn string, filter map[string]string) (<-chan module.LogChunk, error) {
+	ret := _m.Called(ctx, urn, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLog")
+	}
+
+	var r0 <-chan module.LogChunk
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string) (<-chan module.LogChunk, error)); ok {
+		return rf(ctx, urn, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, map[string]string) <-chan module.LogChunk); ok {
+		r0 = rf(ctx, urn, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan module.LogChunk)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, map[string]string) error); ok {
+		r1 = rf(ctx, urn, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetLog_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLog'
+type ResourceService_GetLog_Call struct {
+	*mock.Call
+}
+
+// GetLog is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - filter map[string]string
+func (_e *ResourceService_Expecter) GetLog(ctx interface{}, urn interface{}, filter interface{}) *ResourceService_GetLog_Call {
+	return &ResourceService_GetLog_Call{Call: _e.mock.On("GetLog", ctx, urn, filter)}
+}
+
+func (_c *ResourceService_GetLog_Call) Run(run func(ctx context.Context, urn string, filter map[string]string)) *ResourceService_GetLog_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(map[string]string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetLog_Call) Return(_a0 <-chan module.LogChunk, _a1 error) *ResourceService_GetLog_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetLog_Call) RunAndReturn(run func(context.Context, string, map[string]string) (<-chan module.LogChunk, error)) *ResourceService_GetLog_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetOperation provides a mock function with given fields: ctx, id
+func (_m *ResourceService) GetOperation(ctx context.Context, id int64) (*operation.Operation, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetOperation")
+	}
+
+	var r0 *operation.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*operation.Operation, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *operation.Operation); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*operation.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetOperation_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetOperation'
+type ResourceService_GetOperation_Call struct {
+	*mock.Call
+}
+
+// GetOperation is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ResourceService_Expecter) GetOperation(ctx interface{}, id interface{}) *ResourceService_GetOperation_Call {
+	return &ResourceService_GetOperation_Call{Call: _e.mock.On("GetOperation", ctx, id)}
+}
+
+func (_c *ResourceService_GetOperation_Call) Run(run func(ctx context.Context, id int64)) *ResourceService_GetOperation_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetOperation_Call) Return(_a0 *operation.Operation, _a1 error) *ResourceService_GetOperation_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetOperation_Call) RunAndReturn(run func(context.Context, int64) (*operation.Operation, error)) *ResourceService_GetOperation_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetPolicy provides a mock function with given fields: ctx, id
+func (_m *ResourceService) GetPolicy(ctx context.Context, id int64) (*policy.Policy, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetPolicy")
+	}
+
+	var r0 *policy.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64) (*policy.Policy, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int64) *policy.Policy); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*policy.Policy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int64) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetPolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetPolicy'
+type ResourceService_GetPolicy_Call struct {
+	*mock.Call
+}
+
+// GetPolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id int64
+func (_e *ResourceService_Expecter) GetPolicy(ctx interface{}, id interface{}) *ResourceService_GetPolicy_Call {
+	return &ResourceService_GetPolicy_Call{Call: _e.mock.On("GetPolicy", ctx, id)}
+}
+
+func (_c *ResourceService_GetPolicy_Call) Run(run func(ctx context.Context, id int64)) *ResourceService_GetPolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(int64))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetPolicy_Call) Return(_a0 *policy.Policy, _a1 error) *ResourceService_GetPolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetPolicy_Call) RunAndReturn(run func(context.Context, int64) (*policy.Policy, error)) *ResourceService_GetPolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetProjectQuota provides a mock function with given fields: ctx, project
+func (_m *ResourceService) GetProjectQuota(ctx context.Context, project string) (*quota.Report, error) {
+	ret := _m.Called(ctx, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetProjectQuota")
+	}
+
+	var r0 *quota.Report
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*quota.Report, error)); ok {
+		return rf(ctx, project)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *quota.Report); ok {
+		r0 = rf(ctx, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*quota.Report)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetProjectQuota_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetProjectQuota'
+type ResourceService_GetProjectQuota_Call struct {
+	*mock.Call
+}
+
+// GetProjectQuota is a helper method to define mock.On call
+//   - ctx context.Context
+//   - project string
+func (_e *ResourceService_Expecter) GetProjectQuota(ctx interface{}, project interface{}) *ResourceService_GetProjectQuota_Call {
+	return &ResourceService_GetProjectQuota_Call{Call: _e.mock.On("GetProjectQuota", ctx, project)}
+}
+
+func (_c *ResourceService_GetProjectQuota_Call) Run(run func(ctx context.Context, project string)) *ResourceService_GetProjectQuota_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetProjectQuota_Call) Return(_a0 *quota.Report, _a1 error) *ResourceService_GetProjectQuota_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetProjectQuota_Call) RunAndReturn(run func(context.Context, string) (*quota.Report, error)) *ResourceService_GetProjectQuota_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetResource provides a mock function with given fields: ctx, urn
+func (_m *ResourceService) GetResource(ctx context.Context, urn string) (*resource.Resource, error) {
+	ret := _m.Called(ctx, urn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetResource")
+	}
+
+	var r0 *resource.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*resource.Resource, error)); ok {
+		return rf(ctx, urn)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *resource.Resource); ok {
+		r0 = rf(ctx, urn)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resource.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, urn)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetResource'
+type ResourceService_GetResource_Call struct {
+	*mock.Call
+}
+
+// GetResource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+func (_e *ResourceService_Expecter) GetResource(ctx interface{}, urn interface{}) *ResourceService_GetResource_Call {
+	return &ResourceService_GetResource_Call{Call: _e.mock.On("GetResource", ctx, urn)}
+}
+
+func (_c *ResourceService_GetResource_Call) Run(run func(ctx context.Context, urn string)) *ResourceService_GetResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetResource_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_GetResource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetResource_Call) RunAndReturn(run func(context.Context, string) (*resource.Resource, error)) *ResourceService_GetResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetRevisions provides a mock function with given fields: ctx, selector
+func (_m *ResourceService) GetRevisions(ctx context.Context, selector resource.RevisionsSelector) ([]resource.Revision, error) {
+	ret := _m.Called(ctx, selector)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetRevisions")
+	}
+
+	var r0 []resource.Revision
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.RevisionsSelector) ([]resource.Revision, error)); ok {
+		return rf(ctx, selector)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.RevisionsSelector) []resource.Revision); ok {
+		r0 = rf(ctx, selector)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.Revision)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.RevisionsSelector) error); ok {
+		r1 = rf(ctx, selector)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetRevisions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetRevisions'
+type ResourceService_GetRevisions_Call struct {
+	*mock.Call
+}
+
+// GetRevisions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - selector resource.RevisionsSelector
+func (_e *ResourceService_Expecter) GetRevisions(ctx interface{}, selector interface{}) *ResourceService_GetRevisions_Call {
+	return &ResourceService_GetRevisions_Call{Call: _e.mock.On("GetRevisions", ctx, selector)}
+}
+
+func (_c *ResourceService_GetRevisions_Call) Run(run func(ctx context.Context, selector resource.RevisionsSelector)) *ResourceService_GetRevisions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.RevisionsSelector))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetRevisions_Call) Return(_a0 []resource.Revision, _a1 error) *ResourceService_GetRevisions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetRevisions_Call) RunAndReturn(run func(context.Context, resource.RevisionsSelector) ([]resource.Revision, error)) *ResourceService_GetRevisions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetSyncHistory provides a mock function with given fields: ctx, urn, limit
+func (_m *ResourceService) GetSyncHistory(ctx context.Context, urn string, limit int) ([]resource.SyncRun, error) {
+	ret := _m.Called(ctx, urn, limit)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetSyncHistory")
+	}
+
+	var r0 []resource.SyncRun
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) ([]resource.SyncRun, error)); ok {
+		return rf(ctx, urn, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) []resource.SyncRun); ok {
+		r0 = rf(ctx, urn, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]resource.SyncRun)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, urn, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_GetSyncHistory_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetSyncHistory'
+type ResourceService_GetSyncHistory_Call struct {
+	*mock.Call
+}
+
+// GetSyncHistory is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - limit int
+func (_e *ResourceService_Expecter) GetSyncHistory(ctx interface{}, urn interface{}, limit interface{}) *ResourceService_GetSyncHistory_Call {
+	return &ResourceService_GetSyncHistory_Call{Call: _e.mock.On("GetSyncHistory", ctx, urn, limit)}
+}
+
+func (_c *ResourceService_GetSyncHistory_Call) Run(run func(ctx context.Context, urn string, limit int)) *ResourceService_GetSyncHistory_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(int))
+	})
+	return _c
+}
+
+func (_c *ResourceService_GetSyncHistory_Call) Return(_a0 []resource.SyncRun, _a1 error) *ResourceService_GetSyncHistory_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_GetSyncHistory_Call) RunAndReturn(run func(context.Context, string, int) ([]resource.SyncRun, error)) *ResourceService_GetSyncHistory_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListAuditEvents provides a mock function with given fields: ctx, filter
+func (_m *ResourceService) ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListAuditEvents")
+	}
+
+	var r0 []audit.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) ([]audit.Event, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, audit.Filter) []audit.Event); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]audit.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, audit.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListAuditEvents_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListAuditEvents'
+type ResourceService_ListAuditEvents_Call struct {
+	*mock.Call
+}
+
+// ListAuditEvents is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter audit.Filter
+func (_e *ResourceService_Expecter) ListAuditEvents(ctx interface{}, filter interface{}) *ResourceService_ListAuditEvents_Call {
+	return &ResourceService_ListAuditEvents_Call{Call: _e.mock.On("ListAuditEvents", ctx, filter)}
+}
+
+func (_c *ResourceService_ListAuditEvents_Call) Run(run func(ctx context.Context, filter audit.Filter)) *ResourceService_ListAuditEvents_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(audit.Filter))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListAuditEvents_Call) Return(_a0 []audit.Event, _a1 error) *ResourceService_ListAuditEvents_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListAuditEvents_Call) RunAndReturn(run func(context.Context, audit.Filter) ([]audit.Event, error)) *ResourceService_ListAuditEvents_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListLocks provides a mock function with given fields: ctx, project, includeExpired
+func (_m *ResourceService) ListLocks(ctx context.Context, project string, includeExpired bool) ([]lock.Lock, error) {
+	ret := _m.Called(ctx, project, includeExpired)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListLocks")
+	}
+
+	var r0 []lock.Lock
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) ([]lock.Lock, error)); ok {
+		return rf(ctx, project, includeExpired)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, bool) []lock.Lock); ok {
+		r0 = rf(ctx, project, includeExpired)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lock.Lock)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, bool) error); ok {
+		r1 = rf(ctx, project, includeExpired)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListLocks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListLocks'
+type ResourceService_ListLocks_Call struct {
+	*mock.Call
+}
+
+// ListLocks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - project string
+//   - includeExpired bool
+func (_e *ResourceService_Expecter) ListLocks(ctx interface{}, project interface{}, includeExpired interface{}) *ResourceService_ListLocks_Call {
+	return &ResourceService_ListLocks_Call{Call: _e.mock.On("ListLocks", ctx, project, includeExpired)}
+}
+
+func (_c *ResourceService_ListLocks_Call) Run(run func(ctx context.Context, project string, includeExpired bool)) *ResourceService_ListLocks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListLocks_Call) Return(_a0 []lock.Lock, _a1 error) *ResourceService_ListLocks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListLocks_Call) RunAndReturn(run func(context.Context, string, bool) ([]lock.Lock, error)) *ResourceService_ListLocks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListOperations provides a mock function with given fields: ctx, filter
+func (_m *ResourceService) ListOperations(ctx context.Context, filter operation.Filter) ([]operation.Operation, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListOperations")
+	}
+
+	var r0 []operation.Operation
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, operation.Filter) ([]operation.Operation, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, operation.Filter) []operation.Operation); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]operation.Operation)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, operation.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListOperations_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListOperations'
+type ResourceService_ListOperations_Call struct {
+	*mock.Call
+}
+
+// ListOperations is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter operation.Filter
+func (_e *ResourceService_Expecter) ListOperations(ctx interface{}, filter interface{}) *ResourceService_ListOperations_Call {
+	return &ResourceService_ListOperations_Call{Call: _e.mock.On("ListOperations", ctx, filter)}
+}
+
+func (_c *ResourceService_ListOperations_Call) Run(run func(ctx context.Context, filter operation.Filter)) *ResourceService_ListOperations_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(operation.Filter))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListOperations_Call) Return(_a0 []operation.Operation, _a1 error) *ResourceService_ListOperations_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListOperations_Call) RunAndReturn(run func(context.Context, operation.Filter) ([]operation.Operation, error)) *ResourceService_ListOperations_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListPolicies provides a mock function with given fields: ctx, filter
+func (_m *ResourceService) ListPolicies(ctx context.Context, filter policy.Filter) ([]policy.Policy, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListPolicies")
+	}
+
+	var r0 []policy.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Filter) ([]policy.Policy, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Filter) []policy.Policy); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]policy.Policy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, policy.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListPolicies_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListPolicies'
+type ResourceService_ListPolicies_Call struct {
+	*mock.Call
+}
+
+// ListPolicies is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter policy.Filter
+func (_e *ResourceService_Expecter) ListPolicies(ctx interface{}, filter interface{}) *ResourceService_ListPolicies_Call {
+	return &ResourceService_ListPolicies_Call{Call: _e.mock.On("ListPolicies", ctx, filter)}
+}
+
+func (_c *ResourceService_ListPolicies_Call) Run(run func(ctx context.Context, filter policy.Filter)) *ResourceService_ListPolicies_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(policy.Filter))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListPolicies_Call) Return(_a0 []policy.Policy, _a1 error) *ResourceService_ListPolicies_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListPolicies_Call) RunAndReturn(run func(context.Context, policy.Filter) ([]policy.Policy, error)) *ResourceService_ListPolicies_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListResources provides a mock function with given fields: ctx, filter, withSpecConfigs
+func (_m *ResourceService) ListResources(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error) {
+	ret := _m.Called(ctx, filter, withSpecConfigs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListResources")
+	}
+
+	var r0 resource.PagedResource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) (resource.PagedResource, error)); ok {
+		return rf(ctx, filter, withSpecConfigs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.Filter, bool) resource.PagedResource); ok {
+		r0 = rf(ctx, filter, withSpecConfigs)
+	} else {
+		r0 = ret.Get(0).(resource.PagedResource)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.Filter, bool) error); ok {
+		r1 = rf(ctx, filter, withSpecConfigs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListResources'
+type ResourceService_ListResources_Call struct {
+	*mock.Call
+}
+
+// ListResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter resource.Filter
+//   - withSpecConfigs bool
+func (_e *ResourceService_Expecter) ListResources(ctx interface{}, filter interface{}, withSpecConfigs interface{}) *ResourceService_ListResources_Call {
+	return &ResourceService_ListResources_Call{Call: _e.mock.On("ListResources", ctx, filter, withSpecConfigs)}
+}
+
+func (_c *ResourceService_ListResources_Call) Run(run func(ctx context.Context, filter resource.Filter, withSpecConfigs bool)) *ResourceService_ListResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.Filter), args[2].(bool))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListResources_Call) Return(_a0 resource.PagedResource, _a1 error) *ResourceService_ListResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListResources_Call) RunAndReturn(run func(context.Context, resource.Filter, bool) (resource.PagedResource, error)) *ResourceService_ListResources_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListRoleBindings provides a mock function with given fields: ctx, project
+func (_m *ResourceService) ListRoleBindings(ctx context.Context, project string) ([]rbac.Binding, error) {
+	ret := _m.Called(ctx, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListRoleBindings")
+	}
+
+	var r0 []rbac.Binding
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]rbac.Binding, error)); ok {
+		return rf(ctx, project)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []rbac.Binding); ok {
+		r0 = rf(ctx, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]rbac.Binding)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListRoleBindings_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListRoleBindings'
+type ResourceService_ListRoleBindings_Call struct {
+	*mock.Call
+}
+
+// ListRoleBindings is a helper method to define mock.On call
+//   - ctx context.Context
+//   - project string
+func (_e *ResourceService_Expecter) ListRoleBindings(ctx interface{}, project interface{}) *ResourceService_ListRoleBindings_Call {
+	return &ResourceService_ListRoleBindings_Call{Call: _e.mock.On("ListRoleBindings", ctx, project)}
+}
+
+func (_c *ResourceService_ListRoleBindings_Call) Run(run func(ctx context.Context, project string)) *ResourceService_ListRoleBindings_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListRoleBindings_Call) Return(_a0 []rbac.Binding, _a1 error) *ResourceService_ListRoleBindings_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListRoleBindings_Call) RunAndReturn(run func(context.Context, string) ([]rbac.Binding, error)) *ResourceService_ListRoleBindings_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListScheduledActions provides a mock function with given fields: ctx, filter
+func (_m *ResourceService) ListScheduledActions(ctx context.Context, filter schedule.Filter) ([]schedule.Schedule, error) {
+	ret := _m.Called(ctx, filter)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListScheduledActions")
+	}
+
+	var r0 []schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Filter) ([]schedule.Schedule, error)); ok {
+		return rf(ctx, filter)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Filter) []schedule.Schedule); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]schedule.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.Filter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListScheduledActions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListScheduledActions'
+type ResourceService_ListScheduledActions_Call struct {
+	*mock.Call
+}
+
+// ListScheduledActions is a helper method to define mock.On call
+//   - ctx context.Context
+//   - filter schedule.Filter
+func (_e *ResourceService_Expecter) ListScheduledActions(ctx interface{}, filter interface{}) *ResourceService_ListScheduledActions_Call {
+	return &ResourceService_ListScheduledActions_Call{Call: _e.mock.On("ListScheduledActions", ctx, filter)}
+}
+
+func (_c *ResourceService_ListScheduledActions_Call) Run(run func(ctx context.Context, filter schedule.Filter)) *ResourceService_ListScheduledActions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.Filter))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListScheduledActions_Call) Return(_a0 []schedule.Schedule, _a1 error) *ResourceService_ListScheduledActions_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListScheduledActions_Call) RunAndReturn(run func(context.Context, schedule.Filter) ([]schedule.Schedule, error)) *ResourceService_ListScheduledActions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListWebhooks provides a mock function with given fields: ctx, project
+func (_m *ResourceService) ListWebhooks(ctx context.Context, project string) ([]webhook.Subscription, error) {
+	ret := _m.Called(ctx, project)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListWebhooks")
+	}
+
+	var r0 []webhook.Subscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]webhook.Subscription, error)); ok {
+		return rf(ctx, project)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []webhook.Subscription); ok {
+		r0 = rf(ctx, project)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Subscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, project)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ListWebhooks_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListWebhooks'
+type ResourceService_ListWebhooks_Call struct {
+	*mock.Call
+}
+
+// ListWebhooks is a helper method to define mock.On call
+//   - ctx context.Context
+//   - project string
+func (_e *ResourceService_Expecter) ListWebhooks(ctx interface{}, project interface{}) *ResourceService_ListWebhooks_Call {
+	return &ResourceService_ListWebhooks_Call{Call: _e.mock.On("ListWebhooks", ctx, project)}
+}
+
+func (_c *ResourceService_ListWebhooks_Call) Run(run func(ctx context.Context, project string)) *ResourceService_ListWebhooks_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ListWebhooks_Call) Return(_a0 []webhook.Subscription, _a1 error) *ResourceService_ListWebhooks_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ListWebhooks_Call) RunAndReturn(run func(context.Context, string) ([]webhook.Subscription, error)) *ResourceService_ListWebhooks_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RetrySync provides a mock function with given fields: ctx, urn, fromStep, userID, resourceOpts
+func (_m *ResourceService) RetrySync(ctx context.Context, urn string, fromStep string, userID string, resourceOpts ...core.Options) (*resource.Resource, error) {
+	_va := make([]interface{}, len(resourceOpts))
+	for _i := range resourceOpts {
+		_va[_i] = resourceOpts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, urn, fromStep, userID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RetrySync")
+	}
+
+	var r0 *resource.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...core.Options) (*resource.Resource, error)); ok {
+		return rf(ctx, urn, fromStep, userID, resourceOpts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, ...core.Options) *resource.Resource); ok {
+		r0 = rf(ctx, urn, fromStep, userID, resourceOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resource.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, ...core.Options) error); ok {
+		r1 = rf(ctx, urn, fromStep, userID, resourceOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_RetrySync_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RetrySync'
+type ResourceService_RetrySync_Call struct {
+	*mock.Call
+}
+
+// RetrySync is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - fromStep string
+//   - userID string
+//   - resourceOpts ...core.Options
+func (_e *ResourceService_Expecter) RetrySync(ctx interface{}, urn interface{}, fromStep interface{}, userID interface{}, resourceOpts ...interface{}) *ResourceService_RetrySync_Call {
+	return &ResourceService_RetrySync_Call{Call: _e.mock.On("RetrySync",
+		append([]interface{}{ctx, urn, fromStep, userID}, resourceOpts...)...)}
+}
+
+func (_c *ResourceService_RetrySync_Call) Run(run func(ctx context.Context, urn string, fromStep string, userID string, resourceOpts ...core.Options)) *ResourceService_RetrySync_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Options, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Options)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ResourceService_RetrySync_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_RetrySync_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_RetrySync_Call) RunAndReturn(run func(context.Context, string, string, string, ...core.Options) (*resource.Resource, error)) *ResourceService_RetrySync_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RollbackResource provides a mock function with given fields: ctx, urn, revisionID, userID, resourceOpts
+func (_m *ResourceService) RollbackResource(ctx context.Context, urn string, revisionID int64, userID string, resourceOpts ...core.Options) (*resource.Resource, error) {
+	_va := make([]interface{}, len(resourceOpts))
+	for _i := range resourceOpts {
+		_va[_i] = resourceOpts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, urn, revisionID, userID)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RollbackResource")
+	}
+
+	var r0 *resource.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, ...core.Options) (*resource.Resource, error)); ok {
+		return rf(ctx, urn, revisionID, userID, resourceOpts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int64, string, ...core.Options) *resource.Resource); ok {
+		r0 = rf(ctx, urn, revisionID, userID, resourceOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resource.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int64, string, ...core.Options) error); ok {
+		r1 = rf(ctx, urn, revisionID, userID, resourceOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_RollbackResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RollbackResource'
+type ResourceService_RollbackResource_Call struct {
+	*mock.Call
+}
+
+// RollbackResource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - revisionID int64
+//   - userID string
+//   - resourceOpts ...core.Options
+func (_e *ResourceService_Expecter) RollbackResource(ctx interface{}, urn interface{}, revisionID interface{}, userID interface{}, resourceOpts ...interface{}) *ResourceService_RollbackResource_Call {
+	return &ResourceService_RollbackResource_Call{Call: _e.mock.On("RollbackResource",
+		append([]interface{}{ctx, urn, revisionID, userID}, resourceOpts...)...)}
+}
+
+func (_c *ResourceService_RollbackResource_Call) Run(run func(ctx context.Context, urn string, revisionID int64, userID string, resourceOpts ...core.Options)) *ResourceService_RollbackResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Options, len(args)-4)
+		for i, a := range args[4:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Options)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(int64), args[3].(string), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ResourceService_RollbackResource_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_RollbackResource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_RollbackResource_Call) RunAndReturn(run func(context.Context, string, int64, string, ...core.Options) (*resource.Resource, error)) *ResourceService_RollbackResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ScheduleAction provides a mock function with given fields: ctx, s
+func (_m *ResourceService) ScheduleAction(ctx context.Context, s schedule.Schedule) (*schedule.Schedule, error) {
+	ret := _m.Called(ctx, s)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ScheduleAction")
+	}
+
+	var r0 *schedule.Schedule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Schedule) (*schedule.Schedule, error)); ok {
+		return rf(ctx, s)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, schedule.Schedule) *schedule.Schedule); ok {
+		r0 = rf(ctx, s)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*schedule.Schedule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, schedule.Schedule) error); ok {
+		r1 = rf(ctx, s)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_ScheduleAction_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ScheduleAction'
+type ResourceService_ScheduleAction_Call struct {
+	*mock.Call
+}
+
+// ScheduleAction is a helper method to define mock.On call
+//   - ctx context.Context
+//   - s schedule.Schedule
+func (_e *ResourceService_Expecter) ScheduleAction(ctx interface{}, s interface{}) *ResourceService_ScheduleAction_Call {
+	return &ResourceService_ScheduleAction_Call{Call: _e.mock.On("ScheduleAction", ctx, s)}
+}
+
+func (_c *ResourceService_ScheduleAction_Call) Run(run func(ctx context.Context, s schedule.Schedule)) *ResourceService_ScheduleAction_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(schedule.Schedule))
+	})
+	return _c
+}
+
+func (_c *ResourceService_ScheduleAction_Call) Return(_a0 *schedule.Schedule, _a1 error) *ResourceService_ScheduleAction_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_ScheduleAction_Call) RunAndReturn(run func(context.Context, schedule.Schedule) (*schedule.Schedule, error)) *ResourceService_ScheduleAction_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePolicy provides a mock function with given fields: ctx, p
+func (_m *ResourceService) UpdatePolicy(ctx context.Context, p policy.Policy) (*policy.Policy, error) {
+	ret := _m.Called(ctx, p)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePolicy")
+	}
+
+	var r0 *policy.Policy
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Policy) (*policy.Policy, error)); ok {
+		return rf(ctx, p)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, policy.Policy) *policy.Policy); ok {
+		r0 = rf(ctx, p)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*policy.Policy)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, policy.Policy) error); ok {
+		r1 = rf(ctx, p)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_UpdatePolicy_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePolicy'
+type ResourceService_UpdatePolicy_Call struct {
+	*mock.Call
+}
+
+// UpdatePolicy is a helper method to define mock.On call
+//   - ctx context.Context
+//   - p policy.Policy
+func (_e *ResourceService_Expecter) UpdatePolicy(ctx interface{}, p interface{}) *ResourceService_UpdatePolicy_Call {
+	return &ResourceService_UpdatePolicy_Call{Call: _e.mock.On("UpdatePolicy", ctx, p)}
+}
+
+func (_c *ResourceService_UpdatePolicy_Call) Run(run func(ctx context.Context, p policy.Policy)) *ResourceService_UpdatePolicy_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(policy.Policy))
+	})
+	return _c
+}
+
+func (_c *ResourceService_UpdatePolicy_Call) Return(_a0 *policy.Policy, _a1 error) *ResourceService_UpdatePolicy_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_UpdatePolicy_Call) RunAndReturn(run func(context.Context, policy.Policy) (*policy.Policy, error)) *ResourceService_UpdatePolicy_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateResource provides a mock function with given fields: ctx, urn, req, resourceOpts
+func (_m *ResourceService) UpdateResource(ctx context.Context, urn string, req resource.UpdateRequest, resourceOpts ...core.Options) (*resource.Resource, error) {
+	_va := make([]interface{}, len(resourceOpts))
+	for _i := range resourceOpts {
+		_va[_i] = resourceOpts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, ctx, urn, req)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateResource")
+	}
+
+	var r0 *resource.Resource
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.UpdateRequest, ...core.Options) (*resource.Resource, error)); ok {
+		return rf(ctx, urn, req, resourceOpts...)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, resource.UpdateRequest, ...core.Options) *resource.Resource); ok {
+		r0 = rf(ctx, urn, req, resourceOpts...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*resource.Resource)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, resource.UpdateRequest, ...core.Options) error); ok {
+		r1 = rf(ctx, urn, req, resourceOpts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_UpdateResource_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateResource'
+type ResourceService_UpdateResource_Call struct {
+	*mock.Call
+}
+
+// UpdateResource is a helper method to define mock.On call
+//   - ctx context.Context
+//   - urn string
+//   - req resource.UpdateRequest
+//   - resourceOpts ...core.Options
+func (_e *ResourceService_Expecter) UpdateResource(ctx interface{}, urn interface{}, req interface{}, resourceOpts ...interface{}) *ResourceService_UpdateResource_Call {
+	return &ResourceService_UpdateResource_Call{Call: _e.mock.On("UpdateResource",
+		append([]interface{}{ctx, urn, req}, resourceOpts...)...)}
+}
+
+func (_c *ResourceService_UpdateResource_Call) Run(run func(ctx context.Context, urn string, req resource.UpdateRequest, resourceOpts ...core.Options)) *ResourceService_UpdateResource_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		variadicArgs := make([]core.Options, len(args)-3)
+		for i, a := range args[3:] {
+			if a != nil {
+				variadicArgs[i] = a.(core.Options)
+			}
+		}
+		run(args[0].(context.Context), args[1].(string), args[2].(resource.UpdateRequest), variadicArgs...)
+	})
+	return _c
+}
+
+func (_c *ResourceService_UpdateResource_Call) Return(_a0 *resource.Resource, _a1 error) *ResourceService_UpdateResource_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_UpdateResource_Call) RunAndReturn(run func(context.Context, string, resource.UpdateRequest, ...core.Options) (*resource.Resource, error)) *ResourceService_UpdateResource_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// WatchResources provides a mock function with given fields: ctx, selector, token
+func (_m *ResourceService) WatchResources(ctx context.Context, selector resource.WatchSelector, token string) (<-chan resource.Event, error) {
+	ret := _m.Called(ctx, selector, token)
+
+	if len(ret) == 0 {
+		panic("no return value specified for WatchResources")
+	}
+
+	var r0 <-chan resource.Event
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, resource.WatchSelector, string) (<-chan resource.Event, error)); ok {
+		return rf(ctx, selector, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, resource.WatchSelector, string) <-chan resource.Event); ok {
+		r0 = rf(ctx, selector, token)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan resource.Event)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, resource.WatchSelector, string) error); ok {
+		r1 = rf(ctx, selector, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResourceService_WatchResources_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'WatchResources'
+type ResourceService_WatchResources_Call struct {
+	*mock.Call
+}
+
+// WatchResources is a helper method to define mock.On call
+//   - ctx context.Context
+//   - selector resource.WatchSelector
+//   - token string
+func (_e *ResourceService_Expecter) WatchResources(ctx interface{}, selector interface{}, token interface{}) *ResourceService_WatchResources_Call {
+	return &ResourceService_WatchResources_Call{Call: _e.mock.On("WatchResources", ctx, selector, token)}
+}
+
+func (_c *ResourceService_WatchResources_Call) Run(run func(ctx context.Context, selector resource.WatchSelector, token string)) *ResourceService_WatchResources_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(resource.WatchSelector), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *ResourceService_WatchResources_Call) Return(_a0 <-chan resource.Event, _a1 error) *ResourceService_WatchResources_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ResourceService_WatchResources_Call) RunAndReturn(run func(context.Context, resource.WatchSelector, string) (<-chan resource.Event, error)) *ResourceService_WatchResources_Call {
 	_c.Call.Return(run)
 	return _c
 }
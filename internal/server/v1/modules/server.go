@@ -65,6 +65,8 @@ func (srv *APIServer) GetModule(ctx context.Context, request *entropyv1beta1.Get
 }
 
 func (srv *APIServer) CreateModule(ctx context.Context, request *entropyv1beta1.CreateModuleRequest) (*entropyv1beta1.CreateModuleResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
 	mod, err := moduleFromProto(request.GetModule())
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
@@ -83,6 +85,8 @@ func (srv *APIServer) CreateModule(ctx context.Context, request *entropyv1beta1.
 }
 
 func (srv *APIServer) UpdateModule(ctx context.Context, request *entropyv1beta1.UpdateModuleRequest) (*entropyv1beta1.UpdateModuleResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
 	newConfigs, err := getConfigsAsRawJSON(request)
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
@@ -102,6 +106,8 @@ func (srv *APIServer) UpdateModule(ctx context.Context, request *entropyv1beta1.
 }
 
 func (srv *APIServer) DeleteModule(ctx context.Context, request *entropyv1beta1.DeleteModuleRequest) (*entropyv1beta1.DeleteModuleResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
 	err := srv.moduleService.DeleteModule(ctx, request.GetUrn())
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
@@ -83,3 +83,12 @@ func (lw *LogWrapper) GetResourceRevisions(ctx context.Context, request *entropy
 	}
 	return resp, nil
 }
+
+func (lw *LogWrapper) WatchResources(request *entropyv1beta1.WatchResourcesRequest, server entropyv1beta1.ResourceService_WatchResourcesServer) error {
+	err := lw.ResourceServiceServer.WatchResources(request, server)
+	if err != nil {
+		zap.L().Error("WatchResources() failed", zap.Error(err))
+		return err
+	}
+	return nil
+}
@@ -2,12 +2,23 @@ package resources
 
 import (
 	"encoding/json"
+	"sort"
 	"strconv"
 
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
+	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/lock"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/rbac"
 	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/core/webhook"
 	"github.com/goto/entropy/pkg/errors"
 	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
 )
@@ -37,9 +48,19 @@ func resourceToProto(res resource.Resource) (*entropyv1beta1.Resource, error) {
 		State:     protoState,
 		CreatedBy: res.CreatedBy,
 		UpdatedBy: res.UpdatedBy,
+		Etag:      resourceETag(res.Version),
 	}, nil
 }
 
+// resourceETag returns the etag for the resource version. Resources that
+// are not persisted yet have no etag.
+func resourceETag(version int64) string {
+	if version == 0 {
+		return ""
+	}
+	return strconv.FormatInt(version, decimalBase)
+}
+
 func resourceStateToProto(state resource.State) (*entropyv1beta1.ResourceState, error) {
 	var outputVal *structpb.Value
 	if len(state.Output) > 0 {
@@ -163,3 +184,412 @@ func revisionToProto(revision resource.Revision) (*entropyv1beta1.ResourceRevisi
 		Spec:      spec,
 	}, nil
 }
+
+func specDiffToProto(diff resource.SpecDiff) (*entropyv1beta1.DiffRevisionsResponse, error) {
+	resp := &entropyv1beta1.DiffRevisionsResponse{
+		Urn:    diff.URN,
+		FromId: strconv.FormatInt(diff.FromID, decimalBase),
+	}
+	if diff.ToID != 0 {
+		resp.ToId = strconv.FormatInt(diff.ToID, decimalBase)
+	}
+
+	var err error
+	if resp.Configs, err = specChangesToProto(diff.Configs); err != nil {
+		return nil, err
+	}
+	if resp.Labels, err = specChangesToProto(diff.Labels); err != nil {
+		return nil, err
+	}
+	if resp.Dependencies, err = specChangesToProto(diff.Dependencies); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+func specChangesToProto(changes []resource.Change) ([]*entropyv1beta1.SpecChange, error) {
+	ops := map[string]entropyv1beta1.SpecChange_Op{
+		resource.ChangeAdded:   entropyv1beta1.SpecChange_OP_ADDED,
+		resource.ChangeRemoved: entropyv1beta1.SpecChange_OP_REMOVED,
+		resource.ChangeChanged: entropyv1beta1.SpecChange_OP_CHANGED,
+	}
+
+	var protoChanges []*entropyv1beta1.SpecChange
+	for _, change := range changes {
+		protoChange := &entropyv1beta1.SpecChange{
+			Op:   ops[change.Op],
+			Path: change.Path,
+		}
+
+		var err error
+		if change.From != nil {
+			if protoChange.From, err = structpb.NewValue(change.From); err != nil {
+				return nil, errors.ErrInternal.WithMsgf("diff to protobuf failed").WithCausef("%s", err.Error())
+			}
+		}
+		if change.To != nil {
+			if protoChange.To, err = structpb.NewValue(change.To); err != nil {
+				return nil, errors.ErrInternal.WithMsgf("diff to protobuf failed").WithCausef("%s", err.Error())
+			}
+		}
+		protoChanges = append(protoChanges, protoChange)
+	}
+	return protoChanges, nil
+}
+
+func planToProto(plan *core.Plan) (*entropyv1beta1.ResourcePlan, error) {
+	if plan == nil {
+		return nil, nil
+	}
+
+	changes, err := specChangesToProto(plan.Changes)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &entropyv1beta1.ResourcePlan{Changes: changes}
+	for _, effect := range plan.Effects {
+		effectChanges, err := specChangesToProto(effect.Changes)
+		if err != nil {
+			return nil, err
+		}
+
+		resp.Effects = append(resp.Effects, &entropyv1beta1.ResourceEffect{
+			Kind:    effect.Kind,
+			Target:  effect.Target,
+			Changes: effectChanges,
+		})
+	}
+
+	for _, v := range plan.Warnings {
+		resp.Warnings = append(resp.Warnings, policyViolationToProto(v))
+	}
+	return resp, nil
+}
+
+func bulkActionResultToProto(result core.BulkActionResult) (*entropyv1beta1.BulkActionResult, error) {
+	if result.Err != nil {
+		return &entropyv1beta1.BulkActionResult{
+			Urn:   result.URN,
+			Error: result.Err.Error(),
+		}, nil
+	}
+
+	res, err := resourceToProto(*result.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := planToProto(result.Plan)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entropyv1beta1.BulkActionResult{
+		Urn:      result.URN,
+		Success:  true,
+		Resource: res,
+		Plan:     plan,
+	}, nil
+}
+
+func syncRunToProto(run resource.SyncRun) *entropyv1beta1.SyncRun {
+	return &entropyv1beta1.SyncRun{
+		Id:         strconv.FormatInt(run.ID, decimalBase),
+		Urn:        run.URN,
+		Step:       run.Step,
+		StartedAt:  timestamppb.New(run.StartedAt),
+		FinishedAt: timestamppb.New(run.FinishedAt),
+		Duration:   durationpb.New(run.Duration()),
+		Outcome:    run.Outcome,
+		Error:      run.Error,
+		Retries:    int32(run.Retries),
+	}
+}
+
+var resourceEventTypes = map[string]entropyv1beta1.ResourceEvent_Type{
+	resource.EventCreated: entropyv1beta1.ResourceEvent_TYPE_CREATED,
+	resource.EventUpdated: entropyv1beta1.ResourceEvent_TYPE_UPDATED,
+	resource.EventDeleted: entropyv1beta1.ResourceEvent_TYPE_DELETED,
+}
+
+func resourceEventToProto(ev resource.Event) (*entropyv1beta1.ResourceEvent, error) {
+	res, err := resourceToProto(ev.Resource)
+	if err != nil {
+		return nil, err
+	}
+
+	return &entropyv1beta1.ResourceEvent{
+		Type:       resourceEventTypes[ev.Type],
+		Resource:   res,
+		Timestamp:  timestamppb.New(ev.Timestamp),
+		WatchToken: ev.WatchToken(),
+	}, nil
+}
+
+func auditEventToProto(event audit.Event) (*entropyv1beta1.AuditEvent, error) {
+	var paramsVal *structpb.Value
+	if len(event.Params) > 0 {
+		paramsVal = &structpb.Value{}
+		if err := json.Unmarshal(event.Params, paramsVal); err != nil {
+			return nil, errors.ErrInternal.WithMsgf("failed to unmarshal params").WithCausef("%s", err.Error())
+		}
+	}
+
+	return &entropyv1beta1.AuditEvent{
+		Id:        strconv.FormatInt(event.ID, decimalBase),
+		Timestamp: timestamppb.New(event.Timestamp),
+		Project:   event.Project,
+		Urn:       event.URN,
+		UserId:    event.UserID,
+		RequestId: event.RequestID,
+		Action:    event.Action,
+		Params:    paramsVal,
+		Result:    event.Result,
+		Error:     event.Error,
+	}, nil
+}
+
+// operationToProto returns nil for an operation that was not recorded, e.g.,
+// that of a dry-run.
+func operationToProto(op *operation.Operation) (*entropyv1beta1.Operation, error) {
+	if op == nil || op.ID == 0 {
+		return nil, nil
+	}
+
+	var paramsVal *structpb.Value
+	if len(op.Params) > 0 {
+		paramsVal = &structpb.Value{}
+		if err := json.Unmarshal(op.Params, paramsVal); err != nil {
+			return nil, errors.ErrInternal.WithMsgf("failed to unmarshal params").WithCausef("%s", err.Error())
+		}
+	}
+
+	resp := &entropyv1beta1.Operation{
+		Id:           strconv.FormatInt(op.ID, decimalBase),
+		Urn:          op.URN,
+		Project:      op.Project,
+		Action:       op.Action,
+		Params:       paramsVal,
+		UserId:       op.UserID,
+		Status:       op.Status,
+		PendingSteps: op.PendingSteps,
+		Error:        op.Error,
+		StartedAt:    timestamppb.New(op.StartedAt),
+	}
+	if op.FinishedAt != nil {
+		resp.FinishedAt = timestamppb.New(*op.FinishedAt)
+	}
+	return resp, nil
+}
+
+func auditFilterFromProto(request *entropyv1beta1.ListAuditEventsRequest) audit.Filter {
+	filter := audit.Filter{
+		Project: request.GetProject(),
+		URN:     request.GetUrn(),
+		UserID:  request.GetUserId(),
+		Limit:   int(request.GetLimit()),
+	}
+	if request.GetSince() != nil {
+		filter.Since = request.GetSince().AsTime()
+	}
+	if request.GetUntil() != nil {
+		filter.Until = request.GetUntil().AsTime()
+	}
+	return filter
+}
+
+func webhookToProto(sub webhook.Subscription) *entropyv1beta1.Webhook {
+	return &entropyv1beta1.Webhook{
+		Id:        strconv.FormatInt(sub.ID, decimalBase),
+		Project:   sub.Project,
+		Url:       sub.URL,
+		Secret:    sub.Secret,
+		Kinds:     sub.Kinds,
+		Statuses:  sub.Statuses,
+		Actions:   sub.Actions,
+		CreatedAt: timestamppb.New(sub.CreatedAt),
+		CreatedBy: sub.CreatedBy,
+	}
+}
+
+func webhookFromProto(wh *entropyv1beta1.Webhook) webhook.Subscription {
+	return webhook.Subscription{
+		Project:  wh.GetProject(),
+		URL:      wh.GetUrl(),
+		Secret:   wh.GetSecret(),
+		Kinds:    wh.GetKinds(),
+		Statuses: wh.GetStatuses(),
+		Actions:  wh.GetActions(),
+	}
+}
+
+func quotaReportToProto(report quota.Report) *entropyv1beta1.GetProjectQuotaResponse {
+	resp := &entropyv1beta1.GetProjectQuotaResponse{
+		Project: report.Project,
+		Limit:   quotaLimitToProto(report.Limit),
+		Usage:   quotaUsageToProto(report.Usage),
+	}
+
+	kinds := make([]string, 0, len(report.Kinds))
+	for kind := range report.Kinds {
+		kinds = append(kinds, kind)
+	}
+	sort.Strings(kinds)
+
+	for _, kind := range kinds {
+		resp.Kinds = append(resp.Kinds, &entropyv1beta1.KindQuota{
+			Kind:  kind,
+			Limit: quotaLimitToProto(report.Kinds[kind].Limit),
+			Usage: quotaUsageToProto(report.Kinds[kind].Usage),
+		})
+	}
+	return resp
+}
+
+func quotaLimitToProto(l quota.Limit) *entropyv1beta1.QuotaLimit {
+	return &entropyv1beta1.QuotaLimit{
+		MaxResources: int64(l.MaxResources),
+		MaxReplicas:  int64(l.MaxReplicas),
+		MaxCpu:       l.MaxCPU,
+		MaxMemory:    l.MaxMemory,
+	}
+}
+
+func quotaUsageToProto(u quota.Usage) *entropyv1beta1.QuotaUsage {
+	return &entropyv1beta1.QuotaUsage{
+		Resources: int64(u.Resources),
+		Replicas:  int64(u.Replicas),
+		Cpu:       u.CPU(),
+		Memory:    u.Memory(),
+	}
+}
+
+func lockToProto(l lock.Lock) *entropyv1beta1.Lock {
+	protoLock := &entropyv1beta1.Lock{
+		Id:        strconv.FormatInt(l.ID, decimalBase),
+		Project:   l.Project,
+		Urn:       l.URN,
+		Labels:    l.Labels,
+		Reason:    l.Reason,
+		Owner:     l.Owner,
+		CreatedAt: timestamppb.New(l.CreatedAt),
+		CreatedBy: l.CreatedBy,
+	}
+	if l.ExpiresAt != nil {
+		protoLock.ExpiresAt = timestamppb.New(*l.ExpiresAt)
+	}
+	return protoLock
+}
+
+func lockFromProto(protoLock *entropyv1beta1.Lock) lock.Lock {
+	l := lock.Lock{
+		Project: protoLock.GetProject(),
+		URN:     protoLock.GetUrn(),
+		Labels:  protoLock.GetLabels(),
+		Reason:  protoLock.GetReason(),
+		Owner:   protoLock.GetOwner(),
+	}
+	if protoLock.GetExpiresAt() != nil {
+		expiresAt := protoLock.GetExpiresAt().AsTime()
+		l.ExpiresAt = &expiresAt
+	}
+	return l
+}
+
+func policyToProto(p policy.Policy) *entropyv1beta1.Policy {
+	return &entropyv1beta1.Policy{
+		Id:          strconv.FormatInt(p.ID, decimalBase),
+		Name:        p.Name,
+		Description: p.Description,
+		Project:     p.Project,
+		Kind:        p.Kind,
+		Actions:     p.Actions,
+		Expression:  p.Expression,
+		Message:     p.Message,
+		Mode:        p.Mode,
+		Disabled:    p.Disabled,
+		CreatedAt:   timestamppb.New(p.CreatedAt),
+		UpdatedAt:   timestamppb.New(p.UpdatedAt),
+		CreatedBy:   p.CreatedBy,
+		UpdatedBy:   p.UpdatedBy,
+	}
+}
+
+func policyFromProto(protoPolicy *entropyv1beta1.Policy) policy.Policy {
+	return policy.Policy{
+		Name:        protoPolicy.GetName(),
+		Description: protoPolicy.GetDescription(),
+		Project:     protoPolicy.GetProject(),
+		Kind:        protoPolicy.GetKind(),
+		Actions:     protoPolicy.GetActions(),
+		Expression:  protoPolicy.GetExpression(),
+		Message:     protoPolicy.GetMessage(),
+		Mode:        protoPolicy.GetMode(),
+		Disabled:    protoPolicy.GetDisabled(),
+	}
+}
+
+func policyViolationToProto(v policy.Violation) *entropyv1beta1.PolicyViolation {
+	return &entropyv1beta1.PolicyViolation{
+		PolicyId: strconv.FormatInt(v.PolicyID, decimalBase),
+		Policy:   v.Policy,
+		Mode:     v.Mode,
+		Message:  v.Message,
+	}
+}
+
+func roleBindingToProto(b rbac.Binding) *entropyv1beta1.RoleBinding {
+	return &entropyv1beta1.RoleBinding{
+		Id:        strconv.FormatInt(b.ID, decimalBase),
+		Project:   b.Project,
+		User:      b.User,
+		Group:     b.Group,
+		Role:      b.Role,
+		Actions:   b.Actions,
+		CreatedAt: timestamppb.New(b.CreatedAt),
+		CreatedBy: b.CreatedBy,
+	}
+}
+
+func roleBindingFromProto(protoBinding *entropyv1beta1.RoleBinding) rbac.Binding {
+	return rbac.Binding{
+		Project: protoBinding.GetProject(),
+		User:    protoBinding.GetUser(),
+		Group:   protoBinding.GetGroup(),
+		Role:    protoBinding.GetRole(),
+		Actions: protoBinding.GetActions(),
+	}
+}
+
+func scheduledActionToProto(s schedule.Schedule) (*entropyv1beta1.ScheduledAction, error) {
+	var paramsVal *structpb.Value
+	if len(s.Action.Params) > 0 {
+		paramsVal = &structpb.Value{}
+		if err := json.Unmarshal(s.Action.Params, paramsVal); err != nil {
+			return nil, errors.ErrInternal.WithMsgf("failed to unmarshal params").WithCausef("%s", err.Error())
+		}
+	}
+
+	scheduled := &entropyv1beta1.ScheduledAction{
+		Id:        strconv.FormatInt(s.ID, decimalBase),
+		Urn:       s.URN,
+		Project:   s.Project,
+		Action:    s.Action.Name,
+		Params:    paramsVal,
+		Labels:    s.Action.Labels,
+		Cron:      s.Cron,
+		Status:    s.Status,
+		NextRunAt: timestamppb.New(s.NextRunAt),
+		LastError: s.LastError,
+		CreatedAt: timestamppb.New(s.CreatedAt),
+		CreatedBy: s.CreatedBy,
+	}
+	if !s.RunAt.IsZero() {
+		scheduled.RunAt = timestamppb.New(s.RunAt)
+	}
+	if s.LastRunAt != nil {
+		scheduled.LastRunAt = timestamppb.New(*s.LastRunAt)
+	}
+	return scheduled, nil
+}
@@ -4,11 +4,21 @@ package resources
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/lock"
 	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/rbac"
 	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/core/webhook"
 	"github.com/goto/entropy/internal/server/serverutils"
+	"github.com/goto/entropy/pkg/errors"
 	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
 )
 
@@ -17,12 +27,48 @@ type ResourceService interface {
 	ListResources(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error)
 	CreateResource(ctx context.Context, res resource.Resource, resourceOpts ...core.Options) (*resource.Resource, error)
 	UpdateResource(ctx context.Context, urn string, req resource.UpdateRequest, resourceOpts ...core.Options) (*resource.Resource, error)
-	DeleteResource(ctx context.Context, urn string) error
+	DeleteResource(ctx context.Context, urn string, resourceOpts ...core.Options) error
 
 	ApplyAction(ctx context.Context, urn string, action module.ActionRequest, resourceOpts ...core.Options) (*resource.Resource, error)
+	BulkApplyAction(ctx context.Context, req core.BulkActionRequest) ([]core.BulkActionResult, error)
+	RollbackResource(ctx context.Context, urn string, revisionID int64, userID string, resourceOpts ...core.Options) (*resource.Resource, error)
+	RetrySync(ctx context.Context, urn, fromStep, userID string, resourceOpts ...core.Options) (*resource.Resource, error)
 	GetLog(ctx context.Context, urn string, filter map[string]string) (<-chan module.LogChunk, error)
 
 	GetRevisions(ctx context.Context, selector resource.RevisionsSelector) ([]resource.Revision, error)
+	DiffRevisions(ctx context.Context, urn string, fromID, toID int64) (*resource.SpecDiff, error)
+	GetDependents(ctx context.Context, urn string, transitive bool) ([]resource.Resource, error)
+	GetSyncHistory(ctx context.Context, urn string, limit int) ([]resource.SyncRun, error)
+
+	ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error)
+	WatchResources(ctx context.Context, selector resource.WatchSelector, token string) (<-chan resource.Event, error)
+
+	CreateWebhook(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error)
+	ListWebhooks(ctx context.Context, project string) ([]webhook.Subscription, error)
+	DeleteWebhook(ctx context.Context, id int64) error
+
+	GetProjectQuota(ctx context.Context, project string) (*quota.Report, error)
+
+	CreateLock(ctx context.Context, l lock.Lock) (*lock.Lock, error)
+	ListLocks(ctx context.Context, project string, includeExpired bool) ([]lock.Lock, error)
+	DeleteLock(ctx context.Context, id int64, userID string) error
+
+	CreatePolicy(ctx context.Context, p policy.Policy) (*policy.Policy, error)
+	GetPolicy(ctx context.Context, id int64) (*policy.Policy, error)
+	ListPolicies(ctx context.Context, filter policy.Filter) ([]policy.Policy, error)
+	UpdatePolicy(ctx context.Context, p policy.Policy) (*policy.Policy, error)
+	DeletePolicy(ctx context.Context, id int64) error
+
+	CreateRoleBinding(ctx context.Context, b rbac.Binding) (*rbac.Binding, error)
+	ListRoleBindings(ctx context.Context, project string) ([]rbac.Binding, error)
+	DeleteRoleBinding(ctx context.Context, id int64) error
+
+	GetOperation(ctx context.Context, id int64) (*operation.Operation, error)
+	ListOperations(ctx context.Context, filter operation.Filter) ([]operation.Operation, error)
+
+	ScheduleAction(ctx context.Context, s schedule.Schedule) (*schedule.Schedule, error)
+	ListScheduledActions(ctx context.Context, filter schedule.Filter) ([]schedule.Schedule, error)
+	CancelScheduledAction(ctx context.Context, id int64) (*schedule.Schedule, error)
 }
 
 type APIServer struct {
@@ -37,6 +83,8 @@ func NewAPIServer(resourceService ResourceService) *APIServer {
 }
 
 func (server APIServer) CreateResource(ctx context.Context, request *entropyv1beta1.CreateResourceRequest) (*entropyv1beta1.CreateResourceResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
 	res, err := resourceFromProto(request.Resource, false)
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
@@ -49,7 +97,14 @@ func (server APIServer) CreateResource(ctx context.Context, request *entropyv1be
 	res.CreatedBy = userIdentifier
 	res.UpdatedBy = userIdentifier
 
-	result, err := server.resourceSvc.CreateResource(ctx, *res, core.WithDryRun(request.GetDryRun()))
+	opts, plan, err := mutationOptions(request.GetDryRun(), "", request.GetBreakGlass(), request.GetIdempotencyKey())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	opOpt, op := operationOption()
+	opts = append(opts, opOpt)
+
+	result, err := server.resourceSvc.CreateResource(ctx, *res, opts...)
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
 	}
@@ -59,17 +114,40 @@ func (server APIServer) CreateResource(ctx context.Context, request *entropyv1be
 		return nil, serverutils.ToRPCError(err)
 	}
 
+	responsePlan, err := planToProto(plan)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseOperation, err := operationToProto(op)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
 	return &entropyv1beta1.CreateResourceResponse{
-		Resource: responseResource,
+		Resource:  responseResource,
+		Plan:      responsePlan,
+		Operation: responseOperation,
 	}, nil
 }
 
 func (server APIServer) UpdateResource(ctx context.Context, request *entropyv1beta1.UpdateResourceRequest) (*entropyv1beta1.UpdateResourceResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
 	newSpec, err := resourceSpecFromProto(request.GetNewSpec())
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
 	}
 
+	if request.GetClearDependencies() {
+		if len(newSpec.Dependencies) != 0 {
+			return nil, serverutils.ToRPCError(errors.ErrInvalid.WithMsgf("dependencies cannot be set when clearing them"))
+		}
+	} else if len(newSpec.Dependencies) == 0 {
+		// no dependencies in the spec leaves them unchanged.
+		newSpec.Dependencies = nil
+	}
+
 	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
@@ -81,7 +159,14 @@ func (server APIServer) UpdateResource(ctx context.Context, request *entropyv1be
 		UserID: userIdentifier,
 	}
 
-	res, err := server.resourceSvc.UpdateResource(ctx, request.GetUrn(), updateRequest, core.WithDryRun(request.GetDryRun()))
+	opts, plan, err := mutationOptions(request.GetDryRun(), request.GetExpectedVersion(), request.GetBreakGlass(), request.GetIdempotencyKey())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	opOpt, op := operationOption()
+	opts = append(opts, opOpt)
+
+	res, err := server.resourceSvc.UpdateResource(ctx, request.GetUrn(), updateRequest, opts...)
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
 	}
@@ -91,8 +176,20 @@ func (server APIServer) UpdateResource(ctx context.Context, request *entropyv1be
 		return nil, serverutils.ToRPCError(err)
 	}
 
+	responsePlan, err := planToProto(plan)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseOperation, err := operationToProto(op)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
 	return &entropyv1beta1.UpdateResourceResponse{
-		Resource: responseResource,
+		Resource:  responseResource,
+		Plan:      responsePlan,
+		Operation: responseOperation,
 	}, nil
 }
 
@@ -114,11 +211,12 @@ func (server APIServer) GetResource(ctx context.Context, request *entropyv1beta1
 
 func (server APIServer) ListResources(ctx context.Context, request *entropyv1beta1.ListResourcesRequest) (*entropyv1beta1.ListResourcesResponse, error) {
 	filter := resource.Filter{
-		Kind:     request.GetKind(),
-		Project:  request.GetProject(),
-		Labels:   request.Labels,
-		PageSize: request.PageSize,
-		PageNum:  request.PageNum,
+		Kind:      request.GetKind(),
+		Project:   request.GetProject(),
+		Labels:    request.Labels,
+		PageSize:  request.PageSize,
+		PageNum:   request.PageNum,
+		PageToken: request.GetPageToken(),
 	}
 
 	withSpecConfigs := request.GetWithSpecConfigs()
@@ -138,21 +236,43 @@ func (server APIServer) ListResources(ctx context.Context, request *entropyv1bet
 	}
 
 	return &entropyv1beta1.ListResourcesResponse{
-		Count:     int32(len(responseResources)),
-		Resources: responseResources,
+		Count:         resources.Count,
+		Resources:     responseResources,
+		NextPageToken: resources.NextPageToken,
 	}, nil
 }
 
 func (server APIServer) DeleteResource(ctx context.Context, request *entropyv1beta1.DeleteResourceRequest) (*entropyv1beta1.DeleteResourceResponse, error) {
-	err := server.resourceSvc.DeleteResource(ctx, request.GetUrn())
+	ctx = serverutils.WithAuditActor(ctx)
+
+	opts := []core.Options{core.WithCascade(request.GetCascade())}
+	if request.GetBreakGlass() {
+		opts = append(opts, core.WithBreakGlass(true))
+	}
+	if key := request.GetIdempotencyKey(); key != "" {
+		opts = append(opts, core.WithIdempotencyKey(key))
+	}
+	opOpt, op := operationOption()
+	opts = append(opts, opOpt)
+
+	err := server.resourceSvc.DeleteResource(ctx, request.GetUrn(), opts...)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseOperation, err := operationToProto(op)
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
 	}
 
-	return &entropyv1beta1.DeleteResourceResponse{}, nil
+	return &entropyv1beta1.DeleteResourceResponse{
+		Operation: responseOperation,
+	}, nil
 }
 
 func (server APIServer) ApplyAction(ctx context.Context, request *entropyv1beta1.ApplyActionRequest) (*entropyv1beta1.ApplyActionResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
 	paramsJSON, err := request.GetParams().GetStructValue().MarshalJSON()
 	if err != nil {
 		return nil, err
@@ -170,7 +290,14 @@ func (server APIServer) ApplyAction(ctx context.Context, request *entropyv1beta1
 		UserID: userIdentifier,
 	}
 
-	updatedRes, err := server.resourceSvc.ApplyAction(ctx, request.GetUrn(), action, core.WithDryRun(request.GetDryRun()))
+	opts, plan, err := mutationOptions(request.GetDryRun(), request.GetExpectedVersion(), request.GetBreakGlass(), request.GetIdempotencyKey())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	opOpt, op := operationOption()
+	opts = append(opts, opOpt)
+
+	updatedRes, err := server.resourceSvc.ApplyAction(ctx, request.GetUrn(), action, opts...)
 	if err != nil {
 		return nil, serverutils.ToRPCError(err)
 	}
@@ -180,8 +307,148 @@ func (server APIServer) ApplyAction(ctx context.Context, request *entropyv1beta1
 		return nil, serverutils.ToRPCError(err)
 	}
 
+	responsePlan, err := planToProto(plan)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseOperation, err := operationToProto(op)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
 	return &entropyv1beta1.ApplyActionResponse{
-		Resource: responseResource,
+		Resource:  responseResource,
+		Plan:      responsePlan,
+		Operation: responseOperation,
+	}, nil
+}
+
+func (server APIServer) BulkApplyAction(ctx context.Context, request *entropyv1beta1.BulkApplyActionRequest) (*entropyv1beta1.BulkApplyActionResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	paramsJSON, err := request.GetParams().GetStructValue().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	results, err := server.resourceSvc.BulkApplyAction(ctx, core.BulkActionRequest{
+		Selector: resource.Filter{
+			Project: request.GetProject(),
+			Kind:    request.GetKind(),
+			Labels:  request.GetLabels(),
+		},
+		Action: module.ActionRequest{
+			Name:   request.GetAction(),
+			Params: paramsJSON,
+			Labels: request.GetActionLabels(),
+			UserID: userIdentifier,
+		},
+		Concurrency: int(request.GetConcurrency()),
+		BatchSize:   int(request.GetBatchSize()),
+		DryRun:      request.GetDryRun(),
+	})
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	resp := &entropyv1beta1.BulkApplyActionResponse{}
+	for _, result := range results {
+		responseResult, err := bulkActionResultToProto(result)
+		if err != nil {
+			return nil, serverutils.ToRPCError(err)
+		}
+
+		if responseResult.GetSuccess() {
+			resp.Succeeded++
+		} else {
+			resp.Failed++
+		}
+		resp.Results = append(resp.Results, responseResult)
+	}
+	return resp, nil
+}
+
+func (server APIServer) RollbackResource(ctx context.Context, request *entropyv1beta1.RollbackResourceRequest) (*entropyv1beta1.RollbackResourceResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	revisionID, err := strconv.ParseInt(request.GetRevisionId(), decimalBase, 64)
+	if err != nil {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.
+			WithMsgf("invalid revision id '%s'", request.GetRevisionId()))
+	}
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	dryRunOpt, plan := dryRunOption(request.GetDryRun())
+	opOpt, op := operationOption()
+	updatedRes, err := server.resourceSvc.RollbackResource(ctx, request.GetUrn(), revisionID, userIdentifier, dryRunOpt, opOpt)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseResource, err := resourceToProto(*updatedRes)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responsePlan, err := planToProto(plan)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseOperation, err := operationToProto(op)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.RollbackResourceResponse{
+		Resource:  responseResource,
+		Plan:      responsePlan,
+		Operation: responseOperation,
+	}, nil
+}
+
+func (server APIServer) RetrySync(ctx context.Context, request *entropyv1beta1.RetrySyncRequest) (*entropyv1beta1.RetrySyncResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	opOpt, op := operationOption()
+	opts := []core.Options{opOpt}
+	if request.GetBreakGlass() {
+		opts = append(opts, core.WithBreakGlass(true))
+	}
+
+	res, err := server.resourceSvc.RetrySync(ctx, request.GetUrn(), request.GetFromStep(), userIdentifier, opts...)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseResource, err := resourceToProto(*res)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseOperation, err := operationToProto(op)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.RetrySyncResponse{
+		Resource:  responseResource,
+		Operation: responseOperation,
 	}, nil
 }
 
@@ -217,6 +484,42 @@ func (server APIServer) GetLog(request *entropyv1beta1.GetLogRequest, stream ent
 	}
 }
 
+func (server APIServer) WatchResources(request *entropyv1beta1.WatchResourcesRequest, stream entropyv1beta1.ResourceService_WatchResourcesServer) error {
+	ctx := stream.Context()
+
+	selector := resource.WatchSelector{
+		Project: request.GetProject(),
+		Kind:    request.GetKind(),
+		Labels:  request.GetLabels(),
+	}
+
+	events, err := server.resourceSvc.WatchResources(ctx, selector, request.GetWatchToken())
+	if err != nil {
+		return serverutils.ToRPCError(err)
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case ev, open := <-events:
+			if !open {
+				return nil
+			}
+
+			protoEvent, err := resourceEventToProto(ev)
+			if err != nil {
+				return serverutils.ToRPCError(err)
+			}
+
+			if err := stream.Send(&entropyv1beta1.WatchResourcesResponse{Event: protoEvent}); err != nil {
+				return serverutils.ToRPCError(err)
+			}
+		}
+	}
+}
+
 func (server APIServer) GetResourceRevisions(ctx context.Context, request *entropyv1beta1.GetResourceRevisionsRequest) (*entropyv1beta1.GetResourceRevisionsResponse, error) {
 	revisions, err := server.resourceSvc.GetRevisions(ctx, resource.RevisionsSelector{URN: request.GetUrn()})
 	if err != nil {
@@ -237,3 +540,542 @@ func (server APIServer) GetResourceRevisions(ctx context.Context, request *entro
 		Revisions: responseRevisions,
 	}, nil
 }
+
+func (server APIServer) DiffRevisions(ctx context.Context, request *entropyv1beta1.DiffRevisionsRequest) (*entropyv1beta1.DiffRevisionsResponse, error) {
+	fromID, err := strconv.ParseInt(request.GetFromId(), decimalBase, 64)
+	if err != nil {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.
+			WithMsgf("invalid revision id '%s'", request.GetFromId()))
+	}
+
+	var toID int64
+	if request.GetToId() != "" {
+		toID, err = strconv.ParseInt(request.GetToId(), decimalBase, 64)
+		if err != nil {
+			return nil, serverutils.ToRPCError(errors.ErrInvalid.
+				WithMsgf("invalid revision id '%s'", request.GetToId()))
+		}
+	}
+
+	diff, err := server.resourceSvc.DiffRevisions(ctx, request.GetUrn(), fromID, toID)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	resp, err := specDiffToProto(*diff)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	return resp, nil
+}
+
+func (server APIServer) GetResourceDependents(ctx context.Context, request *entropyv1beta1.GetResourceDependentsRequest) (*entropyv1beta1.GetResourceDependentsResponse, error) {
+	dependents, err := server.resourceSvc.GetDependents(ctx, request.GetUrn(), request.GetTransitive())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseDependents []*entropyv1beta1.Resource
+	for _, res := range dependents {
+		responseResource, err := resourceToProto(res)
+		if err != nil {
+			return nil, serverutils.ToRPCError(err)
+		}
+		responseDependents = append(responseDependents, responseResource)
+	}
+
+	return &entropyv1beta1.GetResourceDependentsResponse{
+		Dependents: responseDependents,
+	}, nil
+}
+
+func (server APIServer) GetResourceSyncHistory(ctx context.Context, request *entropyv1beta1.GetResourceSyncHistoryRequest) (*entropyv1beta1.GetResourceSyncHistoryResponse, error) {
+	runs, err := server.resourceSvc.GetSyncHistory(ctx, request.GetUrn(), int(request.GetLimit()))
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseRuns []*entropyv1beta1.SyncRun
+	for _, run := range runs {
+		responseRuns = append(responseRuns, syncRunToProto(run))
+	}
+
+	return &entropyv1beta1.GetResourceSyncHistoryResponse{
+		Runs: responseRuns,
+	}, nil
+}
+
+func (server APIServer) ListAuditEvents(ctx context.Context, request *entropyv1beta1.ListAuditEventsRequest) (*entropyv1beta1.ListAuditEventsResponse, error) {
+	events, err := server.resourceSvc.ListAuditEvents(ctx, auditFilterFromProto(request))
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseEvents []*entropyv1beta1.AuditEvent
+	for _, event := range events {
+		responseEvent, err := auditEventToProto(event)
+		if err != nil {
+			return nil, serverutils.ToRPCError(err)
+		}
+		responseEvents = append(responseEvents, responseEvent)
+	}
+
+	return &entropyv1beta1.ListAuditEventsResponse{
+		Events: responseEvents,
+	}, nil
+}
+
+func (server APIServer) CreateWebhook(ctx context.Context, request *entropyv1beta1.CreateWebhookRequest) (*entropyv1beta1.CreateWebhookResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	sub := webhookFromProto(request.GetWebhook())
+	sub.CreatedBy = userIdentifier
+
+	created, err := server.resourceSvc.CreateWebhook(ctx, sub)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.CreateWebhookResponse{
+		Webhook: webhookToProto(*created),
+	}, nil
+}
+
+func (server APIServer) ListWebhooks(ctx context.Context, request *entropyv1beta1.ListWebhooksRequest) (*entropyv1beta1.ListWebhooksResponse, error) {
+	subs, err := server.resourceSvc.ListWebhooks(ctx, request.GetProject())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseWebhooks []*entropyv1beta1.Webhook
+	for _, sub := range subs {
+		responseWebhooks = append(responseWebhooks, webhookToProto(sub))
+	}
+
+	return &entropyv1beta1.ListWebhooksResponse{
+		Webhooks: responseWebhooks,
+	}, nil
+}
+
+func (server APIServer) DeleteWebhook(ctx context.Context, request *entropyv1beta1.DeleteWebhookRequest) (*entropyv1beta1.DeleteWebhookResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	id, err := strconv.ParseInt(request.GetId(), decimalBase, 64)
+	if err != nil {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.
+			WithMsgf("invalid webhook id '%s'", request.GetId()))
+	}
+
+	if err := server.resourceSvc.DeleteWebhook(ctx, id); err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	return &entropyv1beta1.DeleteWebhookResponse{}, nil
+}
+
+func (server APIServer) GetProjectQuota(ctx context.Context, request *entropyv1beta1.GetProjectQuotaRequest) (*entropyv1beta1.GetProjectQuotaResponse, error) {
+	if request.GetProject() == "" {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.WithMsgf("project must be set"))
+	}
+
+	report, err := server.resourceSvc.GetProjectQuota(ctx, request.GetProject())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	return quotaReportToProto(*report), nil
+}
+
+func (server APIServer) CreateLock(ctx context.Context, request *entropyv1beta1.CreateLockRequest) (*entropyv1beta1.CreateLockResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	l := lockFromProto(request.GetLock())
+	l.CreatedBy = userIdentifier
+
+	created, err := server.resourceSvc.CreateLock(ctx, l)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.CreateLockResponse{
+		Lock: lockToProto(*created),
+	}, nil
+}
+
+func (server APIServer) ListLocks(ctx context.Context, request *entropyv1beta1.ListLocksRequest) (*entropyv1beta1.ListLocksResponse, error) {
+	locks, err := server.resourceSvc.ListLocks(ctx, request.GetProject(), request.GetIncludeExpired())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseLocks []*entropyv1beta1.Lock
+	for _, l := range locks {
+		responseLocks = append(responseLocks, lockToProto(l))
+	}
+
+	return &entropyv1beta1.ListLocksResponse{
+		Locks: responseLocks,
+	}, nil
+}
+
+func (server APIServer) DeleteLock(ctx context.Context, request *entropyv1beta1.DeleteLockRequest) (*entropyv1beta1.DeleteLockResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	id, err := strconv.ParseInt(request.GetId(), decimalBase, 64)
+	if err != nil {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.
+			WithMsgf("invalid lock id '%s'", request.GetId()))
+	}
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	if err := server.resourceSvc.DeleteLock(ctx, id, userIdentifier); err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	return &entropyv1beta1.DeleteLockResponse{}, nil
+}
+
+func (server APIServer) CreatePolicy(ctx context.Context, request *entropyv1beta1.CreatePolicyRequest) (*entropyv1beta1.CreatePolicyResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	p := policyFromProto(request.GetPolicy())
+	p.CreatedBy = userIdentifier
+
+	created, err := server.resourceSvc.CreatePolicy(ctx, p)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.CreatePolicyResponse{
+		Policy: policyToProto(*created),
+	}, nil
+}
+
+func (server APIServer) GetPolicy(ctx context.Context, request *entropyv1beta1.GetPolicyRequest) (*entropyv1beta1.GetPolicyResponse, error) {
+	id, err := parsePolicyID(request.GetId())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	p, err := server.resourceSvc.GetPolicy(ctx, id)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.GetPolicyResponse{
+		Policy: policyToProto(*p),
+	}, nil
+}
+
+func (server APIServer) ListPolicies(ctx context.Context, request *entropyv1beta1.ListPoliciesRequest) (*entropyv1beta1.ListPoliciesResponse, error) {
+	policies, err := server.resourceSvc.ListPolicies(ctx, policy.Filter{
+		Project: request.GetProject(),
+		Kind:    request.GetKind(),
+	})
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responsePolicies []*entropyv1beta1.Policy
+	for _, p := range policies {
+		responsePolicies = append(responsePolicies, policyToProto(p))
+	}
+
+	return &entropyv1beta1.ListPoliciesResponse{
+		Policies: responsePolicies,
+	}, nil
+}
+
+func (server APIServer) UpdatePolicy(ctx context.Context, request *entropyv1beta1.UpdatePolicyRequest) (*entropyv1beta1.UpdatePolicyResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	id, err := parsePolicyID(request.GetId())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	p := policyFromProto(request.GetPolicy())
+	p.ID = id
+	p.UpdatedBy = userIdentifier
+
+	updated, err := server.resourceSvc.UpdatePolicy(ctx, p)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.UpdatePolicyResponse{
+		Policy: policyToProto(*updated),
+	}, nil
+}
+
+func (server APIServer) DeletePolicy(ctx context.Context, request *entropyv1beta1.DeletePolicyRequest) (*entropyv1beta1.DeletePolicyResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	id, err := parsePolicyID(request.GetId())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	if err := server.resourceSvc.DeletePolicy(ctx, id); err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	return &entropyv1beta1.DeletePolicyResponse{}, nil
+}
+
+func parsePolicyID(s string) (int64, error) {
+	id, err := strconv.ParseInt(s, decimalBase, 64)
+	if err != nil {
+		return 0, errors.ErrInvalid.WithMsgf("invalid policy id '%s'", s)
+	}
+	return id, nil
+}
+
+func (server APIServer) CreateRoleBinding(ctx context.Context, request *entropyv1beta1.CreateRoleBindingRequest) (*entropyv1beta1.CreateRoleBindingResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	b := roleBindingFromProto(request.GetRoleBinding())
+	b.CreatedBy = userIdentifier
+
+	created, err := server.resourceSvc.CreateRoleBinding(ctx, b)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.CreateRoleBindingResponse{
+		RoleBinding: roleBindingToProto(*created),
+	}, nil
+}
+
+func (server APIServer) ListRoleBindings(ctx context.Context, request *entropyv1beta1.ListRoleBindingsRequest) (*entropyv1beta1.ListRoleBindingsResponse, error) {
+	bindings, err := server.resourceSvc.ListRoleBindings(ctx, request.GetProject())
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseBindings []*entropyv1beta1.RoleBinding
+	for _, b := range bindings {
+		responseBindings = append(responseBindings, roleBindingToProto(b))
+	}
+
+	return &entropyv1beta1.ListRoleBindingsResponse{
+		RoleBindings: responseBindings,
+	}, nil
+}
+
+func (server APIServer) DeleteRoleBinding(ctx context.Context, request *entropyv1beta1.DeleteRoleBindingRequest) (*entropyv1beta1.DeleteRoleBindingResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	id, err := strconv.ParseInt(request.GetId(), decimalBase, 64)
+	if err != nil {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.WithMsgf("invalid role binding id '%s'", request.GetId()))
+	}
+
+	if err := server.resourceSvc.DeleteRoleBinding(ctx, id); err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+	return &entropyv1beta1.DeleteRoleBindingResponse{}, nil
+}
+
+func (server APIServer) GetOperation(ctx context.Context, request *entropyv1beta1.GetOperationRequest) (*entropyv1beta1.GetOperationResponse, error) {
+	id, err := strconv.ParseInt(request.GetId(), decimalBase, 64)
+	if err != nil {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.WithMsgf("invalid operation id '%s'", request.GetId()))
+	}
+
+	op, err := server.resourceSvc.GetOperation(ctx, id)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseOperation, err := operationToProto(op)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.GetOperationResponse{
+		Operation: responseOperation,
+	}, nil
+}
+
+func (server APIServer) ListOperations(ctx context.Context, request *entropyv1beta1.ListOperationsRequest) (*entropyv1beta1.ListOperationsResponse, error) {
+	ops, err := server.resourceSvc.ListOperations(ctx, operation.Filter{
+		Project:  request.GetProject(),
+		URN:      request.GetUrn(),
+		Statuses: request.GetStatuses(),
+		Limit:    int(request.GetLimit()),
+	})
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseOperations []*entropyv1beta1.Operation
+	for _, op := range ops {
+		responseOperation, err := operationToProto(&op)
+		if err != nil {
+			return nil, serverutils.ToRPCError(err)
+		}
+		responseOperations = append(responseOperations, responseOperation)
+	}
+
+	return &entropyv1beta1.ListOperationsResponse{
+		Operations: responseOperations,
+	}, nil
+}
+
+// dryRunOption returns the option for the dry-run flag of a request. For
+// dry-runs, the returned plan receives what the request would change.
+func dryRunOption(dryRun bool) (core.Options, *core.Plan) {
+	if !dryRun {
+		return core.WithDryRun(false), nil
+	}
+
+	plan := &core.Plan{}
+	return core.WithPlan(plan), plan
+}
+
+// operationOption returns the option for receiving the operation that
+// tracks a mutation. The operation is left empty for dry-runs.
+func operationOption() (core.Options, *operation.Operation) {
+	op := &operation.Operation{}
+	return core.WithOperation(op), op
+}
+
+// mutationOptions returns the options for the dry-run flag, the expected
+// version (etag), the break-glass flag and the idempotency key of an update
+// request.
+func mutationOptions(dryRun bool, expectedVersion string, breakGlass bool, idempotencyKey string) ([]core.Options, *core.Plan, error) {
+	dryRunOpt, plan := dryRunOption(dryRun)
+	opts := []core.Options{dryRunOpt}
+
+	if expectedVersion != "" {
+		version, err := strconv.ParseInt(expectedVersion, decimalBase, 64)
+		if err != nil || version <= 0 {
+			return nil, nil, errors.ErrInvalid.WithMsgf("invalid expected version '%s'", expectedVersion)
+		}
+		opts = append(opts, core.WithExpectedVersion(version))
+	}
+
+	if breakGlass {
+		opts = append(opts, core.WithBreakGlass(true))
+	}
+	if idempotencyKey != "" {
+		opts = append(opts, core.WithIdempotencyKey(idempotencyKey))
+	}
+	return opts, plan, nil
+}
+
+func (server APIServer) ScheduleAction(ctx context.Context, request *entropyv1beta1.ScheduleActionRequest) (*entropyv1beta1.ScheduleActionResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	paramsJSON, err := request.GetParams().GetStructValue().MarshalJSON()
+	if err != nil {
+		return nil, err
+	}
+
+	userIdentifier, err := serverutils.GetUserIdentifier(ctx)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	s := schedule.Schedule{
+		URN: request.GetUrn(),
+		Action: module.ActionRequest{
+			Name:   request.GetAction(),
+			Params: paramsJSON,
+			Labels: request.GetLabels(),
+		},
+		Cron:      request.GetCron(),
+		CreatedBy: userIdentifier,
+	}
+	if request.GetRunAt() != nil {
+		s.RunAt = request.GetRunAt().AsTime()
+	}
+
+	created, err := server.resourceSvc.ScheduleAction(ctx, s)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseSchedule, err := scheduledActionToProto(*created)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.ScheduleActionResponse{
+		ScheduledAction: responseSchedule,
+	}, nil
+}
+
+func (server APIServer) ListScheduledActions(ctx context.Context, request *entropyv1beta1.ListScheduledActionsRequest) (*entropyv1beta1.ListScheduledActionsResponse, error) {
+	schedules, err := server.resourceSvc.ListScheduledActions(ctx, schedule.Filter{
+		URN:     request.GetUrn(),
+		Project: request.GetProject(),
+		Status:  request.GetStatus(),
+	})
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	var responseSchedules []*entropyv1beta1.ScheduledAction
+	for _, s := range schedules {
+		responseSchedule, err := scheduledActionToProto(s)
+		if err != nil {
+			return nil, serverutils.ToRPCError(err)
+		}
+		responseSchedules = append(responseSchedules, responseSchedule)
+	}
+
+	return &entropyv1beta1.ListScheduledActionsResponse{
+		ScheduledActions: responseSchedules,
+	}, nil
+}
+
+func (server APIServer) CancelScheduledAction(ctx context.Context, request *entropyv1beta1.CancelScheduledActionRequest) (*entropyv1beta1.CancelScheduledActionResponse, error) {
+	ctx = serverutils.WithAuditActor(ctx)
+
+	id, err := strconv.ParseInt(request.GetId(), decimalBase, 64)
+	if err != nil {
+		return nil, serverutils.ToRPCError(errors.ErrInvalid.
+			WithMsgf("invalid scheduled action id '%s'", request.GetId()))
+	}
+
+	cancelled, err := server.resourceSvc.CancelScheduledAction(ctx, id)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	responseSchedule, err := scheduledActionToProto(*cancelled)
+	if err != nil {
+		return nil, serverutils.ToRPCError(err)
+	}
+
+	return &entropyv1beta1.CancelScheduledActionResponse{
+		ScheduledAction: responseSchedule,
+	}, nil
+}
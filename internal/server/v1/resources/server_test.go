@@ -10,20 +10,36 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/testing/protocmp"
+	"google.golang.org/protobuf/types/known/durationpb"
 	"google.golang.org/protobuf/types/known/structpb"
 	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/goto/entropy/core"
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/lock"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/quota"
+	"github.com/goto/entropy/core/rbac"
 	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/core/webhook"
 	"github.com/goto/entropy/internal/server/v1/mocks"
 	"github.com/goto/entropy/pkg/errors"
 	entropyv1beta1 "github.com/goto/entropy/proto/gotocompany/entropy/v1beta1"
 )
 
+// withOperation matches the option through which the handlers receive the
+// operation tracking a mutation.
+var withOperation = mock.MatchedBy(func(opts core.Options) bool { return opts.Operation != nil })
+
 func TestAPIServer_CreateResource(t *testing.T) {
 	t.Parallel()
 
@@ -45,7 +61,7 @@ func TestAPIServer_CreateResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					CreateResource(mock.Anything, mock.Anything, core.WithDryRun(false)).
+					CreateResource(mock.Anything, mock.Anything, core.WithDryRun(false), withOperation).
 					Return(nil, errors.ErrConflict).Once()
 				return NewAPIServer(resourceService)
 			},
@@ -69,7 +85,7 @@ func TestAPIServer_CreateResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					CreateResource(mock.Anything, mock.Anything, core.WithDryRun(false)).
+					CreateResource(mock.Anything, mock.Anything, core.WithDryRun(false), withOperation).
 					Return(nil, errors.ErrInvalid).Once()
 
 				return NewAPIServer(resourceService)
@@ -94,7 +110,7 @@ func TestAPIServer_CreateResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					CreateResource(mock.Anything, mock.Anything, core.WithDryRun(false)).
+					CreateResource(mock.Anything, mock.Anything, core.WithDryRun(false), withOperation).
 					Return(&resource.Resource{
 						URN:       "p-testdata-gl-testname-log",
 						Kind:      "log",
@@ -190,7 +206,7 @@ func TestAPIServer_UpdateResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					UpdateResource(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false)).
+					UpdateResource(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), withOperation).
 					Return(nil, errors.ErrNotFound).Once()
 				return NewAPIServer(resourceService)
 			},
@@ -209,7 +225,7 @@ func TestAPIServer_UpdateResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					UpdateResource(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false)).
+					UpdateResource(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), withOperation).
 					Return(nil, errors.ErrInvalid).Once()
 				return NewAPIServer(resourceService)
 			},
@@ -228,7 +244,7 @@ func TestAPIServer_UpdateResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					UpdateResource(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false)).
+					UpdateResource(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), withOperation).
 					Return(&resource.Resource{
 						URN:       "p-testdata-gl-testname-log",
 						Kind:      "log",
@@ -271,6 +287,77 @@ func TestAPIServer_UpdateResource(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "ClearDependencies",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				clearsDependencies := mock.MatchedBy(func(req resource.UpdateRequest) bool {
+					return req.Spec.Dependencies != nil && len(req.Spec.Dependencies) == 0
+				})
+
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					UpdateResource(mock.Anything, "p-testdata-gl-testname-log", clearsDependencies, core.WithDryRun(false), withOperation).
+					Return(&resource.Resource{
+						URN:       "p-testdata-gl-testname-log",
+						Kind:      "log",
+						Name:      "testname",
+						Project:   "p-testdata-gl",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						Spec: resource.Spec{
+							Configs: []byte(`{"replicas": "10"}`),
+						},
+						State: resource.State{
+							Status: resource.StatusPending,
+						},
+					}, nil).Once()
+
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.UpdateResourceRequest{
+				Urn: "p-testdata-gl-testname-log",
+				NewSpec: &entropyv1beta1.ResourceSpec{
+					Configs: configsStructValue,
+				},
+				ClearDependencies: true,
+			},
+			want: &entropyv1beta1.UpdateResourceResponse{
+				Resource: &entropyv1beta1.Resource{
+					Urn:       "p-testdata-gl-testname-log",
+					Kind:      "log",
+					Name:      "testname",
+					Project:   "p-testdata-gl",
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(updatedAt),
+					Spec: &entropyv1beta1.ResourceSpec{
+						Configs: configsStructValue,
+					},
+					State: &entropyv1beta1.ResourceState{
+						Status: entropyv1beta1.ResourceState_STATUS_PENDING,
+					},
+				},
+			},
+		},
+		{
+			name: "ClearDependenciesWithDependencies",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				return NewAPIServer(&mocks.ResourceService{})
+			},
+			request: &entropyv1beta1.UpdateResourceRequest{
+				Urn: "p-testdata-gl-testname-log",
+				NewSpec: &entropyv1beta1.ResourceSpec{
+					Configs: configsStructValue,
+					Dependencies: []*entropyv1beta1.ResourceDependency{
+						{Key: "kube_cluster", Value: "orn:entropy:kubernetes:p-testdata-gl:cluster"},
+					},
+				},
+				ClearDependencies: true,
+			},
+			want:    nil,
+			wantErr: status.Errorf(codes.InvalidArgument, "bad_request: dependencies cannot be set when clearing them"),
+		},
 	}
 
 	for _, tt := range tests {
@@ -437,9 +524,15 @@ func TestAPIServer_ListResources(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					ListResources(mock.Anything, mock.Anything, false).
+					ListResources(mock.Anything, resource.Filter{
+						Project:   "p-testdata-gl",
+						Kind:      "log",
+						PageSize:  1,
+						PageToken: "MQ",
+					}, false).
 					Return(resource.PagedResource{
-						Count: 1,
+						Count:         3,
+						NextPageToken: "Mg",
 						Resources: []resource.Resource{
 							{
 								URN:       "p-testdata-gl-testname-log",
@@ -462,11 +555,14 @@ func TestAPIServer_ListResources(t *testing.T) {
 				return NewAPIServer(resourceService)
 			},
 			request: &entropyv1beta1.ListResourcesRequest{
-				Project: "p-testdata-gl",
-				Kind:    "log",
+				Project:   "p-testdata-gl",
+				Kind:      "log",
+				PageSize:  1,
+				PageToken: "MQ",
 			},
 			want: &entropyv1beta1.ListResourcesResponse{
-				Count: 1,
+				Count:         3,
+				NextPageToken: "Mg",
 				Resources: []*entropyv1beta1.Resource{
 					{
 						Urn:       "p-testdata-gl-testname-log",
@@ -524,7 +620,7 @@ func TestAPIServer_DeleteResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					DeleteResource(mock.Anything, "p-testdata-gl-testname-log").
+					DeleteResource(mock.Anything, "p-testdata-gl-testname-log", core.WithCascade(false), withOperation).
 					Return(errors.ErrNotFound).Once()
 				return NewAPIServer(resourceService)
 			},
@@ -540,7 +636,7 @@ func TestAPIServer_DeleteResource(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					DeleteResource(mock.Anything, "p-testdata-gl-testname-log").
+					DeleteResource(mock.Anything, "p-testdata-gl-testname-log", core.WithCascade(false), withOperation).
 					Return(nil).Once()
 
 				return NewAPIServer(resourceService)
@@ -550,6 +646,39 @@ func TestAPIServer_DeleteResource(t *testing.T) {
 			},
 			want: &entropyv1beta1.DeleteResourceResponse{},
 		},
+		{
+			name: "HasDependents",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					DeleteResource(mock.Anything, "p-testdata-gl-testname-log", core.WithCascade(false), withOperation).
+					Return(errors.ErrInvalid).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.DeleteResourceRequest{
+				Urn: "p-testdata-gl-testname-log",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: request is not valid"),
+		},
+		{
+			name: "Cascade",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					DeleteResource(mock.Anything, "p-testdata-gl-testname-log", core.WithCascade(true), withOperation).
+					Return(nil).Once()
+
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.DeleteResourceRequest{
+				Urn:     "p-testdata-gl-testname-log",
+				Cascade: true,
+			},
+			want: &entropyv1beta1.DeleteResourceResponse{},
+		},
 	}
 
 	for _, tt := range tests {
@@ -594,7 +723,7 @@ func TestAPIServer_ApplyAction(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false)).
+					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), withOperation).
 					Return(nil, errors.ErrNotFound).Once()
 				return NewAPIServer(resourceService)
 			},
@@ -611,7 +740,7 @@ func TestAPIServer_ApplyAction(t *testing.T) {
 				t.Helper()
 				resourceService := &mocks.ResourceService{}
 				resourceService.EXPECT().
-					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false)).
+					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), withOperation).
 					Return(&resource.Resource{
 						URN:       "p-testdata-gl-testname-log",
 						Kind:      "log",
@@ -653,19 +782,1889 @@ func TestAPIServer_ApplyAction(t *testing.T) {
 				},
 			},
 		},
-	}
+		{
+			name: "InvalidExpectedVersion",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				return NewAPIServer(&mocks.ResourceService{})
+			},
+			request: &entropyv1beta1.ApplyActionRequest{
+				Urn:             "p-testdata-gl-testname-log",
+				Action:          "scale",
+				ExpectedVersion: "v2",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: invalid expected version 'v2'"),
+		},
+		{
+			name: "VersionMismatch",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), core.WithExpectedVersion(2), withOperation).
+					Return(nil, errors.ErrConflict.WithMsgf("resource version mismatch: expected 2, current 3")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ApplyActionRequest{
+				Urn:             "p-testdata-gl-testname-log",
+				Action:          "scale",
+				ExpectedVersion: "2",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.AlreadyExists, "conflict: resource version mismatch: expected 2, current 3"),
+		},
+		{
+			name: "Locked",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), withOperation).
+					Return(nil, errors.ErrInvalid.WithMsgf("cannot perform 'scale' on resource 'p-testdata-gl-testname-log': locked by john (change freeze)")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ApplyActionRequest{
+				Urn:    "p-testdata-gl-testname-log",
+				Action: "scale",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: cannot perform 'scale' on resource 'p-testdata-gl-testname-log': locked by john (change freeze)"),
+		},
+		{
+			name: "SuccessWithBreakGlass",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), core.WithBreakGlass(true), withOperation).
+					Return(&resource.Resource{
+						URN:       "p-testdata-gl-testname-log",
+						Kind:      "log",
+						Name:      "testname",
+						Project:   "p-testdata-gl",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						Spec: resource.Spec{
+							Configs: []byte(`{"replicas": "10"}`),
+						},
+						State: resource.State{
+							Status: resource.StatusPending,
+						},
+					}, nil).Once()
 
-	for _, tt := range tests {
-		tt := tt
-		t.Run(tt.name, func(t *testing.T) {
-			t.Parallel()
-			srv := tt.setup(t)
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ApplyActionRequest{
+				Urn:        "p-testdata-gl-testname-log",
+				Action:     "scale",
+				BreakGlass: true,
+			},
+			want: &entropyv1beta1.ApplyActionResponse{
+				Resource: &entropyv1beta1.Resource{
+					Urn:       "p-testdata-gl-testname-log",
+					Kind:      "log",
+					Name:      "testname",
+					Project:   "p-testdata-gl",
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(updatedAt),
+					Spec: &entropyv1beta1.ResourceSpec{
+						Configs: configsStructValue,
+					},
+					State: &entropyv1beta1.ResourceState{
+						Status: entropyv1beta1.ResourceState_STATUS_PENDING,
+					},
+				},
+			},
+		},
+		{
+			name: "SuccessWithExpectedVersion",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, core.WithDryRun(false), core.WithExpectedVersion(2), withOperation).
+					Return(&resource.Resource{
+						URN:       "p-testdata-gl-testname-log",
+						Kind:      "log",
+						Name:      "testname",
+						Project:   "p-testdata-gl",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						Version:   3,
+						Spec: resource.Spec{
+							Configs: []byte(`{"replicas": "10"}`),
+						},
+						State: resource.State{
+							Status: resource.StatusPending,
+						},
+					}, nil).Once()
 
-			ctx := context.Background()
-			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
-			ctx = metadata.NewIncomingContext(ctx, md)
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ApplyActionRequest{
+				Urn:             "p-testdata-gl-testname-log",
+				Action:          "scale",
+				ExpectedVersion: "2",
+			},
+			want: &entropyv1beta1.ApplyActionResponse{
+				Resource: &entropyv1beta1.Resource{
+					Urn:       "p-testdata-gl-testname-log",
+					Kind:      "log",
+					Name:      "testname",
+					Project:   "p-testdata-gl",
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(updatedAt),
+					Spec: &entropyv1beta1.ResourceSpec{
+						Configs: configsStructValue,
+					},
+					State: &entropyv1beta1.ResourceState{
+						Status: entropyv1beta1.ResourceState_STATUS_PENDING,
+					},
+					Etag: "3",
+				},
+			},
+		},
+		{
+			name: "SuccessWithDryRun",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				isPlan := mock.MatchedBy(func(opts core.Options) bool {
+					return opts.DryRun && opts.Plan != nil
+				})
 
-			got, err := srv.ApplyAction(ctx, tt.request)
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ApplyAction(mock.Anything, "p-testdata-gl-testname-log", mock.Anything, isPlan, withOperation).
+					Run(func(_ context.Context, _ string, _ module.ActionRequest, resourceOpts ...core.Options) {
+						*resourceOpts[0].Plan = core.Plan{
+							Changes: []resource.Change{
+								{Op: resource.ChangeChanged, Path: "spec.configs.replicas", From: "1", To: "10"},
+							},
+							Effects: []module.Effect{
+								{
+									Kind:   "helm_release",
+									Target: "firehose/testname",
+									Changes: []resource.Change{
+										{Op: resource.ChangeChanged, Path: "replicaCount", From: float64(1), To: float64(10)},
+									},
+								},
+							},
+						}
+					}).
+					Return(&resource.Resource{
+						URN:       "p-testdata-gl-testname-log",
+						Kind:      "log",
+						Name:      "testname",
+						Project:   "p-testdata-gl",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						Spec: resource.Spec{
+							Configs: []byte(`{"replicas": "10"}`),
+						},
+						State: resource.State{
+							Status: resource.StatusPending,
+						},
+					}, nil).Once()
+
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ApplyActionRequest{
+				Urn:    "p-testdata-gl-testname-log",
+				Action: "scale",
+				Params: configsStructValue,
+				DryRun: true,
+			},
+			want: &entropyv1beta1.ApplyActionResponse{
+				Resource: &entropyv1beta1.Resource{
+					Urn:       "p-testdata-gl-testname-log",
+					Kind:      "log",
+					Name:      "testname",
+					Project:   "p-testdata-gl",
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(updatedAt),
+					Spec: &entropyv1beta1.ResourceSpec{
+						Configs: configsStructValue,
+					},
+					State: &entropyv1beta1.ResourceState{
+						Status: entropyv1beta1.ResourceState_STATUS_PENDING,
+					},
+				},
+				Plan: &entropyv1beta1.ResourcePlan{
+					Changes: []*entropyv1beta1.SpecChange{
+						{
+							Op:   entropyv1beta1.SpecChange_OP_CHANGED,
+							Path: "spec.configs.replicas",
+							From: structpb.NewStringValue("1"),
+							To:   structpb.NewStringValue("10"),
+						},
+					},
+					Effects: []*entropyv1beta1.ResourceEffect{
+						{
+							Kind:   "helm_release",
+							Target: "firehose/testname",
+							Changes: []*entropyv1beta1.SpecChange{
+								{
+									Op:   entropyv1beta1.SpecChange_OP_CHANGED,
+									Path: "replicaCount",
+									From: structpb.NewNumberValue(1),
+									To:   structpb.NewNumberValue(10),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.ApplyAction(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_RollbackResource(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+	updatedAt := createdAt.Add(1 * time.Minute)
+
+	configsStructValue := &structpb.Value{}
+	require.NoError(t, json.Unmarshal([]byte(`{"replicas": "10"}`), &configsStructValue))
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.RollbackResourceRequest
+		want    *entropyv1beta1.RollbackResourceResponse
+		wantErr error
+	}{
+		{
+			name: "InvalidRevisionID",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				return NewAPIServer(&mocks.ResourceService{})
+			},
+			request: &entropyv1beta1.RollbackResourceRequest{
+				Urn:        "p-testdata-gl-testname-log",
+				RevisionId: "latest",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: invalid revision id 'latest'"),
+		},
+		{
+			name: "RevisionNotFound",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					RollbackResource(mock.Anything, "p-testdata-gl-testname-log", int64(42), "john.doe@goto.com", core.WithDryRun(false), withOperation).
+					Return(nil, errors.ErrNotFound).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.RollbackResourceRequest{
+				Urn:        "p-testdata-gl-testname-log",
+				RevisionId: "42",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, "not_found: requested entity not found"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					RollbackResource(mock.Anything, "p-testdata-gl-testname-log", int64(42), "john.doe@goto.com", core.WithDryRun(false), withOperation).
+					Return(&resource.Resource{
+						URN:       "p-testdata-gl-testname-log",
+						Kind:      "log",
+						Name:      "testname",
+						Project:   "p-testdata-gl",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						Spec: resource.Spec{
+							Configs: []byte(`{"replicas": "10"}`),
+						},
+						State: resource.State{
+							Status: resource.StatusPending,
+						},
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.RollbackResourceRequest{
+				Urn:        "p-testdata-gl-testname-log",
+				RevisionId: "42",
+			},
+			want: &entropyv1beta1.RollbackResourceResponse{
+				Resource: &entropyv1beta1.Resource{
+					Urn:       "p-testdata-gl-testname-log",
+					Kind:      "log",
+					Name:      "testname",
+					Project:   "p-testdata-gl",
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(updatedAt),
+					Spec: &entropyv1beta1.ResourceSpec{
+						Configs: configsStructValue,
+					},
+					State: &entropyv1beta1.ResourceState{
+						Status: entropyv1beta1.ResourceState_STATUS_PENDING,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.RollbackResource(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_DiffRevisions(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.DiffRevisionsRequest
+		want    *entropyv1beta1.DiffRevisionsResponse
+		wantErr error
+	}{
+		{
+			name: "InvalidRevisionID",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				return NewAPIServer(&mocks.ResourceService{})
+			},
+			request: &entropyv1beta1.DiffRevisionsRequest{
+				Urn:    "p-testdata-gl-testname-log",
+				FromId: "1",
+				ToId:   "latest",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: invalid revision id 'latest'"),
+		},
+		{
+			name: "RevisionNotFound",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					DiffRevisions(mock.Anything, "p-testdata-gl-testname-log", int64(1), int64(0)).
+					Return(nil, errors.ErrNotFound).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.DiffRevisionsRequest{
+				Urn:    "p-testdata-gl-testname-log",
+				FromId: "1",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, "not_found: requested entity not found"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					DiffRevisions(mock.Anything, "p-testdata-gl-testname-log", int64(1), int64(2)).
+					Return(&resource.SpecDiff{
+						URN:    "p-testdata-gl-testname-log",
+						FromID: 1,
+						ToID:   2,
+						Configs: []resource.Change{
+							{Op: resource.ChangeChanged, Path: "replicas", From: float64(1), To: float64(3)},
+						},
+						Labels: []resource.Change{
+							{Op: resource.ChangeAdded, Path: "team", To: "data"},
+						},
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.DiffRevisionsRequest{
+				Urn:    "p-testdata-gl-testname-log",
+				FromId: "1",
+				ToId:   "2",
+			},
+			want: &entropyv1beta1.DiffRevisionsResponse{
+				Urn:    "p-testdata-gl-testname-log",
+				FromId: "1",
+				ToId:   "2",
+				Configs: []*entropyv1beta1.SpecChange{
+					{
+						Op:   entropyv1beta1.SpecChange_OP_CHANGED,
+						Path: "replicas",
+						From: structpb.NewNumberValue(1),
+						To:   structpb.NewNumberValue(3),
+					},
+				},
+				Labels: []*entropyv1beta1.SpecChange{
+					{
+						Op:   entropyv1beta1.SpecChange_OP_ADDED,
+						Path: "team",
+						To:   structpb.NewStringValue("data"),
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			got, err := srv.DiffRevisions(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_GetResourceDependents(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.GetResourceDependentsRequest
+		want    *entropyv1beta1.GetResourceDependentsResponse
+		wantErr error
+	}{
+		{
+			name: "ResourceNotFound",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					GetDependents(mock.Anything, "orn:entropy:kubernetes:p-testdata-gl:cluster", false).
+					Return(nil, errors.ErrNotFound).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.GetResourceDependentsRequest{
+				Urn: "orn:entropy:kubernetes:p-testdata-gl:cluster",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, "not_found: requested entity not found"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					GetDependents(mock.Anything, "orn:entropy:kubernetes:p-testdata-gl:cluster", true).
+					Return([]resource.Resource{
+						{
+							URN:       "orn:entropy:firehose:p-testdata-gl:fh",
+							Kind:      "firehose",
+							Name:      "fh",
+							Project:   "p-testdata-gl",
+							CreatedAt: createdAt,
+							UpdatedAt: createdAt,
+							Spec: resource.Spec{
+								Dependencies: map[string]string{"kube_cluster": "orn:entropy:kubernetes:p-testdata-gl:cluster"},
+							},
+							State: resource.State{Status: resource.StatusCompleted},
+						},
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.GetResourceDependentsRequest{
+				Urn:        "orn:entropy:kubernetes:p-testdata-gl:cluster",
+				Transitive: true,
+			},
+			want: &entropyv1beta1.GetResourceDependentsResponse{
+				Dependents: []*entropyv1beta1.Resource{
+					{
+						Urn:       "orn:entropy:firehose:p-testdata-gl:fh",
+						Kind:      "firehose",
+						Name:      "fh",
+						Project:   "p-testdata-gl",
+						CreatedAt: timestamppb.New(createdAt),
+						UpdatedAt: timestamppb.New(createdAt),
+						Spec: &entropyv1beta1.ResourceSpec{
+							Configs: structpb.NewNullValue(),
+							Dependencies: []*entropyv1beta1.ResourceDependency{
+								{Key: "kube_cluster", Value: "orn:entropy:kubernetes:p-testdata-gl:cluster"},
+							},
+						},
+						State: &entropyv1beta1.ResourceState{
+							Status: entropyv1beta1.ResourceState_STATUS_COMPLETED,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			got, err := srv.GetResourceDependents(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.Truef(t, errors.Is(err, tt.wantErr), "'%s' != '%s'", tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_ListAuditEvents(t *testing.T) {
+	t.Parallel()
+
+	timestamp := time.Now()
+	since := timestamp.Add(-time.Hour)
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.ListAuditEventsRequest
+		want    *entropyv1beta1.ListAuditEventsResponse
+		wantErr error
+	}{
+		{
+			name: "Unsupported",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ListAuditEvents(mock.Anything, audit.Filter{}).
+					Return(nil, errors.ErrUnsupported).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ListAuditEventsRequest{},
+			want:    nil,
+			wantErr: status.Error(codes.Internal, "unsupported: requested feature is not supported"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ListAuditEvents(mock.Anything, audit.Filter{
+						Project: "p-testdata-gl",
+						UserID:  "john.doe@goto.com",
+						Since:   since.UTC(),
+						Limit:   10,
+					}).
+					Return([]audit.Event{
+						{
+							ID:        2,
+							Timestamp: timestamp,
+							Project:   "p-testdata-gl",
+							URN:       "p-testdata-gl-testname-log",
+							UserID:    "john.doe@goto.com",
+							RequestID: "req-1",
+							Action:    "scale",
+							Params:    json.RawMessage(`{"replicas": 2}`),
+							Result:    audit.ResultFailure,
+							Error:     "bad_request: request is not valid",
+						},
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ListAuditEventsRequest{
+				Project: "p-testdata-gl",
+				UserId:  "john.doe@goto.com",
+				Since:   timestamppb.New(since),
+				Limit:   10,
+			},
+			want: &entropyv1beta1.ListAuditEventsResponse{
+				Events: []*entropyv1beta1.AuditEvent{
+					{
+						Id:        "2",
+						Timestamp: timestamppb.New(timestamp),
+						Project:   "p-testdata-gl",
+						Urn:       "p-testdata-gl-testname-log",
+						UserId:    "john.doe@goto.com",
+						RequestId: "req-1",
+						Action:    "scale",
+						Params: structpb.NewStructValue(&structpb.Struct{
+							Fields: map[string]*structpb.Value{"replicas": structpb.NewNumberValue(2)},
+						}),
+						Result: audit.ResultFailure,
+						Error:  "bad_request: request is not valid",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			got, err := srv.ListAuditEvents(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.Truef(t, errors.Is(err, tt.wantErr), "'%s' != '%s'", tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_GetResourceSyncHistory(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now()
+	finishedAt := startedAt.Add(1500 * time.Millisecond)
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.GetResourceSyncHistoryRequest
+		want    *entropyv1beta1.GetResourceSyncHistoryResponse
+		wantErr error
+	}{
+		{
+			name: "ResourceNotFound",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					GetSyncHistory(mock.Anything, "p-testdata-gl-testname-log", 0).
+					Return(nil, errors.ErrNotFound).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.GetResourceSyncHistoryRequest{Urn: "p-testdata-gl-testname-log"},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, "not_found: requested entity not found"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					GetSyncHistory(mock.Anything, "p-testdata-gl-testname-log", 5).
+					Return([]resource.SyncRun{
+						{
+							ID:         7,
+							URN:        "p-testdata-gl-testname-log",
+							Step:       "consumer_reset",
+							StartedAt:  startedAt,
+							FinishedAt: finishedAt,
+							Outcome:    resource.SyncOutcomeRetry,
+							Error:      "timed out",
+							Retries:    2,
+						},
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.GetResourceSyncHistoryRequest{Urn: "p-testdata-gl-testname-log", Limit: 5},
+			want: &entropyv1beta1.GetResourceSyncHistoryResponse{
+				Runs: []*entropyv1beta1.SyncRun{
+					{
+						Id:         "7",
+						Urn:        "p-testdata-gl-testname-log",
+						Step:       "consumer_reset",
+						StartedAt:  timestamppb.New(startedAt),
+						FinishedAt: timestamppb.New(finishedAt),
+						Duration:   durationpb.New(1500 * time.Millisecond),
+						Outcome:    resource.SyncOutcomeRetry,
+						Error:      "timed out",
+						Retries:    2,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			got, err := srv.GetResourceSyncHistory(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.Truef(t, errors.Is(err, tt.wantErr), "'%s' != '%s'", tt.wantErr, err)
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_RetrySync(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+	updatedAt := createdAt.Add(1 * time.Minute)
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.RetrySyncRequest
+		want    *entropyv1beta1.RetrySyncResponse
+		wantErr error
+	}{
+		{
+			name: "NotInError",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					RetrySync(mock.Anything, "p-testdata-gl-testname-log", "", "john.doe@goto.com", withOperation).
+					Return(nil, errors.ErrInvalid.WithMsgf("cannot retry sync of resource in 'STATUS_COMPLETED'")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.RetrySyncRequest{Urn: "p-testdata-gl-testname-log"},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: cannot retry sync of resource in 'STATUS_COMPLETED'"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					RetrySync(mock.Anything, "p-testdata-gl-testname-log", "consumer_reset", "john.doe@goto.com", withOperation, core.WithBreakGlass(true)).
+					Return(&resource.Resource{
+						URN:       "p-testdata-gl-testname-log",
+						Kind:      "log",
+						Name:      "testname",
+						Project:   "p-testdata-gl",
+						CreatedAt: createdAt,
+						UpdatedAt: updatedAt,
+						State: resource.State{
+							Status: resource.StatusPending,
+						},
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.RetrySyncRequest{
+				Urn:        "p-testdata-gl-testname-log",
+				FromStep:   "consumer_reset",
+				BreakGlass: true,
+			},
+			want: &entropyv1beta1.RetrySyncResponse{
+				Resource: &entropyv1beta1.Resource{
+					Urn:       "p-testdata-gl-testname-log",
+					Kind:      "log",
+					Name:      "testname",
+					Project:   "p-testdata-gl",
+					CreatedAt: timestamppb.New(createdAt),
+					UpdatedAt: timestamppb.New(updatedAt),
+					Spec: &entropyv1beta1.ResourceSpec{
+						Configs: structpb.NewNullValue(),
+					},
+					State: &entropyv1beta1.ResourceState{
+						Status: entropyv1beta1.ResourceState_STATUS_PENDING,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.RetrySync(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+type fakeWatchStream struct {
+	grpc.ServerStream
+
+	ctx  context.Context
+	sent []*entropyv1beta1.WatchResourcesResponse
+}
+
+func (fs *fakeWatchStream) Context() context.Context { return fs.ctx }
+
+func (fs *fakeWatchStream) Send(resp *entropyv1beta1.WatchResourcesResponse) error {
+	fs.sent = append(fs.sent, resp)
+	return nil
+}
+
+func TestAPIServer_WatchResources(t *testing.T) {
+	t.Parallel()
+
+	eventAt := time.Now()
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		t.Parallel()
+
+		resourceService := &mocks.ResourceService{}
+		resourceService.EXPECT().
+			WatchResources(mock.Anything, resource.WatchSelector{Project: "foo"}, "!!").
+			Return(nil, errors.ErrInvalid.WithMsgf("invalid watch token")).Once()
+
+		srv := NewAPIServer(resourceService)
+		err := srv.WatchResources(&entropyv1beta1.WatchResourcesRequest{
+			Project:    "foo",
+			WatchToken: "!!",
+		}, &fakeWatchStream{ctx: context.Background()})
+		assert.Equal(t, status.Error(codes.InvalidArgument, "bad_request: invalid watch token").Error(), err.Error())
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		events := make(chan resource.Event, 1)
+		ev := resource.Event{
+			ID:        7,
+			Type:      resource.EventUpdated,
+			Timestamp: eventAt,
+			Resource: resource.Resource{
+				URN:       "orn:entropy:firehose:foo:bar",
+				Kind:      "firehose",
+				Name:      "bar",
+				Project:   "foo",
+				CreatedAt: eventAt,
+				UpdatedAt: eventAt,
+				Version:   2,
+				State:     resource.State{Status: resource.StatusCompleted},
+			},
+		}
+		events <- ev
+		close(events)
+
+		selector := resource.WatchSelector{
+			Kind:   "firehose",
+			Labels: map[string]string{"team": "data"},
+		}
+		resourceService := &mocks.ResourceService{}
+		resourceService.EXPECT().
+			WatchResources(mock.Anything, selector, "").
+			Return(events, nil).Once()
+
+		stream := &fakeWatchStream{ctx: context.Background()}
+		srv := NewAPIServer(resourceService)
+		require.NoError(t, srv.WatchResources(&entropyv1beta1.WatchResourcesRequest{
+			Kind:   "firehose",
+			Labels: map[string]string{"team": "data"},
+		}, stream))
+
+		want := []*entropyv1beta1.WatchResourcesResponse{
+			{
+				Event: &entropyv1beta1.ResourceEvent{
+					Type: entropyv1beta1.ResourceEvent_TYPE_UPDATED,
+					Resource: &entropyv1beta1.Resource{
+						Urn:       "orn:entropy:firehose:foo:bar",
+						Kind:      "firehose",
+						Name:      "bar",
+						Project:   "foo",
+						CreatedAt: timestamppb.New(eventAt),
+						UpdatedAt: timestamppb.New(eventAt),
+						Spec: &entropyv1beta1.ResourceSpec{
+							Configs: structpb.NewNullValue(),
+						},
+						State: &entropyv1beta1.ResourceState{
+							Status: entropyv1beta1.ResourceState_STATUS_COMPLETED,
+						},
+						Etag: "2",
+					},
+					Timestamp:  timestamppb.New(eventAt),
+					WatchToken: ev.WatchToken(),
+				},
+			},
+		}
+		if diff := cmp.Diff(want, stream.sent, protocmp.Transform()); diff != "" {
+			t.Errorf("mismatch (-want +got):\n%s", diff)
+		}
+	})
+}
+
+func TestAPIServer_CreateWebhook(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.CreateWebhookRequest
+		want    *entropyv1beta1.CreateWebhookResponse
+		wantErr error
+	}{
+		{
+			name: "InvalidURL",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreateWebhook(mock.Anything, mock.Anything).
+					Return(nil, errors.ErrInvalid.WithMsgf("url must be an absolute http(s) url")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreateWebhookRequest{
+				Webhook: &entropyv1beta1.Webhook{Project: "foo", Url: "localhost"},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: url must be an absolute http(s) url"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreateWebhook(mock.Anything, webhook.Subscription{
+						Project:   "foo",
+						URL:       "https://example.com/hook",
+						Kinds:     []string{"firehose"},
+						CreatedBy: "john.doe@goto.com",
+					}).
+					Return(&webhook.Subscription{
+						ID:        1,
+						Project:   "foo",
+						URL:       "https://example.com/hook",
+						Secret:    "s3cr3t",
+						Kinds:     []string{"firehose"},
+						CreatedAt: createdAt,
+						CreatedBy: "john.doe@goto.com",
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreateWebhookRequest{
+				Webhook: &entropyv1beta1.Webhook{
+					Project: "foo",
+					Url:     "https://example.com/hook",
+					Kinds:   []string{"firehose"},
+				},
+			},
+			want: &entropyv1beta1.CreateWebhookResponse{
+				Webhook: &entropyv1beta1.Webhook{
+					Id:        "1",
+					Project:   "foo",
+					Url:       "https://example.com/hook",
+					Secret:    "s3cr3t",
+					Kinds:     []string{"firehose"},
+					CreatedAt: timestamppb.New(createdAt),
+					CreatedBy: "john.doe@goto.com",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.CreateWebhook(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_DeleteWebhook(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.DeleteWebhookRequest
+		wantErr error
+	}{
+		{
+			name: "InvalidID",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				return NewAPIServer(&mocks.ResourceService{})
+			},
+			request: &entropyv1beta1.DeleteWebhookRequest{Id: "abc"},
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: invalid webhook id 'abc'"),
+		},
+		{
+			name: "NotFound",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					DeleteWebhook(mock.Anything, int64(7)).
+					Return(errors.ErrNotFound.WithMsgf("webhook with id '7' not found")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.DeleteWebhookRequest{Id: "7"},
+			wantErr: status.Error(codes.NotFound, "not_found: webhook with id '7' not found"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					DeleteWebhook(mock.Anything, int64(7)).
+					Return(nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.DeleteWebhookRequest{Id: "7"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			_, err := srv.DeleteWebhook(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestAPIServer_GetProjectQuota(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.GetProjectQuotaRequest
+		want    *entropyv1beta1.GetProjectQuotaResponse
+		wantErr error
+	}{
+		{
+			name: "MissingProject",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				return NewAPIServer(&mocks.ResourceService{})
+			},
+			request: &entropyv1beta1.GetProjectQuotaRequest{},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: project must be set"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					GetProjectQuota(mock.Anything, "p-testdata-gl").
+					Return(&quota.Report{
+						Project: "p-testdata-gl",
+						Limit:   quota.Limit{MaxResources: 10, MaxCPU: "4"},
+						Usage:   quota.Usage{Resources: 2, Replicas: 3, CPUMillis: 1500, MemoryBytes: 1 << 30},
+						Kinds: map[string]quota.Kind{
+							"kafka":    {Usage: quota.Usage{Resources: 1}},
+							"firehose": {Limit: quota.Limit{MaxReplicas: 5}, Usage: quota.Usage{Resources: 1, Replicas: 3, CPUMillis: 1500, MemoryBytes: 1 << 30}},
+						},
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.GetProjectQuotaRequest{Project: "p-testdata-gl"},
+			want: &entropyv1beta1.GetProjectQuotaResponse{
+				Project: "p-testdata-gl",
+				Limit:   &entropyv1beta1.QuotaLimit{MaxResources: 10, MaxCpu: "4"},
+				Usage:   &entropyv1beta1.QuotaUsage{Resources: 2, Replicas: 3, Cpu: "1500m", Memory: "1Gi"},
+				Kinds: []*entropyv1beta1.KindQuota{
+					{
+						Kind:  "firehose",
+						Limit: &entropyv1beta1.QuotaLimit{MaxReplicas: 5},
+						Usage: &entropyv1beta1.QuotaUsage{Resources: 1, Replicas: 3, Cpu: "1500m", Memory: "1Gi"},
+					},
+					{
+						Kind:  "kafka",
+						Limit: &entropyv1beta1.QuotaLimit{},
+						Usage: &entropyv1beta1.QuotaUsage{Resources: 1, Cpu: "0", Memory: "0"},
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			got, err := srv.GetProjectQuota(context.Background(), tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_CreateLock(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+	expiresAt := createdAt.Add(48 * time.Hour)
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.CreateLockRequest
+		want    *entropyv1beta1.CreateLockResponse
+		wantErr error
+	}{
+		{
+			name: "MissingReason",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreateLock(mock.Anything, mock.Anything).
+					Return(nil, errors.ErrInvalid.WithMsgf("reason must be set")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreateLockRequest{
+				Lock: &entropyv1beta1.Lock{Project: "p-testdata-gl"},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: reason must be set"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreateLock(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, l lock.Lock) (*lock.Lock, error) {
+						assert.Equal(t, "john.doe@goto.com", l.CreatedBy)
+						require.NotNil(t, l.ExpiresAt)
+						assert.True(t, expiresAt.Equal(*l.ExpiresAt))
+
+						l.ID = 4
+						l.Owner = l.CreatedBy
+						l.CreatedAt = createdAt
+						return &l, nil
+					}).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreateLockRequest{
+				Lock: &entropyv1beta1.Lock{
+					Project:   "p-testdata-gl",
+					Labels:    map[string]string{"env": "production"},
+					Reason:    "change freeze",
+					ExpiresAt: timestamppb.New(expiresAt),
+				},
+			},
+			want: &entropyv1beta1.CreateLockResponse{
+				Lock: &entropyv1beta1.Lock{
+					Id:        "4",
+					Project:   "p-testdata-gl",
+					Labels:    map[string]string{"env": "production"},
+					Reason:    "change freeze",
+					Owner:     "john.doe@goto.com",
+					ExpiresAt: timestamppb.New(expiresAt),
+					CreatedAt: timestamppb.New(createdAt),
+					CreatedBy: "john.doe@goto.com",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.CreateLock(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_CreatePolicy(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.CreatePolicyRequest
+		want    *entropyv1beta1.CreatePolicyResponse
+		wantErr error
+	}{
+		{
+			name: "InvalidExpression",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreatePolicy(mock.Anything, mock.Anything).
+					Return(nil, errors.ErrInvalid.WithMsgf("invalid expression")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreatePolicyRequest{
+				Policy: &entropyv1beta1.Policy{Name: "broken", Expression: "resource.", Mode: "deny"},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: invalid expression"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreatePolicy(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, p policy.Policy) (*policy.Policy, error) {
+						assert.Equal(t, "john.doe@goto.com", p.CreatedBy)
+
+						p.ID = 7
+						p.CreatedAt = createdAt
+						p.UpdatedAt = createdAt
+						p.UpdatedBy = p.CreatedBy
+						return &p, nil
+					}).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreatePolicyRequest{
+				Policy: &entropyv1beta1.Policy{
+					Name:       "dagger-max-replicas",
+					Kind:       "dagger",
+					Actions:    []string{"update", "scale"},
+					Expression: "resource.configs.replicas <= 16",
+					Message:    "dagger jobs may run at most 16 replicas",
+					Mode:       "deny",
+				},
+			},
+			want: &entropyv1beta1.CreatePolicyResponse{
+				Policy: &entropyv1beta1.Policy{
+					Id:         "7",
+					Name:       "dagger-max-replicas",
+					Kind:       "dagger",
+					Actions:    []string{"update", "scale"},
+					Expression: "resource.configs.replicas <= 16",
+					Message:    "dagger jobs may run at most 16 replicas",
+					Mode:       "deny",
+					CreatedAt:  timestamppb.New(createdAt),
+					UpdatedAt:  timestamppb.New(createdAt),
+					CreatedBy:  "john.doe@goto.com",
+					UpdatedBy:  "john.doe@goto.com",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.CreatePolicy(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_ApplyAction_PolicyViolations(t *testing.T) {
+	t.Parallel()
+
+	resourceService := &mocks.ResourceService{}
+	resourceService.EXPECT().
+		ApplyAction(mock.Anything, "p-testdata-gl-testname-mock-project-default", mock.Anything, mock.Anything, mock.Anything).
+		Return(nil, errors.ErrInvalid.
+			WithMsgf("'scale' on resource 'p-testdata-gl-testname-mock-project-default' denied by policy 'max-replicas': too many replicas").
+			WithViolations(errors.Violation{Type: "POLICY", Subject: "max-replicas", Description: "too many replicas"})).
+		Once()
+	srv := NewAPIServer(resourceService)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.New(map[string]string{"user-id": "john.doe@goto.com"}))
+	_, err := srv.ApplyAction(ctx, &entropyv1beta1.ApplyActionRequest{
+		Urn:    "p-testdata-gl-testname-mock-project-default",
+		Action: "scale",
+	})
+	require.Error(t, err)
+
+	st := status.Convert(err)
+	assert.Equal(t, codes.InvalidArgument, st.Code())
+	require.Len(t, st.Details(), 1)
+
+	failure, ok := st.Details()[0].(*errdetails.PreconditionFailure)
+	require.True(t, ok)
+	want := &errdetails.PreconditionFailure{
+		Violations: []*errdetails.PreconditionFailure_Violation{
+			{Type: "POLICY", Subject: "max-replicas", Description: "too many replicas"},
+		},
+	}
+	if diff := cmp.Diff(want, failure, protocmp.Transform()); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAPIServer_ScheduleAction(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+	runAt := createdAt.Add(time.Hour)
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.ScheduleActionRequest
+		want    *entropyv1beta1.ScheduleActionResponse
+		wantErr error
+	}{
+		{
+			name: "InvalidCron",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ScheduleAction(mock.Anything, mock.Anything).
+					Return(nil, errors.ErrInvalid.WithMsgf("invalid cron expression 'daily'")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ScheduleActionRequest{
+				Urn:    "p-testdata-gl-testname-log",
+				Action: "stop",
+				Cron:   "daily",
+			},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: invalid cron expression 'daily'"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					ScheduleAction(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, s schedule.Schedule) (*schedule.Schedule, error) {
+						assert.Equal(t, "john.doe@goto.com", s.CreatedBy)
+						assert.True(t, runAt.Equal(s.RunAt))
+						assert.JSONEq(t, `{"replicas":10}`, string(s.Action.Params))
+
+						s.ID = 3
+						s.Project = "p-testdata-gl"
+						s.Status = schedule.StatusPending
+						s.NextRunAt = s.RunAt
+						s.CreatedAt = createdAt
+						return &s, nil
+					}).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.ScheduleActionRequest{
+				Urn:    "p-testdata-gl-testname-log",
+				Action: "scale",
+				Params: structpb.NewStructValue(&structpb.Struct{
+					Fields: map[string]*structpb.Value{"replicas": structpb.NewNumberValue(10)},
+				}),
+				RunAt: timestamppb.New(runAt),
+			},
+			want: &entropyv1beta1.ScheduleActionResponse{
+				ScheduledAction: &entropyv1beta1.ScheduledAction{
+					Id:      "3",
+					Urn:     "p-testdata-gl-testname-log",
+					Project: "p-testdata-gl",
+					Action:  "scale",
+					Params: structpb.NewStructValue(&structpb.Struct{
+						Fields: map[string]*structpb.Value{"replicas": structpb.NewNumberValue(10)},
+					}),
+					RunAt:     timestamppb.New(runAt),
+					Status:    schedule.StatusPending,
+					NextRunAt: timestamppb.New(runAt),
+					CreatedAt: timestamppb.New(createdAt),
+					CreatedBy: "john.doe@goto.com",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.ScheduleAction(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_BulkApplyAction(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.BulkApplyActionRequest
+		want    *entropyv1beta1.BulkApplyActionResponse
+		wantErr error
+	}{
+		{
+			name: "NoProject",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					BulkApplyAction(mock.Anything, mock.Anything).
+					Return(nil, errors.ErrInvalid.WithMsgf("project must be set for bulk actions")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.BulkApplyActionRequest{Action: "stop"},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: project must be set for bulk actions"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					BulkApplyAction(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, req core.BulkActionRequest) ([]core.BulkActionResult, error) {
+						assert.Equal(t, resource.Filter{
+							Project: "p-testdata-gl",
+							Kind:    "log",
+							Labels:  map[string]string{"team": "payments"},
+						}, req.Selector)
+						assert.Equal(t, "stop", req.Action.Name)
+						assert.Equal(t, "john.doe@goto.com", req.Action.UserID)
+						assert.Equal(t, 3, req.BatchSize)
+
+						return []core.BulkActionResult{
+							{
+								URN: "p-testdata-gl-a-log",
+								Resource: &resource.Resource{
+									URN:       "p-testdata-gl-a-log",
+									Kind:      "log",
+									Name:      "a",
+									Project:   "p-testdata-gl",
+									CreatedAt: createdAt,
+									UpdatedAt: createdAt,
+									State:     resource.State{Status: resource.StatusPending},
+								},
+							},
+							{
+								URN: "p-testdata-gl-b-log",
+								Err: errors.ErrInvalid.WithMsgf("cannot perform 'stop' on resource in 'STATUS_PENDING'"),
+							},
+						}, nil
+					}).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.BulkApplyActionRequest{
+				Project:   "p-testdata-gl",
+				Kind:      "log",
+				Labels:    map[string]string{"team": "payments"},
+				Action:    "stop",
+				BatchSize: 3,
+			},
+			want: &entropyv1beta1.BulkApplyActionResponse{
+				Results: []*entropyv1beta1.BulkActionResult{
+					{
+						Urn:     "p-testdata-gl-a-log",
+						Success: true,
+						Resource: &entropyv1beta1.Resource{
+							Urn:       "p-testdata-gl-a-log",
+							Kind:      "log",
+							Name:      "a",
+							Project:   "p-testdata-gl",
+							CreatedAt: timestamppb.New(createdAt),
+							UpdatedAt: timestamppb.New(createdAt),
+							Spec: &entropyv1beta1.ResourceSpec{
+								Configs: structpb.NewNullValue(),
+							},
+							State: &entropyv1beta1.ResourceState{
+								Status: entropyv1beta1.ResourceState_STATUS_PENDING,
+							},
+						},
+					},
+					{
+						Urn:   "p-testdata-gl-b-log",
+						Error: "bad_request: cannot perform 'stop' on resource in 'STATUS_PENDING'",
+					},
+				},
+				Succeeded: 1,
+				Failed:    1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.BulkApplyAction(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_CreateRoleBinding(t *testing.T) {
+	t.Parallel()
+
+	createdAt := time.Now()
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.CreateRoleBindingRequest
+		want    *entropyv1beta1.CreateRoleBindingResponse
+		wantErr error
+	}{
+		{
+			name: "PermissionDenied",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreateRoleBinding(mock.Anything, mock.Anything).
+					Return(nil, errors.ErrForbidden.WithMsgf("user 'john.doe@goto.com' is not allowed to 'manage' in project 'foo'")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreateRoleBindingRequest{
+				RoleBinding: &entropyv1beta1.RoleBinding{Project: "foo", User: "john.doe@goto.com", Role: "admin"},
+			},
+			want:    nil,
+			wantErr: status.Error(codes.PermissionDenied, "forbidden: user 'john.doe@goto.com' is not allowed to 'manage' in project 'foo'"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					CreateRoleBinding(mock.Anything, mock.Anything).
+					RunAndReturn(func(_ context.Context, b rbac.Binding) (*rbac.Binding, error) {
+						assert.Equal(t, "john.doe@goto.com", b.CreatedBy)
+
+						b.ID = 3
+						b.CreatedAt = createdAt
+						return &b, nil
+					}).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.CreateRoleBindingRequest{
+				RoleBinding: &entropyv1beta1.RoleBinding{
+					Project: "foo",
+					Group:   "oncall",
+					Role:    "operator",
+					Actions: []string{"scale"},
+				},
+			},
+			want: &entropyv1beta1.CreateRoleBindingResponse{
+				RoleBinding: &entropyv1beta1.RoleBinding{
+					Id:        "3",
+					Project:   "foo",
+					Group:     "oncall",
+					Role:      "operator",
+					Actions:   []string{"scale"},
+					CreatedAt: timestamppb.New(createdAt),
+					CreatedBy: "john.doe@goto.com",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			ctx := context.Background()
+			md := metadata.New(map[string]string{"user-id": "john.doe@goto.com"})
+			ctx = metadata.NewIncomingContext(ctx, md)
+
+			got, err := srv.CreateRoleBinding(ctx, tt.request)
+			if tt.wantErr != nil {
+				assert.Error(t, err)
+				assert.True(t, errors.Is(err, tt.wantErr))
+			} else {
+				assert.NoError(t, err)
+				if diff := cmp.Diff(tt.want, got, protocmp.Transform()); diff != "" {
+					t.Errorf("mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestAPIServer_DeleteResource_Operation(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now()
+
+	resourceService := &mocks.ResourceService{}
+	resourceService.EXPECT().
+		DeleteResource(mock.Anything, "p-testdata-gl-testname-log", core.WithCascade(false), withOperation).
+		RunAndReturn(func(_ context.Context, urn string, opts ...core.Options) error {
+			*opts[1].Operation = operation.Operation{
+				ID:        7,
+				URN:       urn,
+				Project:   "p-testdata-gl",
+				Action:    module.DeleteAction,
+				UserID:    "john.doe@goto.com",
+				Status:    operation.StatusPending,
+				StartedAt: startedAt,
+			}
+			return nil
+		}).Once()
+	srv := NewAPIServer(resourceService)
+
+	got, err := srv.DeleteResource(context.Background(), &entropyv1beta1.DeleteResourceRequest{
+		Urn: "p-testdata-gl-testname-log",
+	})
+	require.NoError(t, err)
+
+	want := &entropyv1beta1.DeleteResourceResponse{
+		Operation: &entropyv1beta1.Operation{
+			Id:        "7",
+			Urn:       "p-testdata-gl-testname-log",
+			Project:   "p-testdata-gl",
+			Action:    module.DeleteAction,
+			UserId:    "john.doe@goto.com",
+			Status:    operation.StatusPending,
+			StartedAt: timestamppb.New(startedAt),
+		},
+	}
+	if diff := cmp.Diff(want, got, protocmp.Transform()); diff != "" {
+		t.Errorf("mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestAPIServer_GetOperation(t *testing.T) {
+	t.Parallel()
+
+	startedAt := time.Now()
+	finishedAt := startedAt.Add(time.Minute)
+
+	tests := []struct {
+		name    string
+		setup   func(t *testing.T) *APIServer
+		request *entropyv1beta1.GetOperationRequest
+		want    *entropyv1beta1.GetOperationResponse
+		wantErr error
+	}{
+		{
+			name: "InvalidID",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				return NewAPIServer(&mocks.ResourceService{})
+			},
+			request: &entropyv1beta1.GetOperationRequest{Id: "abc"},
+			want:    nil,
+			wantErr: status.Error(codes.InvalidArgument, "bad_request: invalid operation id 'abc'"),
+		},
+		{
+			name: "NotFound",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					GetOperation(mock.Anything, int64(9)).
+					Return(nil, errors.ErrNotFound.WithMsgf("operation with id '9' not found")).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.GetOperationRequest{Id: "9"},
+			want:    nil,
+			wantErr: status.Error(codes.NotFound, "not_found: operation with id '9' not found"),
+		},
+		{
+			name: "Success",
+			setup: func(t *testing.T) *APIServer {
+				t.Helper()
+				resourceService := &mocks.ResourceService{}
+				resourceService.EXPECT().
+					GetOperation(mock.Anything, int64(3)).
+					Return(&operation.Operation{
+						ID:         3,
+						URN:        "p-testdata-gl-testname-log",
+						Project:    "p-testdata-gl",
+						Action:     "scale",
+						Params:     []byte(`{"replicas":2}`),
+						UserID:     "john.doe@goto.com",
+						Status:     operation.StatusFailed,
+						Error:      "quota exceeded",
+						StartedAt:  startedAt,
+						FinishedAt: &finishedAt,
+					}, nil).Once()
+				return NewAPIServer(resourceService)
+			},
+			request: &entropyv1beta1.GetOperationRequest{Id: "3"},
+			want: &entropyv1beta1.GetOperationResponse{
+				Operation: &entropyv1beta1.Operation{
+					Id:      "3",
+					Urn:     "p-testdata-gl-testname-log",
+					Project: "p-testdata-gl",
+					Action:  "scale",
+					Params: structpb.NewStructValue(&structpb.Struct{Fields: map[string]*structpb.Value{
+						"replicas": structpb.NewNumberValue(2),
+					}}),
+					UserId:     "john.doe@goto.com",
+					Status:     operation.StatusFailed,
+					Error:      "quota exceeded",
+					StartedAt:  timestamppb.New(startedAt),
+					FinishedAt: timestamppb.New(finishedAt),
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			srv := tt.setup(t)
+
+			got, err := srv.GetOperation(context.Background(), tt.request)
 			if tt.wantErr != nil {
 				assert.Error(t, err)
 				assert.True(t, errors.Is(err, tt.wantErr))
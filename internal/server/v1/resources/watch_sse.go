@@ -0,0 +1,109 @@
+package resources
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/server/serverutils"
+)
+
+// sseKeepAliveInterval is how often a comment is sent on idle streams so
+// that proxies do not drop the connection.
+const sseKeepAliveInterval = 30 * time.Second
+
+// WatchHandler serves WatchResources as server-sent events, since the HTTP
+// gateway does not support streaming calls. The selector is read from the
+// query parameters of the request (project, kind, labels[key]=value) and
+// every event carries its watch token as the event id. A reconnecting
+// client resumes through the Last-Event-ID header.
+type WatchHandler struct {
+	resourceSvc ResourceService
+}
+
+func NewWatchHandler(resourceSvc ResourceService) *WatchHandler {
+	return &WatchHandler{resourceSvc: resourceSvc}
+}
+
+func (h *WatchHandler) ServeHTTP(wr http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+
+	selector := resource.WatchSelector{
+		Project: query.Get("project"),
+		Kind:    query.Get("kind"),
+		Labels:  map[string]string{},
+	}
+	for key, values := range query {
+		if strings.HasPrefix(key, "labels[") && strings.HasSuffix(key, "]") && len(values) > 0 {
+			selector.Labels[strings.TrimSuffix(strings.TrimPrefix(key, "labels["), "]")] = values[0]
+		}
+	}
+
+	token := query.Get("watch_token")
+	if lastEventID := req.Header.Get("Last-Event-ID"); lastEventID != "" {
+		token = lastEventID
+	}
+
+	ctx := req.Context()
+	events, err := h.resourceSvc.WatchResources(ctx, selector, token)
+	if err != nil {
+		st, _ := status.FromError(serverutils.ToRPCError(err))
+		http.Error(wr, st.Message(), runtime.HTTPStatusFromCode(st.Code()))
+		return
+	}
+
+	rc := http.NewResponseController(wr)
+	wr.Header().Set("Content-Type", "text/event-stream")
+	wr.Header().Set("Cache-Control", "no-cache")
+	wr.Header().Set("Connection", "keep-alive")
+	wr.WriteHeader(http.StatusOK)
+	if err := rc.Flush(); err != nil {
+		return
+	}
+
+	marshaller := protojson.MarshalOptions{UseProtoNames: true}
+
+	keepAlive := time.NewTicker(sseKeepAliveInterval)
+	defer keepAlive.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-keepAlive.C:
+			if _, err := fmt.Fprint(wr, ": keep-alive\n\n"); err != nil {
+				return
+			}
+
+		case ev, open := <-events:
+			if !open {
+				return
+			}
+
+			protoEvent, err := resourceEventToProto(ev)
+			if err != nil {
+				return
+			}
+
+			data, err := marshaller.Marshal(protoEvent)
+			if err != nil {
+				return
+			}
+
+			if _, err := fmt.Fprintf(wr, "id: %s\nevent: %s\ndata: %s\n\n", protoEvent.GetWatchToken(), ev.Type, data); err != nil {
+				return
+			}
+		}
+
+		if err := rc.Flush(); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package resources
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/internal/server/v1/mocks"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestWatchHandler(t *testing.T) {
+	t.Parallel()
+
+	t.Run("InvalidToken", func(t *testing.T) {
+		t.Parallel()
+
+		resourceService := &mocks.ResourceService{}
+		resourceService.EXPECT().
+			WatchResources(mock.Anything, resource.WatchSelector{Labels: map[string]string{}}, "!!").
+			Return(nil, errors.ErrInvalid.WithMsgf("invalid watch token")).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1beta1/resources:watch", nil)
+		req.Header.Set("Last-Event-ID", "!!")
+		rec := httptest.NewRecorder()
+		NewWatchHandler(resourceService).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusBadRequest, rec.Code)
+	})
+
+	t.Run("Success", func(t *testing.T) {
+		t.Parallel()
+
+		ev := resource.Event{
+			ID:        3,
+			Type:      resource.EventCreated,
+			Timestamp: time.Now(),
+			Resource: resource.Resource{
+				URN:     "orn:entropy:firehose:foo:bar",
+				Kind:    "firehose",
+				Project: "foo",
+				Version: 1,
+			},
+		}
+		events := make(chan resource.Event, 1)
+		events <- ev
+		close(events)
+
+		selector := resource.WatchSelector{
+			Project: "foo",
+			Kind:    "firehose",
+			Labels:  map[string]string{"team": "data"},
+		}
+		resourceService := &mocks.ResourceService{}
+		resourceService.EXPECT().
+			WatchResources(mock.Anything, selector, "").
+			Return(events, nil).Once()
+
+		req := httptest.NewRequest(http.MethodGet, "/api/v1beta1/resources:watch?project=foo&kind=firehose&labels[team]=data", nil)
+		rec := httptest.NewRecorder()
+		NewWatchHandler(resourceService).ServeHTTP(rec, req)
+
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+		assert.Contains(t, rec.Body.String(), "id: "+ev.WatchToken()+"\nevent: created\ndata: {")
+		assert.Contains(t, rec.Body.String(), `"orn:entropy:firehose:foo:bar"`)
+	})
+}
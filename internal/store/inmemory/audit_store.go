@@ -0,0 +1,42 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/goto/entropy/core/audit"
+)
+
+func (st *Store) AppendAuditEvent(_ context.Context, event audit.Event) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.lastAuditEventID++
+	event.ID = st.lastAuditEventID
+	if event.Timestamp.IsZero() {
+		event.Timestamp = st.clock()
+	}
+	event.Params = cloneBytes(event.Params)
+
+	st.auditEvents = append(st.auditEvents, event)
+	return nil
+}
+
+func (st *Store) ListAuditEvents(_ context.Context, filter audit.Filter) ([]audit.Event, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	var events []audit.Event
+	for i := len(st.auditEvents) - 1; i >= 0; i-- {
+		event := st.auditEvents[i]
+		if !filter.Matches(event) {
+			continue
+		}
+
+		event.Params = cloneBytes(event.Params)
+		events = append(events, event)
+		if filter.Limit > 0 && len(events) == filter.Limit {
+			break
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,98 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// maxResourceEvents is the number of resource events retained, older events
+// are dropped.
+const maxResourceEvents = 1000
+
+func (st *Store) ResourceEvents(_ context.Context, afterID int64, limit int) ([]resource.Event, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	if len(st.resourceEvents) > 0 && afterID < st.resourceEvents[0].ID-1 {
+		return nil, errors.ErrInvalid.WithMsgf("watch token has expired")
+	}
+
+	var events []resource.Event
+	for _, ev := range st.resourceEvents {
+		if ev.ID <= afterID {
+			continue
+		}
+
+		ev.Resource = cloneResource(ev.Resource)
+		events = append(events, ev)
+		if limit > 0 && len(events) == limit {
+			break
+		}
+	}
+	return events, nil
+}
+
+func (st *Store) LastResourceEventID(_ context.Context) (int64, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	return st.lastResourceEventID, nil
+}
+
+func (st *Store) PruneResourceEvents(_ context.Context, before time.Time) (int, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	pruned := 0
+	for pruned < len(st.resourceEvents)-1 && st.resourceEvents[pruned].Timestamp.Before(before) {
+		pruned++
+	}
+	st.resourceEvents = st.resourceEvents[pruned:]
+	return pruned, nil
+}
+
+func (st *Store) ResourceEventNotifications(ctx context.Context) (<-chan struct{}, error) {
+	ch := make(chan struct{}, 1)
+
+	st.subsMu.Lock()
+	st.eventSubs[ch] = struct{}{}
+	st.subsMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		st.subsMu.Lock()
+		delete(st.eventSubs, ch)
+		close(ch)
+		st.subsMu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+// appendResourceEvent records the mutation of the resource and wakes up the
+// watchers. Must be called with st.mu held.
+func (st *Store) appendResourceEvent(eventType string, r resource.Resource) {
+	st.lastResourceEventID++
+	ev := resource.NewEvent(eventType, cloneResource(r), st.clock())
+	ev.ID = st.lastResourceEventID
+
+	st.resourceEvents = append(st.resourceEvents, ev)
+	if len(st.resourceEvents) > maxResourceEvents {
+		st.resourceEvents = st.resourceEvents[len(st.resourceEvents)-maxResourceEvents:]
+	}
+
+	st.subsMu.Lock()
+	defer st.subsMu.Unlock()
+
+	for ch := range st.eventSubs {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// a wake-up is pending already.
+		}
+	}
+}
@@ -0,0 +1,62 @@
+package inmemory
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+
+	"github.com/goto/entropy/core/idempotency"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateIdempotencyRecord(_ context.Context, r idempotency.Record) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if existing, found := st.idempotencyRecords[r.Key]; found && existing.ExpiresAt.After(r.CreatedAt) {
+		return errors.ErrConflict.WithCausef("idempotency key '%s' is in use", r.Key)
+	}
+
+	r.Response = slices.Clone(r.Response)
+	st.idempotencyRecords[r.Key] = r
+	return nil
+}
+
+func (st *Store) GetIdempotencyRecord(_ context.Context, key string) (*idempotency.Record, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	r, found := st.idempotencyRecords[key]
+	if !found {
+		return nil, errors.ErrNotFound.WithCausef("idempotency key '%s' not found", key)
+	}
+
+	r.Response = slices.Clone(r.Response)
+	return &r, nil
+}
+
+func (st *Store) CompleteIdempotencyRecord(_ context.Context, key string, response json.RawMessage) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	r, found := st.idempotencyRecords[key]
+	if !found {
+		return errors.ErrNotFound.WithCausef("idempotency key '%s' not found", key)
+	}
+
+	r.Status = idempotency.StatusCompleted
+	r.Response = slices.Clone(response)
+	st.idempotencyRecords[key] = r
+	return nil
+}
+
+func (st *Store) DeleteIdempotencyRecord(_ context.Context, key string) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, found := st.idempotencyRecords[key]; !found {
+		return errors.ErrNotFound.WithCausef("idempotency key '%s' not found", key)
+	}
+	delete(st.idempotencyRecords, key)
+	return nil
+}
@@ -0,0 +1,195 @@
+package inmemory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/goto/entropy/core/audit"
+	"github.com/goto/entropy/core/idempotency"
+	"github.com/goto/entropy/core/lock"
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// Store is an in-memory implementation of resource.Store, module.Store,
+// audit.Store, webhook.Store, schedule.Store, lock.Store, policy.Store,
+// rbac.Store, idempotency.Store, operation.Store and the optional resource
+// store capabilities. It is meant for tests and local development where
+// running PostgresQL is not desirable.
+// All state is lost when the process exits.
+type Store struct {
+	mu              sync.RWMutex
+	clock           func() time.Time
+	extendInterval  time.Duration
+	refreshInterval time.Duration
+	config          Config
+
+	lastResourceID      int64
+	lastRevisionID      int64
+	lastAuditEventID    int64
+	lastSyncRunID       int64
+	lastResourceEventID int64
+	lastWebhookID       int64
+	lastDeliveryID      int64
+	lastScheduleID      int64
+	lastLockID          int64
+	lastPolicyID        int64
+	lastRoleBindingID   int64
+	lastOperationID     int64
+	resources           map[string]*resourceRecord
+	revisions           map[string][]revisionRecord
+	modules             map[string]module.Module
+	auditEvents         []audit.Event
+	syncRuns            map[string][]resource.SyncRun
+	resourceEvents      []resource.Event
+	webhooks            map[int64]webhook.Subscription
+	deliveries          []webhook.Delivery
+	schedules           map[int64]schedule.Schedule
+	locks               map[int64]lock.Lock
+	policies            map[int64]policy.Policy
+	roleBindings        map[int64]rbac.Binding
+	idempotencyRecords  map[string]idempotency.Record
+	operations          map[int64]operation.Operation
+
+	subsMu    sync.Mutex
+	syncSubs  map[chan struct{}]struct{}
+	eventSubs map[chan struct{}]struct{}
+}
+
+type Config struct {
+	PaginationSizeDefault int32
+	PaginationPageDefault int32
+}
+
+type resourceRecord struct {
+	id  int64
+	res resource.Resource
+}
+
+type revisionRecord struct {
+	id           int64
+	reason       string
+	labels       map[string]string
+	configs      []byte
+	dependencies map[string]string
+	createdAt    time.Time
+	createdBy    string
+}
+
+// Open returns an empty in-memory store instance. refreshInterval and
+// extendInterval have the same meaning as in the postgres store.
+func Open(refreshInterval, extendInterval time.Duration, paginationSizeDefault, paginationPageDefault int32) (*Store, error) {
+	if refreshInterval >= extendInterval {
+		return nil, errors.New("refreshInterval must be lower than extendInterval")
+	}
+
+	return &Store{
+		clock:           time.Now,
+		extendInterval:  extendInterval,
+		refreshInterval: refreshInterval,
+		config: Config{
+			PaginationSizeDefault: paginationSizeDefault,
+			PaginationPageDefault: paginationPageDefault,
+		},
+		resources:    map[string]*resourceRecord{},
+		revisions:    map[string][]revisionRecord{},
+		modules:      map[string]module.Module{},
+		syncRuns:     map[string][]resource.SyncRun{},
+		webhooks:     map[int64]webhook.Subscription{},
+		schedules:    map[int64]schedule.Schedule{},
+		locks:        map[int64]lock.Lock{},
+		policies:     map[int64]policy.Policy{},
+		roleBindings: map[int64]rbac.Binding{},
+		operations:   map[int64]operation.Operation{},
+		syncSubs:     map[chan struct{}]struct{}{},
+		eventSubs:    map[chan struct{}]struct{}{},
+
+		idempotencyRecords: map[string]idempotency.Record{},
+	}, nil
+}
+
+// Migrate is a no-op for the in-memory store.
+func (st *Store) Migrate(_ context.Context) error { return nil }
+
+func (st *Store) Close() error { return nil }
+
+// runAllHooks runs the hooks of a mutation, which holds the lock of the
+// store. Stores called from the hooks must not lock it again, see lock.
+func runAllHooks(ctx context.Context, hooks []resource.MutationHook) error {
+	ctx = context.WithValue(ctx, hookKey{}, true)
+	for _, hook := range hooks {
+		if err := hook(ctx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type hookKey struct{}
+
+// lock locks the store for writing, unless called from the hooks of a
+// mutation that holds the lock already. The returned func unlocks it.
+func (st *Store) lock(ctx context.Context) func() {
+	if inHook(ctx) {
+		return func() {}
+	}
+	st.mu.Lock()
+	return st.mu.Unlock
+}
+
+// rlock is lock for reading.
+func (st *Store) rlock(ctx context.Context) func() {
+	if inHook(ctx) {
+		return func() {}
+	}
+	st.mu.RLock()
+	return st.mu.RUnlock
+}
+
+func inHook(ctx context.Context) bool {
+	inHook, _ := ctx.Value(hookKey{}).(bool)
+	return inHook
+}
+
+func cloneResource(r resource.Resource) resource.Resource {
+	cloned := r
+	cloned.Labels = cloneMap(r.Labels)
+	cloned.Spec = resource.Spec{
+		Configs:      cloneBytes(r.Spec.Configs),
+		Dependencies: cloneMap(r.Spec.Dependencies),
+	}
+	cloned.State.Output = cloneBytes(r.State.Output)
+	cloned.State.ModuleData = cloneBytes(r.State.ModuleData)
+	if r.State.PreviousDependencies != nil {
+		cloned.State.PreviousDependencies = cloneMap(r.State.PreviousDependencies)
+	}
+	if r.State.NextSyncAt != nil {
+		nextSyncAt := *r.State.NextSyncAt
+		cloned.State.NextSyncAt = &nextSyncAt
+	}
+	return cloned
+}
+
+func cloneMap(m map[string]string) map[string]string {
+	res := make(map[string]string, len(m))
+	for k, v := range m {
+		res[k] = v
+	}
+	return res
+}
+
+func cloneBytes(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	res := make([]byte, len(b))
+	copy(res, b)
+	return res
+}
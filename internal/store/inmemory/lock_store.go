@@ -0,0 +1,73 @@
+package inmemory
+
+import (
+	"context"
+	"maps"
+	"sort"
+
+	"github.com/goto/entropy/core/lock"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateLock(_ context.Context, l lock.Lock) (*lock.Lock, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.lastLockID++
+	l.ID = st.lastLockID
+	if l.CreatedAt.IsZero() {
+		l.CreatedAt = st.clock()
+	}
+
+	st.locks[l.ID] = cloneLock(l)
+	created := cloneLock(l)
+	return &created, nil
+}
+
+func (st *Store) GetLock(_ context.Context, id int64) (*lock.Lock, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	l, found := st.locks[id]
+	if !found {
+		return nil, errors.ErrNotFound.WithCausef("lock with id '%d' not found", id)
+	}
+
+	l = cloneLock(l)
+	return &l, nil
+}
+
+func (st *Store) ListLocks(_ context.Context, project string) ([]lock.Lock, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	var locks []lock.Lock
+	for _, l := range st.locks {
+		if project == "" || l.Project == project {
+			locks = append(locks, cloneLock(l))
+		}
+	}
+
+	sort.Slice(locks, func(i, j int) bool { return locks[i].ID < locks[j].ID })
+	return locks, nil
+}
+
+func (st *Store) DeleteLock(_ context.Context, id int64) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, found := st.locks[id]; !found {
+		return errors.ErrNotFound.WithCausef("lock with id '%d' not found", id)
+	}
+	delete(st.locks, id)
+	return nil
+}
+
+func cloneLock(l lock.Lock) lock.Lock {
+	l.Labels = maps.Clone(l.Labels)
+	if l.ExpiresAt != nil {
+		expiresAt := *l.ExpiresAt
+		l.ExpiresAt = &expiresAt
+	}
+	return l
+}
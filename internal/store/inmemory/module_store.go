@@ -0,0 +1,77 @@
+package inmemory
+
+import (
+	"context"
+	"sort"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) GetModule(_ context.Context, urn string) (*module.Module, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	mod, found := st.modules[urn]
+	if !found {
+		return nil, errors.ErrNotFound
+	}
+
+	mod.Configs = cloneBytes(mod.Configs)
+	return &mod, nil
+}
+
+func (st *Store) ListModules(_ context.Context, project string) ([]module.Module, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	var mods []module.Module
+	for _, mod := range st.modules {
+		if project != "" && mod.Project != project {
+			continue
+		}
+		mod.Configs = cloneBytes(mod.Configs)
+		mods = append(mods, mod)
+	}
+
+	sort.Slice(mods, func(i, j int) bool { return mods[i].URN < mods[j].URN })
+	return mods, nil
+}
+
+func (st *Store) CreateModule(_ context.Context, m module.Module) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, exists := st.modules[m.URN]; exists {
+		return errors.ErrConflict.WithCausef("module with urn '%s' already exists", m.URN)
+	}
+
+	m.Configs = cloneBytes(m.Configs)
+	st.modules[m.URN] = m
+	return nil
+}
+
+func (st *Store) UpdateModule(_ context.Context, m module.Module) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	existing, found := st.modules[m.URN]
+	if !found {
+		// same as the postgres store, updating a non-existent module
+		// is a no-op.
+		return nil
+	}
+
+	existing.Configs = cloneBytes(m.Configs)
+	existing.UpdatedAt = st.clock()
+	st.modules[m.URN] = existing
+	return nil
+}
+
+func (st *Store) DeleteModule(_ context.Context, urn string) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	delete(st.modules, urn)
+	return nil
+}
@@ -0,0 +1,48 @@
+package inmemory_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func TestStore_Modules(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	mods := []module.Module{
+		{URN: "orn:entropy:module:project-a:firehose", Name: "firehose", Project: "project-a", Configs: json.RawMessage(`{}`)},
+		{URN: "orn:entropy:module:project-b:firehose", Name: "firehose", Project: "project-b", Configs: json.RawMessage(`{}`)},
+	}
+	for _, mod := range mods {
+		require.NoError(t, store.CreateModule(ctx, mod))
+	}
+	assert.ErrorIs(t, store.CreateModule(ctx, mods[0]), errors.ErrConflict)
+
+	got, err := store.ListModules(ctx, "project-b")
+	require.NoError(t, err)
+	assert.Equal(t, []module.Module{mods[1]}, got)
+
+	all, err := store.ListModules(ctx, "")
+	require.NoError(t, err)
+	assert.Len(t, all, 2)
+
+	updated := mods[0]
+	updated.Configs = json.RawMessage(`{"foo":"bar"}`)
+	require.NoError(t, store.UpdateModule(ctx, updated))
+
+	mod, err := store.GetModule(ctx, updated.URN)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"foo":"bar"}`, string(mod.Configs))
+
+	require.NoError(t, store.DeleteModule(ctx, updated.URN))
+	_, err = store.GetModule(ctx, updated.URN)
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+}
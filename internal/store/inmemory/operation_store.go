@@ -0,0 +1,79 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateOperation(ctx context.Context, op operation.Operation) (*operation.Operation, error) {
+	defer st.lock(ctx)()
+
+	st.lastOperationID++
+	op.ID = st.lastOperationID
+	if op.StartedAt.IsZero() {
+		op.StartedAt = st.clock()
+	}
+
+	st.operations[op.ID] = cloneOperation(op)
+	created := cloneOperation(op)
+	return &created, nil
+}
+
+func (st *Store) GetOperation(ctx context.Context, id int64) (*operation.Operation, error) {
+	defer st.rlock(ctx)()
+
+	op, found := st.operations[id]
+	if !found {
+		return nil, errors.ErrNotFound.WithCausef("operation with id '%d' not found", id)
+	}
+
+	op = cloneOperation(op)
+	return &op, nil
+}
+
+func (st *Store) ListOperations(ctx context.Context, filter operation.Filter) ([]operation.Operation, error) {
+	defer st.rlock(ctx)()
+
+	var ops []operation.Operation
+	for _, op := range st.operations {
+		if filter.Matches(op) {
+			ops = append(ops, cloneOperation(op))
+		}
+	}
+
+	sort.Slice(ops, func(i, j int) bool { return ops[i].ID > ops[j].ID })
+	if filter.Limit > 0 && len(ops) > filter.Limit {
+		ops = ops[:filter.Limit]
+	}
+	return ops, nil
+}
+
+func (st *Store) UpdateOperation(ctx context.Context, op operation.Operation) error {
+	defer st.lock(ctx)()
+
+	existing, found := st.operations[op.ID]
+	if !found {
+		return errors.ErrNotFound.WithCausef("operation with id '%d' not found", op.ID)
+	}
+
+	existing.Status = op.Status
+	existing.PendingSteps = op.PendingSteps
+	existing.Error = op.Error
+	existing.FinishedAt = op.FinishedAt
+	st.operations[op.ID] = cloneOperation(existing)
+	return nil
+}
+
+func cloneOperation(op operation.Operation) operation.Operation {
+	op.Params = cloneBytes(op.Params)
+	op.PendingSteps = slices.Clone(op.PendingSteps)
+	if op.FinishedAt != nil {
+		finishedAt := *op.FinishedAt
+		op.FinishedAt = &finishedAt
+	}
+	return op
+}
@@ -0,0 +1,96 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreatePolicy(_ context.Context, p policy.Policy) (*policy.Policy, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if st.policyNameTaken(p) {
+		return nil, errors.ErrConflict.WithCausef("policy with name '%s' already exists", p.Name)
+	}
+
+	st.lastPolicyID++
+	p.ID = st.lastPolicyID
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = st.clock()
+		p.UpdatedAt = p.CreatedAt
+	}
+
+	st.policies[p.ID] = clonePolicy(p)
+	created := clonePolicy(p)
+	return &created, nil
+}
+
+func (st *Store) GetPolicy(_ context.Context, id int64) (*policy.Policy, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	p, found := st.policies[id]
+	if !found {
+		return nil, errors.ErrNotFound.WithCausef("policy with id '%d' not found", id)
+	}
+
+	p = clonePolicy(p)
+	return &p, nil
+}
+
+func (st *Store) ListPolicies(_ context.Context) ([]policy.Policy, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	policies := make([]policy.Policy, 0, len(st.policies))
+	for _, p := range st.policies {
+		policies = append(policies, clonePolicy(p))
+	}
+
+	sort.Slice(policies, func(i, j int) bool { return policies[i].ID < policies[j].ID })
+	return policies, nil
+}
+
+func (st *Store) UpdatePolicy(_ context.Context, p policy.Policy) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, found := st.policies[p.ID]; !found {
+		return errors.ErrNotFound.WithCausef("policy with id '%d' not found", p.ID)
+	} else if st.policyNameTaken(p) {
+		return errors.ErrConflict.WithCausef("policy with name '%s' already exists", p.Name)
+	}
+
+	st.policies[p.ID] = clonePolicy(p)
+	return nil
+}
+
+func (st *Store) DeletePolicy(_ context.Context, id int64) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, found := st.policies[id]; !found {
+		return errors.ErrNotFound.WithCausef("policy with id '%d' not found", id)
+	}
+	delete(st.policies, id)
+	return nil
+}
+
+// policyNameTaken returns true if another policy has the name of p.
+func (st *Store) policyNameTaken(p policy.Policy) bool {
+	for _, existing := range st.policies {
+		if existing.ID != p.ID && existing.Name == p.Name {
+			return true
+		}
+	}
+	return false
+}
+
+func clonePolicy(p policy.Policy) policy.Policy {
+	p.Actions = slices.Clone(p.Actions)
+	return p
+}
@@ -0,0 +1,9 @@
+package inmemory
+
+import "context"
+
+// LockProject does nothing since the writes to the store, along with their
+// hooks, are already made one at a time.
+func (st *Store) LockProject(_ context.Context, _ string) error {
+	return nil
+}
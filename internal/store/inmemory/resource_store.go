@@ -0,0 +1,418 @@
+package inmemory
+
+import (
+	"context"
+	"encoding/base64"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) GetByURN(ctx context.Context, urn string) (*resource.Resource, error) {
+	defer st.rlock(ctx)()
+
+	rec, found := st.resources[urn]
+	if !found {
+		return nil, errors.ErrNotFound
+	}
+
+	res := cloneResource(rec.res)
+	return &res, nil
+}
+
+func (st *Store) List(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error) {
+	if filter.PageSize < 1 {
+		filter.PageSize = st.config.PaginationSizeDefault
+	}
+	if filter.PageNum < 1 {
+		filter.PageNum = st.config.PaginationPageDefault
+	}
+
+	var afterID int64
+	if filter.PageToken != "" {
+		var err error
+		afterID, err = decodePageToken(filter.PageToken)
+		if err != nil {
+			return resource.PagedResource{}, err
+		}
+	}
+
+	defer st.rlock(ctx)()
+
+	var matched []*resourceRecord
+	for _, rec := range st.sortedRecords() {
+		if filter.Matches(rec.res) {
+			matched = append(matched, rec)
+		}
+	}
+
+	page := matched
+	if filter.PageToken != "" {
+		start := sort.Search(len(page), func(i int) bool { return page[i].id > afterID })
+		page = page[start:]
+	} else if filter.PageSize > 0 {
+		offset := int((filter.PageNum - 1) * filter.PageSize)
+		if offset > len(page) {
+			offset = len(page)
+		}
+		page = page[offset:]
+	}
+
+	var nextPageToken string
+	if filter.PageSize > 0 && len(page) > int(filter.PageSize) {
+		page = page[:filter.PageSize]
+		nextPageToken = encodePageToken(page[len(page)-1].id)
+	}
+
+	var result []resource.Resource
+	for _, rec := range page {
+		res := cloneResource(rec.res)
+		if !withSpecConfigs {
+			res.Spec.Configs = nil
+		}
+		result = append(result, res)
+	}
+
+	return resource.PagedResource{
+		Count:         int32(len(matched)),
+		Resources:     result,
+		NextPageToken: nextPageToken,
+	}, nil
+}
+
+func (st *Store) Create(ctx context.Context, r resource.Resource, hooks ...resource.MutationHook) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, exists := st.resources[r.URN]; exists {
+		return errors.ErrConflict.WithCausef("resource with urn '%s' already exists", r.URN)
+	}
+
+	if err := st.checkDependencies(r.Spec.Dependencies); err != nil {
+		return err
+	}
+
+	if err := runAllHooks(ctx, hooks); err != nil {
+		return err
+	}
+
+	st.lastResourceID++
+	created := cloneResource(r)
+	created.Version = 1
+	st.resources[r.URN] = &resourceRecord{
+		id:  st.lastResourceID,
+		res: created,
+	}
+
+	st.appendRevision(resource.Revision{
+		URN:       r.URN,
+		Spec:      r.Spec,
+		Labels:    r.Labels,
+		Reason:    "action:create",
+		CreatedBy: r.UpdatedBy,
+	})
+	st.appendResourceEvent(resource.EventCreated, created)
+	st.notifySyncScheduled(r, nil)
+	return nil
+}
+
+func (st *Store) Update(ctx context.Context, r resource.Resource, saveRevision bool, reason string, hooks ...resource.MutationHook) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	rec, found := st.resources[r.URN]
+	if !found {
+		return errors.ErrNotFound.WithCausef("resource with urn '%s' not found", r.URN)
+	}
+
+	if rec.res.Version != r.Version {
+		return errors.ErrConflict.
+			WithMsgf("resource '%s' was modified concurrently (version %d is stale)", r.URN, r.Version)
+	}
+
+	if err := st.checkDependencies(r.Spec.Dependencies); err != nil {
+		return err
+	}
+
+	if err := runAllHooks(ctx, hooks); err != nil {
+		return err
+	}
+
+	prevNextSync := rec.res.State.NextSyncAt
+	updated := cloneResource(rec.res)
+	updated.Version++
+	updated.UpdatedAt = st.clock()
+	updated.UpdatedBy = r.UpdatedBy
+	updated.Labels = cloneMap(r.Labels)
+	updated.Spec = resource.Spec{
+		Configs:      cloneBytes(r.Spec.Configs),
+		Dependencies: cloneMap(r.Spec.Dependencies),
+	}
+	updated.State = cloneResource(r).State
+	rec.res = updated
+	st.appendResourceEvent(resource.EventUpdated, updated)
+
+	if saveRevision {
+		st.appendRevision(resource.Revision{
+			URN:       r.URN,
+			Spec:      r.Spec,
+			Labels:    r.Labels,
+			Reason:    reason,
+			CreatedBy: r.UpdatedBy,
+		})
+	}
+	st.notifySyncScheduled(r, prevNextSync)
+	return nil
+}
+
+func (st *Store) Delete(ctx context.Context, urn string, hooks ...resource.MutationHook) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	rec, found := st.resources[urn]
+	if !found {
+		return errors.ErrNotFound.WithCausef("resource with urn '%s' not found", urn)
+	}
+
+	if err := runAllHooks(ctx, hooks); err != nil {
+		return err
+	}
+
+	st.appendResourceEvent(resource.EventDeleted, rec.res)
+	delete(st.resources, urn)
+	delete(st.revisions, urn)
+	delete(st.syncRuns, urn)
+	return nil
+}
+
+func (st *Store) Revisions(_ context.Context, selector resource.RevisionsSelector) ([]resource.Revision, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	if _, found := st.resources[selector.URN]; !found {
+		return nil, nil
+	}
+
+	revs := st.revisions[selector.URN]
+	res := make([]resource.Revision, 0, len(revs))
+	for i := len(revs) - 1; i >= 0; i-- {
+		rev := revs[i]
+		res = append(res, resource.Revision{
+			ID:        rev.id,
+			URN:       selector.URN,
+			Reason:    rev.reason,
+			Labels:    cloneMap(rev.labels),
+			CreatedAt: rev.createdAt,
+			CreatedBy: rev.createdBy,
+			Spec: resource.Spec{
+				Configs:      cloneBytes(rev.configs),
+				Dependencies: cloneMap(rev.dependencies),
+			},
+		})
+	}
+	return res, nil
+}
+
+func (st *Store) Dependents(_ context.Context, urn string) ([]string, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	if _, found := st.resources[urn]; !found {
+		return nil, errors.ErrNotFound.WithCausef("resource with urn '%s' not found", urn)
+	}
+
+	var urns []string
+	for _, rec := range st.sortedRecords() {
+		for _, dependsOn := range rec.res.Spec.Dependencies {
+			if dependsOn == urn {
+				urns = append(urns, rec.res.URN)
+				break
+			}
+		}
+	}
+	return urns, nil
+}
+
+func (st *Store) SyncOne(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn, hooks ...resource.MutationHook) error {
+	urn, err := st.fetchResourceForSync(scope)
+	if err != nil {
+		return err
+	} else if urn == "" {
+		// No resource available for sync.
+		return nil
+	}
+
+	cur, err := st.GetByURN(ctx, urn)
+	if err != nil {
+		return err
+	}
+
+	synced, err := st.handleDequeued(ctx, *cur, syncFn)
+	if err != nil {
+		return err
+	}
+
+	return st.Update(ctx, *synced, false, "sync", hooks...)
+}
+
+func (st *Store) handleDequeued(baseCtx context.Context, res resource.Resource, fn resource.SyncFn) (*resource.Resource, error) {
+	runCtx, cancel := context.WithCancel(baseCtx)
+	defer cancel()
+
+	// Run heartbeat to keep the resource being picked up by some other syncer
+	// thread. If heartbeat exits, runCtx will be cancelled and fn should exit.
+	go st.runHeartbeat(runCtx, cancel, res.URN)
+
+	return fn(runCtx, res)
+}
+
+// fetchResourceForSync finds a resource ready for sync and extends its next
+// sync time atomically. This ensures multiple workers do not pick up the same
+// resource for sync. Returns empty string if no resource is ready.
+func (st *Store) fetchResourceForSync(scope map[string][]string) (string, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	now := st.clock()
+	for _, rec := range st.sortedRecords() {
+		nextSync := rec.res.State.NextSyncAt
+		if nextSync == nil || nextSync.After(now) {
+			continue
+		}
+
+		matched, err := matchScope(rec.res, scope)
+		if err != nil {
+			return "", err
+		} else if !matched {
+			continue
+		}
+
+		st.extendWaitTime(rec)
+		return rec.res.URN, nil
+	}
+
+	return "", nil
+}
+
+func (st *Store) runHeartbeat(ctx context.Context, cancel context.CancelFunc, urn string) {
+	defer cancel()
+
+	tick := time.NewTicker(st.refreshInterval)
+	defer tick.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-tick.C:
+			st.mu.Lock()
+			rec, found := st.resources[urn]
+			if found {
+				st.extendWaitTime(rec)
+			}
+			st.mu.Unlock()
+
+			if !found {
+				return
+			}
+		}
+	}
+}
+
+// extendWaitTime must be called with the lock held.
+func (st *Store) extendWaitTime(rec *resourceRecord) {
+	extendTo := st.clock().Add(st.extendInterval)
+	rec.res.State.NextSyncAt = &extendTo
+}
+
+// checkDependencies must be called with the lock held.
+func (st *Store) checkDependencies(deps map[string]string) error {
+	for _, dependsOnURN := range deps {
+		if _, found := st.resources[dependsOnURN]; !found {
+			return errors.ErrNotFound.WithCausef("dependency resource '%s' not found", dependsOnURN)
+		}
+	}
+	return nil
+}
+
+// appendRevision must be called with the lock held.
+func (st *Store) appendRevision(rev resource.Revision) {
+	st.lastRevisionID++
+	st.revisions[rev.URN] = append(st.revisions[rev.URN], revisionRecord{
+		id:           st.lastRevisionID,
+		reason:       rev.Reason,
+		labels:       cloneMap(rev.Labels),
+		configs:      cloneBytes(rev.Spec.Configs),
+		dependencies: cloneMap(rev.Spec.Dependencies),
+		createdAt:    st.clock(),
+		createdBy:    rev.CreatedBy,
+	})
+}
+
+// sortedRecords returns resource records in insertion order. It must be
+// called with the lock held.
+func (st *Store) sortedRecords() []*resourceRecord {
+	recs := make([]*resourceRecord, 0, len(st.resources))
+	for _, rec := range st.resources {
+		recs = append(recs, rec)
+	}
+	sort.Slice(recs, func(i, j int) bool { return recs[i].id < recs[j].id })
+	return recs
+}
+
+func matchScope(res resource.Resource, scope map[string][]string) (bool, error) {
+	for key, values := range scope {
+		var actual string
+		switch key {
+		case "urn":
+			actual = res.URN
+		case "kind":
+			actual = res.Kind
+		case "name":
+			actual = res.Name
+		case "project":
+			actual = res.Project
+		case "state_status":
+			actual = res.State.Status
+		default:
+			return false, errors.ErrInvalid.WithMsgf("sync scope key '%s' is not supported", key)
+		}
+
+		if !contains(values, actual) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
+func contains(arr []string, s string) bool {
+	for _, v := range arr {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// encodePageToken returns an opaque token for resuming a listing right
+// after the record with the given id.
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.ErrInvalid.WithMsgf("invalid page token")
+	}
+
+	lastID, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || lastID < 0 {
+		return 0, errors.ErrInvalid.WithMsgf("invalid page token")
+	}
+	return lastID, nil
+}
@@ -0,0 +1,415 @@
+package inmemory_test
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/internal/store/inmemory"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+var past = time.Unix(1650536955, 0)
+
+func newTestStore(t *testing.T) *inmemory.Store {
+	t.Helper()
+
+	store, err := inmemory.Open(time.Second, 5*time.Second, 0, 1)
+	require.NoError(t, err)
+
+	fixtures := []resource.Resource{
+		{
+			URN:     "orn:entropy:kubernetes:project-a:cluster",
+			Kind:    "kubernetes",
+			Name:    "cluster",
+			Project: "project-a",
+			Labels:  map[string]string{"team": "infra"},
+			Spec:    resource.Spec{Configs: json.RawMessage(`{"host":"localhost"}`)},
+			State:   resource.State{Status: resource.StatusCompleted},
+		},
+		{
+			URN:     "orn:entropy:firehose:project-a:fh1",
+			Kind:    "firehose",
+			Name:    "fh1",
+			Project: "project-a",
+			Labels:  map[string]string{"team": "payments"},
+			Spec: resource.Spec{
+				Configs:      json.RawMessage(`{"replicas":1}`),
+				Dependencies: map[string]string{"kube_cluster": "orn:entropy:kubernetes:project-a:cluster"},
+			},
+			State: resource.State{Status: resource.StatusPending, NextSyncAt: &past},
+		},
+		{
+			URN:     "orn:entropy:firehose:project-b:fh2",
+			Kind:    "firehose",
+			Name:    "fh2",
+			Project: "project-b",
+			Labels:  map[string]string{"team": "payments"},
+			Spec:    resource.Spec{Configs: json.RawMessage(`{"replicas":2}`)},
+			State:   resource.State{Status: resource.StatusPending, NextSyncAt: &past},
+		},
+	}
+
+	for _, res := range fixtures {
+		require.NoError(t, store.Create(context.Background(), res))
+	}
+	return store
+}
+
+func TestStore_Create(t *testing.T) {
+	t.Parallel()
+
+	t.Run("Conflict", func(t *testing.T) {
+		t.Parallel()
+		store := newTestStore(t)
+
+		err := store.Create(context.Background(), resource.Resource{URN: "orn:entropy:firehose:project-a:fh1"})
+		assert.ErrorIs(t, err, errors.ErrConflict)
+	})
+
+	t.Run("MissingDependency", func(t *testing.T) {
+		t.Parallel()
+		store := newTestStore(t)
+
+		err := store.Create(context.Background(), resource.Resource{
+			URN:  "orn:entropy:firehose:project-a:fh3",
+			Spec: resource.Spec{Dependencies: map[string]string{"kube_cluster": "orn:entropy:kubernetes:project-a:unknown"}},
+		})
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+
+	t.Run("HookFailure", func(t *testing.T) {
+		t.Parallel()
+		store := newTestStore(t)
+
+		urn := "orn:entropy:firehose:project-a:fh3"
+		err := store.Create(context.Background(), resource.Resource{URN: urn}, func(ctx context.Context) error {
+			return errors.New("failed")
+		})
+		assert.Error(t, err)
+
+		_, err = store.GetByURN(context.Background(), urn)
+		assert.ErrorIs(t, err, errors.ErrNotFound)
+	})
+}
+
+func TestStore_GetByURN(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	got, err := store.GetByURN(context.Background(), "orn:entropy:firehose:project-a:fh1")
+	require.NoError(t, err)
+	assert.Equal(t, "fh1", got.Name)
+	assert.Equal(t, map[string]string{"team": "payments"}, got.Labels)
+	assert.JSONEq(t, `{"replicas":1}`, string(got.Spec.Configs))
+
+	// mutating the returned value must not affect the stored copy.
+	got.Labels["team"] = "other"
+	again, err := store.GetByURN(context.Background(), "orn:entropy:firehose:project-a:fh1")
+	require.NoError(t, err)
+	assert.Equal(t, "payments", again.Labels["team"])
+
+	_, err = store.GetByURN(context.Background(), "orn:entropy:firehose:project-a:unknown")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+}
+
+func TestStore_List(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	tests := []struct {
+		name      string
+		filter    resource.Filter
+		withSpec  bool
+		wantURNs  []string
+		wantCount int32
+		wantToken string
+	}{
+		{
+			name:      "All",
+			filter:    resource.Filter{},
+			wantURNs:  []string{"orn:entropy:kubernetes:project-a:cluster", "orn:entropy:firehose:project-a:fh1", "orn:entropy:firehose:project-b:fh2"},
+			wantCount: 3,
+		},
+		{
+			name:      "ByProjectAndKind",
+			filter:    resource.Filter{Project: "project-a", Kind: "firehose"},
+			wantURNs:  []string{"orn:entropy:firehose:project-a:fh1"},
+			wantCount: 1,
+		},
+		{
+			name:      "ByLabels",
+			filter:    resource.Filter{Labels: map[string]string{"team": "payments"}},
+			wantURNs:  []string{"orn:entropy:firehose:project-a:fh1", "orn:entropy:firehose:project-b:fh2"},
+			wantCount: 2,
+		},
+		{
+			name:      "FirstPage",
+			filter:    resource.Filter{PageSize: 2},
+			wantURNs:  []string{"orn:entropy:kubernetes:project-a:cluster", "orn:entropy:firehose:project-a:fh1"},
+			wantCount: 3,
+			wantToken: "Mg",
+		},
+		{
+			name:      "Paginated",
+			filter:    resource.Filter{PageSize: 2, PageNum: 2},
+			wantURNs:  []string{"orn:entropy:firehose:project-b:fh2"},
+			wantCount: 3,
+		},
+		{
+			name:      "PageToken",
+			filter:    resource.Filter{PageSize: 1, PageToken: "Mg"},
+			wantURNs:  []string{"orn:entropy:firehose:project-b:fh2"},
+			wantCount: 3,
+		},
+		{
+			name:      "PageOutOfRange",
+			filter:    resource.Filter{PageSize: 2, PageNum: 3},
+			wantURNs:  nil,
+			wantCount: 3,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := store.List(context.Background(), tt.filter, tt.withSpec)
+			require.NoError(t, err)
+
+			var gotURNs []string
+			for _, res := range got.Resources {
+				assert.Nil(t, res.Spec.Configs)
+				gotURNs = append(gotURNs, res.URN)
+			}
+			assert.Equal(t, tt.wantURNs, gotURNs)
+			assert.Equal(t, tt.wantCount, got.Count)
+			assert.Equal(t, tt.wantToken, got.NextPageToken)
+		})
+	}
+
+	t.Run("InvalidPageToken", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := store.List(context.Background(), resource.Filter{PageToken: "!!"}, false)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+}
+
+func TestStore_Update(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	ctx := context.Background()
+	urn := "orn:entropy:firehose:project-b:fh2"
+
+	res, err := store.GetByURN(ctx, urn)
+	require.NoError(t, err)
+
+	res.Spec.Configs = json.RawMessage(`{"replicas":3}`)
+	res.UpdatedBy = "john"
+	require.NoError(t, store.Update(ctx, *res, true, "action:update"))
+
+	// res still carries the version it was read at.
+	err = store.Update(ctx, *res, false, "sync")
+	assert.ErrorIs(t, err, errors.ErrConflict)
+
+	res.Version++
+	require.NoError(t, store.Update(ctx, *res, false, "sync"))
+
+	got, err := store.GetByURN(ctx, urn)
+	require.NoError(t, err)
+	assert.Equal(t, int64(3), got.Version)
+
+	revs, err := store.Revisions(ctx, resource.RevisionsSelector{URN: urn})
+	require.NoError(t, err)
+	require.Len(t, revs, 2)
+	assert.Equal(t, "action:update", revs[0].Reason)
+	assert.Equal(t, "john", revs[0].CreatedBy)
+	assert.JSONEq(t, `{"replicas":3}`, string(revs[0].Spec.Configs))
+	assert.Equal(t, "action:create", revs[1].Reason)
+
+	// hooks run within the update and can use the store.
+	_, err = store.CreateWebhook(ctx, webhook.Subscription{Project: "project-b", URL: "http://localhost/hook"})
+	require.NoError(t, err)
+	require.NoError(t, store.Update(ctx, *got, false, "sync", func(ctx context.Context) error {
+		return webhook.Publish(ctx, store, webhook.Event{ID: "ev-1", Project: "project-b", URN: urn, Timestamp: past})
+	}))
+
+	deliveries, err := store.ClaimDeliveries(ctx, past, time.Minute, 0)
+	require.NoError(t, err)
+	require.Len(t, deliveries, 1)
+	assert.Equal(t, urn, deliveries[0].Event.URN)
+
+	err = store.Update(ctx, resource.Resource{URN: "orn:entropy:firehose:project-b:unknown"}, false, "")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+}
+
+func TestStore_Delete(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	ctx := context.Background()
+	urn := "orn:entropy:firehose:project-b:fh2"
+
+	require.NoError(t, store.Delete(ctx, urn))
+
+	_, err := store.GetByURN(ctx, urn)
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+
+	revs, err := store.Revisions(ctx, resource.RevisionsSelector{URN: urn})
+	assert.NoError(t, err)
+	assert.Empty(t, revs)
+
+	assert.ErrorIs(t, store.Delete(ctx, urn), errors.ErrNotFound)
+}
+
+func TestStore_Dependents(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+	ctx := context.Background()
+
+	got, err := store.Dependents(ctx, "orn:entropy:kubernetes:project-a:cluster")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"orn:entropy:firehose:project-a:fh1"}, got)
+
+	got, err = store.Dependents(ctx, "orn:entropy:firehose:project-a:fh1")
+	require.NoError(t, err)
+	assert.Empty(t, got)
+
+	_, err = store.Dependents(ctx, "orn:entropy:kubernetes:project-a:unknown")
+	assert.ErrorIs(t, err, errors.ErrNotFound)
+}
+
+func TestStore_SyncOne(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ScopedByKind", func(t *testing.T) {
+		t.Parallel()
+		store := newTestStore(t)
+		ctx := context.Background()
+
+		var synced []string
+		syncFn := func(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
+			synced = append(synced, res.URN)
+			res.State.Status = resource.StatusCompleted
+			res.State.NextSyncAt = nil
+			return &res, nil
+		}
+
+		scope := map[string][]string{"project": {"project-b"}}
+		require.NoError(t, store.SyncOne(ctx, scope, syncFn))
+		require.NoError(t, store.SyncOne(ctx, scope, syncFn))
+		assert.Equal(t, []string{"orn:entropy:firehose:project-b:fh2"}, synced)
+
+		res, err := store.GetByURN(ctx, "orn:entropy:firehose:project-b:fh2")
+		require.NoError(t, err)
+		assert.Equal(t, resource.StatusCompleted, res.State.Status)
+		assert.Nil(t, res.State.NextSyncAt)
+	})
+
+	t.Run("UnsupportedScope", func(t *testing.T) {
+		t.Parallel()
+		store := newTestStore(t)
+
+		err := store.SyncOne(context.Background(), map[string][]string{"foo": {"bar"}}, nil)
+		assert.ErrorIs(t, err, errors.ErrInvalid)
+	})
+
+	t.Run("LockedWhileSyncing", func(t *testing.T) {
+		t.Parallel()
+		store := newTestStore(t)
+		ctx := context.Background()
+
+		var mu sync.Mutex
+		counts := map[string]int{}
+		syncFn := func(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
+			mu.Lock()
+			counts[res.URN]++
+			mu.Unlock()
+
+			time.Sleep(50 * time.Millisecond)
+			res.State.NextSyncAt = nil
+			return &res, nil
+		}
+
+		var wg sync.WaitGroup
+		for i := 0; i < 4; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				assert.NoError(t, store.SyncOne(ctx, nil, syncFn))
+			}()
+		}
+		wg.Wait()
+
+		assert.Equal(t, map[string]int{
+			"orn:entropy:firehose:project-a:fh1": 1,
+			"orn:entropy:firehose:project-b:fh2": 1,
+		}, counts)
+	})
+
+	t.Run("SyncFailureKeepsLock", func(t *testing.T) {
+		t.Parallel()
+		store := newTestStore(t)
+		ctx := context.Background()
+		urn := "orn:entropy:firehose:project-b:fh2"
+
+		scope := map[string][]string{"urn": {urn}}
+		err := store.SyncOne(ctx, scope, func(ctx context.Context, res resource.Resource) (*resource.Resource, error) {
+			return nil, errors.New("failed")
+		})
+		assert.Error(t, err)
+
+		res, err := store.GetByURN(ctx, urn)
+		require.NoError(t, err)
+		require.NotNil(t, res.State.NextSyncAt)
+		assert.True(t, res.State.NextSyncAt.After(time.Now()))
+	})
+}
+
+func TestStore_SyncNotifications(t *testing.T) {
+	t.Parallel()
+	store := newTestStore(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wakeUp, err := store.SyncNotifications(ctx)
+	require.NoError(t, err)
+
+	notified := func() bool {
+		select {
+		case <-wakeUp:
+			return true
+		default:
+			return false
+		}
+	}
+
+	scheduleAt := func(nextSync time.Time) {
+		res, err := store.GetByURN(ctx, "orn:entropy:kubernetes:project-a:cluster")
+		require.NoError(t, err)
+		res.State.NextSyncAt = &nextSync
+		require.NoError(t, store.Update(ctx, *res, false, "sync"))
+	}
+
+	later := time.Now().Add(time.Hour)
+	scheduleAt(later)
+	assert.True(t, notified(), "a sync scheduled in the future wakes up the syncers")
+
+	scheduleAt(later.Add(time.Hour))
+	assert.False(t, notified(), "a postponed sync does not wake up the syncers")
+
+	scheduleAt(later.Add(-time.Minute))
+	assert.True(t, notified(), "a sync moved earlier wakes up the syncers")
+
+	scheduleAt(past)
+	assert.True(t, notified(), "a due sync wakes up the syncers")
+}
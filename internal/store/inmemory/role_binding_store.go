@@ -0,0 +1,69 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"sort"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateRoleBinding(_ context.Context, b rbac.Binding) (*rbac.Binding, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.lastRoleBindingID++
+	b.ID = st.lastRoleBindingID
+	if b.CreatedAt.IsZero() {
+		b.CreatedAt = st.clock()
+	}
+
+	st.roleBindings[b.ID] = cloneRoleBinding(b)
+	created := cloneRoleBinding(b)
+	return &created, nil
+}
+
+func (st *Store) GetRoleBinding(_ context.Context, id int64) (*rbac.Binding, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	b, found := st.roleBindings[id]
+	if !found {
+		return nil, errors.ErrNotFound.WithCausef("role binding with id '%d' not found", id)
+	}
+
+	b = cloneRoleBinding(b)
+	return &b, nil
+}
+
+func (st *Store) ListRoleBindings(_ context.Context, project string) ([]rbac.Binding, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	var bindings []rbac.Binding
+	for _, b := range st.roleBindings {
+		if project == "" || b.Project == project {
+			bindings = append(bindings, cloneRoleBinding(b))
+		}
+	}
+
+	sort.Slice(bindings, func(i, j int) bool { return bindings[i].ID < bindings[j].ID })
+	return bindings, nil
+}
+
+func (st *Store) DeleteRoleBinding(_ context.Context, id int64) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, found := st.roleBindings[id]; !found {
+		return errors.ErrNotFound.WithCausef("role binding with id '%d' not found", id)
+	}
+	delete(st.roleBindings, id)
+	return nil
+}
+
+func cloneRoleBinding(b rbac.Binding) rbac.Binding {
+	b.Actions = slices.Clone(b.Actions)
+	return b
+}
@@ -0,0 +1,112 @@
+package inmemory
+
+import (
+	"context"
+	"maps"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateSchedule(_ context.Context, s schedule.Schedule) (*schedule.Schedule, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.lastScheduleID++
+	s.ID = st.lastScheduleID
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = st.clock()
+	}
+
+	st.schedules[s.ID] = cloneSchedule(s)
+	created := cloneSchedule(s)
+	return &created, nil
+}
+
+func (st *Store) GetSchedule(_ context.Context, id int64) (*schedule.Schedule, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	s, found := st.schedules[id]
+	if !found {
+		return nil, errors.ErrNotFound.WithCausef("schedule with id '%d' not found", id)
+	}
+
+	s = cloneSchedule(s)
+	return &s, nil
+}
+
+func (st *Store) ListSchedules(_ context.Context, filter schedule.Filter) ([]schedule.Schedule, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	var schedules []schedule.Schedule
+	for _, s := range st.schedules {
+		if filter.Matches(s) {
+			schedules = append(schedules, cloneSchedule(s))
+		}
+	}
+
+	sort.Slice(schedules, func(i, j int) bool { return schedules[i].ID < schedules[j].ID })
+	return schedules, nil
+}
+
+func (st *Store) ClaimDueSchedules(_ context.Context, now time.Time, lease time.Duration, limit int) ([]schedule.Schedule, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	var due []schedule.Schedule
+	for _, s := range st.schedules {
+		if s.Status == schedule.StatusPending && !s.NextRunAt.After(now) {
+			due = append(due, s)
+		}
+	}
+
+	sort.Slice(due, func(i, j int) bool {
+		if due[i].NextRunAt.Equal(due[j].NextRunAt) {
+			return due[i].ID < due[j].ID
+		}
+		return due[i].NextRunAt.Before(due[j].NextRunAt)
+	})
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	for i, s := range due {
+		due[i] = cloneSchedule(s)
+
+		s.NextRunAt = now.Add(lease)
+		st.schedules[s.ID] = s
+	}
+	return due, nil
+}
+
+func (st *Store) UpdateSchedule(_ context.Context, s schedule.Schedule) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	existing, found := st.schedules[s.ID]
+	if !found {
+		return errors.ErrNotFound.WithCausef("schedule with id '%d' not found", s.ID)
+	}
+
+	existing.Status = s.Status
+	existing.NextRunAt = s.NextRunAt
+	existing.LastRunAt = s.LastRunAt
+	existing.LastError = s.LastError
+	st.schedules[s.ID] = existing
+	return nil
+}
+
+func cloneSchedule(s schedule.Schedule) schedule.Schedule {
+	s.Action.Params = slices.Clone(s.Action.Params)
+	s.Action.Labels = maps.Clone(s.Action.Labels)
+	if s.LastRunAt != nil {
+		lastRunAt := *s.LastRunAt
+		s.LastRunAt = &lastRunAt
+	}
+	return s
+}
@@ -0,0 +1,32 @@
+package inmemory
+
+import (
+	"context"
+
+	"github.com/goto/entropy/core/resource"
+)
+
+func (st *Store) AppendSyncRun(_ context.Context, run resource.SyncRun) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.lastSyncRunID++
+	run.ID = st.lastSyncRunID
+	st.syncRuns[run.URN] = append(st.syncRuns[run.URN], run)
+	return nil
+}
+
+func (st *Store) SyncHistory(_ context.Context, urn string, limit int) ([]resource.SyncRun, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	runs := st.syncRuns[urn]
+	var history []resource.SyncRun
+	for i := len(runs) - 1; i >= 0; i-- {
+		history = append(history, runs[i])
+		if limit > 0 && len(history) == limit {
+			break
+		}
+	}
+	return history, nil
+}
@@ -0,0 +1,72 @@
+package inmemory
+
+import (
+	"context"
+	"time"
+
+	"github.com/goto/entropy/core/resource"
+)
+
+func (st *Store) SyncNotifications(ctx context.Context) (<-chan struct{}, error) {
+	ch := make(chan struct{}, 1)
+
+	st.subsMu.Lock()
+	st.syncSubs[ch] = struct{}{}
+	st.subsMu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+
+		st.subsMu.Lock()
+		delete(st.syncSubs, ch)
+		close(ch)
+		st.subsMu.Unlock()
+	}()
+
+	return ch, nil
+}
+
+func (st *Store) NextSyncAt(_ context.Context, scope map[string][]string) (*time.Time, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	var earliest *time.Time
+	for _, rec := range st.resources {
+		nextSync := rec.res.State.NextSyncAt
+		if nextSync == nil || (earliest != nil && !nextSync.Before(*earliest)) {
+			continue
+		}
+
+		matched, err := matchScope(rec.res, scope)
+		if err != nil {
+			return nil, err
+		} else if matched {
+			t := *nextSync
+			earliest = &t
+		}
+	}
+	return earliest, nil
+}
+
+// notifySyncScheduled wakes up the subscribed syncers if the resource is due
+// for sync already, or if its next sync moved earlier than prevNextSync. The
+// syncers sleeping until an earlier due time recompute their wait on waking.
+func (st *Store) notifySyncScheduled(r resource.Resource, prevNextSync *time.Time) {
+	nextSync := r.State.NextSyncAt
+	if nextSync == nil {
+		return
+	} else if nextSync.After(st.clock()) && prevNextSync != nil && !nextSync.Before(*prevNextSync) {
+		return
+	}
+
+	st.subsMu.Lock()
+	defer st.subsMu.Unlock()
+
+	for ch := range st.syncSubs {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// a wake-up is pending already.
+		}
+	}
+}
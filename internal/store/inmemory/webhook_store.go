@@ -0,0 +1,141 @@
+package inmemory
+
+import (
+	"context"
+	"slices"
+	"sort"
+	"time"
+
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateWebhook(_ context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	st.lastWebhookID++
+	sub.ID = st.lastWebhookID
+	if sub.CreatedAt.IsZero() {
+		sub.CreatedAt = st.clock()
+	}
+	sub = cloneSubscription(sub)
+
+	st.webhooks[sub.ID] = sub
+	created := cloneSubscription(sub)
+	return &created, nil
+}
+
+func (st *Store) GetWebhook(_ context.Context, id int64) (*webhook.Subscription, error) {
+	st.mu.RLock()
+	defer st.mu.RUnlock()
+
+	sub, found := st.webhooks[id]
+	if !found {
+		return nil, errors.ErrNotFound.WithCausef("webhook with id '%d' not found", id)
+	}
+
+	sub = cloneSubscription(sub)
+	return &sub, nil
+}
+
+func (st *Store) ListWebhooks(ctx context.Context, project string) ([]webhook.Subscription, error) {
+	defer st.rlock(ctx)()
+
+	var subs []webhook.Subscription
+	for _, sub := range st.webhooks {
+		if project == "" || sub.Project == project {
+			subs = append(subs, cloneSubscription(sub))
+		}
+	}
+
+	sort.Slice(subs, func(i, j int) bool { return subs[i].ID < subs[j].ID })
+	return subs, nil
+}
+
+func (st *Store) DeleteWebhook(_ context.Context, id int64) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	if _, found := st.webhooks[id]; !found {
+		return errors.ErrNotFound.WithCausef("webhook with id '%d' not found", id)
+	}
+
+	delete(st.webhooks, id)
+	st.deliveries = slices.DeleteFunc(st.deliveries, func(d webhook.Delivery) bool {
+		return d.Subscription.ID == id
+	})
+	return nil
+}
+
+func (st *Store) EnqueueDeliveries(ctx context.Context, deliveries []webhook.Delivery) error {
+	defer st.lock(ctx)()
+
+	for _, d := range deliveries {
+		if _, found := st.webhooks[d.Subscription.ID]; !found {
+			continue
+		}
+
+		st.lastDeliveryID++
+		d.ID = st.lastDeliveryID
+		if d.Status == "" {
+			d.Status = webhook.DeliveryPending
+		}
+		st.deliveries = append(st.deliveries, d)
+	}
+	return nil
+}
+
+func (st *Store) ClaimDeliveries(_ context.Context, now time.Time, lease time.Duration, limit int) ([]webhook.Delivery, error) {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	var due []int
+	for i, d := range st.deliveries {
+		if d.Status == webhook.DeliveryPending && !d.NextAttemptAt.After(now) {
+			due = append(due, i)
+		}
+	}
+
+	sort.SliceStable(due, func(i, j int) bool {
+		return st.deliveries[due[i]].NextAttemptAt.Before(st.deliveries[due[j]].NextAttemptAt)
+	})
+	if limit > 0 && len(due) > limit {
+		due = due[:limit]
+	}
+
+	claimed := make([]webhook.Delivery, 0, len(due))
+	for _, i := range due {
+		d := st.deliveries[i]
+		d.Subscription = cloneSubscription(st.webhooks[d.Subscription.ID])
+		claimed = append(claimed, d)
+
+		st.deliveries[i].NextAttemptAt = now.Add(lease)
+	}
+	return claimed, nil
+}
+
+func (st *Store) UpdateDelivery(_ context.Context, d webhook.Delivery) error {
+	st.mu.Lock()
+	defer st.mu.Unlock()
+
+	for i := range st.deliveries {
+		if st.deliveries[i].ID != d.ID {
+			continue
+		}
+
+		st.deliveries[i].Status = d.Status
+		st.deliveries[i].Attempts = d.Attempts
+		st.deliveries[i].NextAttemptAt = d.NextAttemptAt
+		st.deliveries[i].LastError = d.LastError
+		return nil
+	}
+	return errors.ErrNotFound.WithCausef("webhook delivery with id '%d' not found", d.ID)
+}
+
+func cloneSubscription(sub webhook.Subscription) webhook.Subscription {
+	sub.Kinds = slices.Clone(sub.Kinds)
+	sub.Statuses = slices.Clone(sub.Statuses)
+	sub.Actions = slices.Clone(sub.Actions)
+	return sub
+}
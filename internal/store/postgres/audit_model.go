@@ -0,0 +1,35 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/goto/entropy/core/audit"
+)
+
+type auditEventModel struct {
+	ID        int64     `db:"id"`
+	Timestamp time.Time `db:"timestamp"`
+	Project   string    `db:"project"`
+	URN       string    `db:"urn"`
+	UserID    string    `db:"user_id"`
+	RequestID string    `db:"request_id"`
+	Action    string    `db:"action"`
+	Params    []byte    `db:"params"`
+	Result    string    `db:"result"`
+	Error     string    `db:"error"`
+}
+
+func (m auditEventModel) toEvent() audit.Event {
+	return audit.Event{
+		ID:        m.ID,
+		Timestamp: m.Timestamp,
+		Project:   m.Project,
+		URN:       m.URN,
+		UserID:    m.UserID,
+		RequestID: m.RequestID,
+		Action:    m.Action,
+		Params:    m.Params,
+		Result:    m.Result,
+		Error:     m.Error,
+	}
+}
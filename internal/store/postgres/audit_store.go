@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"context"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/audit"
+)
+
+func (st *Store) AppendAuditEvent(ctx context.Context, event audit.Event) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "AppendAuditEvent"),
+			attribute.String(string(semconv.DBSQLTableKey), tableAuditEvents),
+		}...,
+	)
+
+	timestamp := event.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+
+	_, err := sq.Insert(tableAuditEvents).
+		Columns("timestamp", "project", "urn", "user_id", "request_id", "action", "params", "result", "error").
+		Values(timestamp, event.Project, event.URN, event.UserID, event.RequestID, event.Action, []byte(event.Params), event.Result, event.Error).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	return err
+}
+
+func (st *Store) ListAuditEvents(ctx context.Context, filter audit.Filter) ([]audit.Event, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListAuditEvents"),
+			attribute.String(string(semconv.DBSQLTableKey), tableAuditEvents),
+		}...,
+	)
+
+	builder := sq.Select("*").From(tableAuditEvents).OrderBy("id DESC")
+	if filter.Project != "" {
+		builder = builder.Where(sq.Eq{"project": filter.Project})
+	}
+	if filter.URN != "" {
+		builder = builder.Where(sq.Eq{"urn": filter.URN})
+	}
+	if filter.UserID != "" {
+		builder = builder.Where(sq.Eq{"user_id": filter.UserID})
+	}
+	if !filter.Since.IsZero() {
+		builder = builder.Where(sq.GtOrEq{"timestamp": filter.Since})
+	}
+	if !filter.Until.IsZero() {
+		builder = builder.Where(sq.Lt{"timestamp": filter.Until})
+	}
+	if filter.Limit > 0 {
+		builder = builder.Limit(uint64(filter.Limit))
+	}
+
+	q, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var models []auditEventModel
+	if err := st.db.SelectContext(ctx, &models, q, args...); err != nil {
+		return nil, err
+	}
+
+	events := make([]audit.Event, 0, len(models))
+	for _, m := range models {
+		events = append(events, m.toEvent())
+	}
+	return events, nil
+}
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/goto/entropy/core/idempotency"
+)
+
+type idempotencyModel struct {
+	Key         string    `db:"key"`
+	Operation   string    `db:"operation"`
+	RequestHash string    `db:"request_hash"`
+	Status      string    `db:"status"`
+	Response    []byte    `db:"response"`
+	CreatedAt   time.Time `db:"created_at"`
+	ExpiresAt   time.Time `db:"expires_at"`
+}
+
+func (m idempotencyModel) toRecord() idempotency.Record {
+	return idempotency.Record{
+		Key:         m.Key,
+		Operation:   m.Operation,
+		RequestHash: m.RequestHash,
+		Status:      m.Status,
+		Response:    m.Response,
+		CreatedAt:   m.CreatedAt,
+		ExpiresAt:   m.ExpiresAt,
+	}
+}
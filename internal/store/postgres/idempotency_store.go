@@ -0,0 +1,143 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/idempotency"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateIdempotencyRecord(ctx context.Context, r idempotency.Record) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CreateIdempotencyRecord"),
+			attribute.String(string(semconv.DBSQLTableKey), tableIdempotencyRecords),
+		}...,
+	)
+
+	// an expired record is replaced in place, a live one is left alone and
+	// no row is affected.
+	result, err := sq.Insert(tableIdempotencyRecords).
+		Columns("key", "operation", "request_hash", "status", "response", "created_at", "expires_at").
+		Values(r.Key, r.Operation, r.RequestHash, r.Status, nullJSON(r.Response), r.CreatedAt, r.ExpiresAt).
+		Suffix(`ON CONFLICT ("key") DO UPDATE SET
+			operation = EXCLUDED.operation,
+			request_hash = EXCLUDED.request_hash,
+			status = EXCLUDED.status,
+			response = EXCLUDED.response,
+			created_at = EXCLUDED.created_at,
+			expires_at = EXCLUDED.expires_at
+		WHERE ` + tableIdempotencyRecords + `.expires_at <= EXCLUDED.created_at`).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrConflict.WithCausef("idempotency key '%s' is in use", r.Key)
+	}
+	return nil
+}
+
+func (st *Store) GetIdempotencyRecord(ctx context.Context, key string) (*idempotency.Record, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetIdempotencyRecord"),
+			attribute.String(string(semconv.DBSQLTableKey), tableIdempotencyRecords),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tableIdempotencyRecords).
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var m idempotencyModel
+	if err := st.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.WithCausef("idempotency key '%s' not found", key)
+		}
+		return nil, err
+	}
+
+	r := m.toRecord()
+	return &r, nil
+}
+
+func (st *Store) CompleteIdempotencyRecord(ctx context.Context, key string, response json.RawMessage) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CompleteIdempotencyRecord"),
+			attribute.String(string(semconv.DBSQLTableKey), tableIdempotencyRecords),
+		}...,
+	)
+
+	result, err := sq.Update(tableIdempotencyRecords).
+		Set("status", idempotency.StatusCompleted).
+		Set("response", nullJSON(response)).
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("idempotency key '%s' not found", key)
+	}
+	return nil
+}
+
+func (st *Store) DeleteIdempotencyRecord(ctx context.Context, key string) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "DeleteIdempotencyRecord"),
+			attribute.String(string(semconv.DBSQLTableKey), tableIdempotencyRecords),
+		}...,
+	)
+
+	result, err := sq.Delete(tableIdempotencyRecords).
+		Where(sq.Eq{"key": key}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("idempotency key '%s' not found", key)
+	}
+	return nil
+}
+
+func nullJSON(data []byte) any {
+	if len(data) == 0 {
+		return nil
+	}
+	return data
+}
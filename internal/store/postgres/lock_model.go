@@ -0,0 +1,46 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/goto/entropy/core/lock"
+)
+
+type lockModel struct {
+	ID        int64        `db:"id"`
+	Project   string       `db:"project"`
+	URN       string       `db:"urn"`
+	Labels    []byte       `db:"labels"`
+	Reason    string       `db:"reason"`
+	Owner     string       `db:"owner"`
+	ExpiresAt sql.NullTime `db:"expires_at"`
+	CreatedAt time.Time    `db:"created_at"`
+	CreatedBy string       `db:"created_by"`
+}
+
+func (m lockModel) toLock() (*lock.Lock, error) {
+	var labels map[string]string
+	if len(m.Labels) > 0 {
+		if err := json.Unmarshal(m.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	l := &lock.Lock{
+		ID:        m.ID,
+		Project:   m.Project,
+		URN:       m.URN,
+		Labels:    labels,
+		Reason:    m.Reason,
+		Owner:     m.Owner,
+		CreatedAt: m.CreatedAt,
+		CreatedBy: m.CreatedBy,
+	}
+	if m.ExpiresAt.Valid {
+		expiresAt := m.ExpiresAt.Time
+		l.ExpiresAt = &expiresAt
+	}
+	return l, nil
+}
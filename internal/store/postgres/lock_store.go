@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/lock"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateLock(ctx context.Context, l lock.Lock) (*lock.Lock, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CreateLock"),
+			attribute.String(string(semconv.DBSQLTableKey), tableLocks),
+		}...,
+	)
+
+	labelsJSON, err := json.Marshal(l.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	if l.CreatedAt.IsZero() {
+		l.CreatedAt = time.Now()
+	}
+
+	err = sq.Insert(tableLocks).
+		Columns("project", "urn", "labels", "reason", "owner", "expires_at", "created_at", "created_by").
+		Values(l.Project, l.URN, labelsJSON, l.Reason, l.Owner, nullTime(l.ExpiresAt), l.CreatedAt, l.CreatedBy).
+		Suffix(`RETURNING "id"`).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		QueryRowContext(ctx).
+		Scan(&l.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &l, nil
+}
+
+func (st *Store) GetLock(ctx context.Context, id int64) (*lock.Lock, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetLock"),
+			attribute.String(string(semconv.DBSQLTableKey), tableLocks),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tableLocks).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var m lockModel
+	if err := st.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.WithCausef("lock with id '%d' not found", id)
+		}
+		return nil, err
+	}
+	return m.toLock()
+}
+
+func (st *Store) ListLocks(ctx context.Context, project string) ([]lock.Lock, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListLocks"),
+			attribute.String(string(semconv.DBSQLTableKey), tableLocks),
+		}...,
+	)
+
+	builder := sq.Select("*").From(tableLocks).OrderBy("id")
+	if project != "" {
+		builder = builder.Where(sq.Eq{"project": project})
+	}
+
+	q, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var models []lockModel
+	if err := st.db.SelectContext(ctx, &models, q, args...); err != nil {
+		return nil, err
+	}
+
+	locks := make([]lock.Lock, 0, len(models))
+	for _, m := range models {
+		l, err := m.toLock()
+		if err != nil {
+			return nil, err
+		}
+		locks = append(locks, *l)
+	}
+	return locks, nil
+}
+
+func (st *Store) DeleteLock(ctx context.Context, id int64) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "DeleteLock"),
+			attribute.String(string(semconv.DBSQLTableKey), tableLocks),
+		}...,
+	)
+
+	result, err := sq.Delete(tableLocks).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("lock with id '%d' not found", id)
+	}
+	return nil
+}
@@ -0,0 +1,44 @@
+package postgres
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/goto/entropy/core/operation"
+)
+
+type operationModel struct {
+	ID           int64          `db:"id"`
+	URN          string         `db:"urn"`
+	Project      string         `db:"project"`
+	Action       string         `db:"action"`
+	Params       []byte         `db:"params"`
+	UserID       string         `db:"user_id"`
+	Status       string         `db:"status"`
+	PendingSteps pq.StringArray `db:"pending_steps"`
+	Error        string         `db:"error"`
+	StartedAt    time.Time      `db:"started_at"`
+	FinishedAt   sql.NullTime   `db:"finished_at"`
+}
+
+func (m operationModel) toOperation() operation.Operation {
+	op := operation.Operation{
+		ID:           m.ID,
+		URN:          m.URN,
+		Project:      m.Project,
+		Action:       m.Action,
+		Params:       m.Params,
+		UserID:       m.UserID,
+		Status:       m.Status,
+		PendingSteps: m.PendingSteps,
+		Error:        m.Error,
+		StartedAt:    m.StartedAt,
+	}
+	if m.FinishedAt.Valid {
+		finishedAt := m.FinishedAt.Time
+		op.FinishedAt = &finishedAt
+	}
+	return op
+}
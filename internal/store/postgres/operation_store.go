@@ -0,0 +1,164 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/operation"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateOperation(ctx context.Context, op operation.Operation) (*operation.Operation, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CreateOperation"),
+			attribute.String(string(semconv.DBSQLTableKey), tableOperations),
+		}...,
+	)
+
+	if op.StartedAt.IsZero() {
+		op.StartedAt = time.Now()
+	}
+
+	// recorded within the mutation when called from its hooks, so that the
+	// operation is committed along with the change.
+	var db sq.BaseRunner = st.db
+	if tx, ok := txFromContext(ctx); ok {
+		db = tx
+	}
+
+	err := sq.Insert(tableOperations).
+		Columns("urn", "project", "action", "params", "user_id", "status", "pending_steps", "error", "started_at", "finished_at").
+		Values(op.URN, op.Project, op.Action, []byte(op.Params), op.UserID, op.Status,
+			textArray(op.PendingSteps), op.Error, op.StartedAt, nullTime(op.FinishedAt)).
+		Suffix(`RETURNING "id"`).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(db).
+		QueryRowContext(ctx).
+		Scan(&op.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &op, nil
+}
+
+func (st *Store) GetOperation(ctx context.Context, id int64) (*operation.Operation, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetOperation"),
+			attribute.String(string(semconv.DBSQLTableKey), tableOperations),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tableOperations).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var m operationModel
+	if err := st.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.WithCausef("operation with id '%d' not found", id)
+		}
+		return nil, err
+	}
+
+	op := m.toOperation()
+	return &op, nil
+}
+
+func (st *Store) ListOperations(ctx context.Context, filter operation.Filter) ([]operation.Operation, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListOperations"),
+			attribute.String(string(semconv.DBSQLTableKey), tableOperations),
+		}...,
+	)
+
+	builder := sq.Select("*").From(tableOperations).OrderBy("id DESC")
+	if filter.Project != "" {
+		builder = builder.Where(sq.Eq{"project": filter.Project})
+	}
+	if filter.URN != "" {
+		builder = builder.Where(sq.Eq{"urn": filter.URN})
+	}
+	if len(filter.Statuses) > 0 {
+		builder = builder.Where(sq.Eq{"status": filter.Statuses})
+	}
+	if filter.Limit > 0 {
+		builder = builder.Limit(uint64(filter.Limit))
+	}
+
+	q, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// read within the mutation when called from its hooks.
+	var db sqlx.QueryerContext = st.db
+	if tx, ok := txFromContext(ctx); ok {
+		db = tx
+	}
+
+	var models []operationModel
+	if err := sqlx.SelectContext(ctx, db, &models, q, args...); err != nil {
+		return nil, err
+	}
+
+	ops := make([]operation.Operation, 0, len(models))
+	for _, m := range models {
+		ops = append(ops, m.toOperation())
+	}
+	return ops, nil
+}
+
+func (st *Store) UpdateOperation(ctx context.Context, op operation.Operation) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "UpdateOperation"),
+			attribute.String(string(semconv.DBSQLTableKey), tableOperations),
+		}...,
+	)
+
+	var db sq.BaseRunner = st.db
+	if tx, ok := txFromContext(ctx); ok {
+		db = tx
+	}
+
+	result, err := sq.Update(tableOperations).
+		SetMap(map[string]any{
+			"status":        op.Status,
+			"pending_steps": textArray(op.PendingSteps),
+			"error":         op.Error,
+			"finished_at":   nullTime(op.FinishedAt),
+		}).
+		Where(sq.Eq{"id": op.ID}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("operation with id '%d' not found", op.ID)
+	}
+	return nil
+}
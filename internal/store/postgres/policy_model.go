@@ -0,0 +1,45 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/goto/entropy/core/policy"
+)
+
+type policyModel struct {
+	ID          int64          `db:"id"`
+	Name        string         `db:"name"`
+	Description string         `db:"description"`
+	Project     string         `db:"project"`
+	Kind        string         `db:"kind"`
+	Actions     pq.StringArray `db:"actions"`
+	Expression  string         `db:"expression"`
+	Message     string         `db:"message"`
+	Mode        string         `db:"mode"`
+	Disabled    bool           `db:"disabled"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+	CreatedBy   string         `db:"created_by"`
+	UpdatedBy   string         `db:"updated_by"`
+}
+
+func (m policyModel) toPolicy() policy.Policy {
+	return policy.Policy{
+		ID:          m.ID,
+		Name:        m.Name,
+		Description: m.Description,
+		Project:     m.Project,
+		Kind:        m.Kind,
+		Actions:     m.Actions,
+		Expression:  m.Expression,
+		Message:     m.Message,
+		Mode:        m.Mode,
+		Disabled:    m.Disabled,
+		CreatedAt:   m.CreatedAt,
+		UpdatedAt:   m.UpdatedAt,
+		CreatedBy:   m.CreatedBy,
+		UpdatedBy:   m.UpdatedBy,
+	}
+}
@@ -0,0 +1,170 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/policy"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreatePolicy(ctx context.Context, p policy.Policy) (*policy.Policy, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CreatePolicy"),
+			attribute.String(string(semconv.DBSQLTableKey), tablePolicies),
+		}...,
+	)
+
+	if p.CreatedAt.IsZero() {
+		p.CreatedAt = time.Now()
+		p.UpdatedAt = p.CreatedAt
+	}
+
+	err := sq.Insert(tablePolicies).
+		Columns("name", "description", "project", "kind", "actions", "expression", "message", "mode",
+			"disabled", "created_at", "updated_at", "created_by", "updated_by").
+		Values(p.Name, p.Description, p.Project, p.Kind, textArray(p.Actions), p.Expression, p.Message, p.Mode,
+			p.Disabled, p.CreatedAt, p.UpdatedAt, p.CreatedBy, p.UpdatedBy).
+		Suffix(`RETURNING "id"`).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		QueryRowContext(ctx).
+		Scan(&p.ID)
+	if err != nil {
+		return nil, translateErr(err)
+	}
+	return &p, nil
+}
+
+func (st *Store) GetPolicy(ctx context.Context, id int64) (*policy.Policy, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetPolicy"),
+			attribute.String(string(semconv.DBSQLTableKey), tablePolicies),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tablePolicies).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var m policyModel
+	if err := st.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.WithCausef("policy with id '%d' not found", id)
+		}
+		return nil, err
+	}
+
+	p := m.toPolicy()
+	return &p, nil
+}
+
+func (st *Store) ListPolicies(ctx context.Context) ([]policy.Policy, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListPolicies"),
+			attribute.String(string(semconv.DBSQLTableKey), tablePolicies),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tablePolicies).
+		OrderBy("id").
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var models []policyModel
+	if err := st.db.SelectContext(ctx, &models, q, args...); err != nil {
+		return nil, err
+	}
+
+	policies := make([]policy.Policy, 0, len(models))
+	for _, m := range models {
+		policies = append(policies, m.toPolicy())
+	}
+	return policies, nil
+}
+
+func (st *Store) UpdatePolicy(ctx context.Context, p policy.Policy) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "UpdatePolicy"),
+			attribute.String(string(semconv.DBSQLTableKey), tablePolicies),
+		}...,
+	)
+
+	result, err := sq.Update(tablePolicies).
+		SetMap(map[string]any{
+			"name":        p.Name,
+			"description": p.Description,
+			"project":     p.Project,
+			"kind":        p.Kind,
+			"actions":     textArray(p.Actions),
+			"expression":  p.Expression,
+			"message":     p.Message,
+			"mode":        p.Mode,
+			"disabled":    p.Disabled,
+			"updated_at":  p.UpdatedAt,
+			"updated_by":  p.UpdatedBy,
+		}).
+		Where(sq.Eq{"id": p.ID}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return translateErr(err)
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("policy with id '%d' not found", p.ID)
+	}
+	return nil
+}
+
+func (st *Store) DeletePolicy(ctx context.Context, id int64) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "DeletePolicy"),
+			attribute.String(string(semconv.DBSQLTableKey), tablePolicies),
+		}...,
+	)
+
+	result, err := sq.Delete(tablePolicies).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("policy with id '%d' not found", id)
+	}
+	return nil
+}
@@ -21,6 +21,20 @@ const (
 	tableRevisions    = "revisions"
 	tableRevisionTags = "revision_tags"
 	columnRevisionID  = "revision_id"
+
+	tableAuditEvents    = "audit_events"
+	tableSyncRuns       = "sync_runs"
+	tableResourceEvents = "resource_events"
+
+	tableWebhooks          = "webhooks"
+	tableWebhookDeliveries = "webhook_deliveries"
+
+	tableScheduledActions   = "scheduled_actions"
+	tableLocks              = "resource_locks"
+	tablePolicies           = "admission_policies"
+	tableRoleBindings       = "role_bindings"
+	tableIdempotencyRecords = "idempotency_records"
+	tableOperations         = "operations"
 )
 
 // schema represents the storage schema.
@@ -34,6 +48,8 @@ type Store struct {
 	extendInterval  time.Duration
 	refreshInterval time.Duration
 	config          Config
+	syncListener    *notifyListener
+	eventListener   *notifyListener
 }
 
 type Config struct {
@@ -46,7 +62,15 @@ func (st *Store) Migrate(ctx context.Context) error {
 	return err
 }
 
-func (st *Store) Close() error { return st.db.Close() }
+func (st *Store) Close() error {
+	if err := st.syncListener.close(); err != nil {
+		return err
+	}
+	if err := st.eventListener.close(); err != nil {
+		return err
+	}
+	return st.db.Close()
+}
 
 // Open returns store instance backed by PostgresQL.
 func Open(conStr string, refreshInterval, extendInterval time.Duration, paginationSizeDefault, paginationPageDefault int32) (*Store, error) {
@@ -85,5 +109,7 @@ func Open(conStr string, refreshInterval, extendInterval time.Duration, paginati
 			PaginationSizeDefault: paginationSizeDefault,
 			PaginationPageDefault: paginationPageDefault,
 		},
+		syncListener:  newNotifyListener(conStr, channelSyncDue),
+		eventListener: newNotifyListener(conStr, channelResourceEvents),
 	}, nil
 }
@@ -0,0 +1,29 @@
+package postgres
+
+import (
+	"context"
+
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// LockProject takes a lock on the project that is held until the write
+// whose hooks call it is committed.
+func (st *Store) LockProject(ctx context.Context, project string) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "LockProject"),
+		}...,
+	)
+
+	tx, ok := txFromContext(ctx)
+	if !ok {
+		return errors.ErrInternal.WithMsgf("project can only be locked within a write")
+	}
+
+	_, err := tx.ExecContext(ctx, "SELECT pg_advisory_xact_lock(hashtext($1))", "project:"+project)
+	return err
+}
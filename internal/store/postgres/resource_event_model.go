@@ -0,0 +1,60 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+
+	"github.com/goto/entropy/core/resource"
+)
+
+type resourceEventModel struct {
+	ID        int64     `db:"id"`
+	EventType string    `db:"event_type"`
+	URN       string    `db:"urn"`
+	Resource  []byte    `db:"resource"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func (m resourceEventModel) toEvent() (resource.Event, error) {
+	var res resource.Resource
+	if err := json.Unmarshal(m.Resource, &res); err != nil {
+		return resource.Event{}, err
+	}
+
+	return resource.Event{
+		ID:        m.ID,
+		Type:      m.EventType,
+		Timestamp: m.CreatedAt,
+		Resource:  res,
+	}, nil
+}
+
+// insertResourceEvent appends the event to the feed and notifies the
+// watchers on commit of tx. The event records the id of tx, readers order
+// the feed by it so that events committed later never sort before the ones
+// already read.
+func insertResourceEvent(ctx context.Context, tx *sqlx.Tx, eventType string, r resource.Resource) error {
+	ev := resource.NewEvent(eventType, r, time.Now())
+
+	resJSON, err := json.Marshal(ev.Resource)
+	if err != nil {
+		return err
+	}
+
+	_, err = sq.Insert(tableResourceEvents).
+		Columns("event_type", "urn", "resource", "created_at").
+		Values(ev.Type, r.URN, resJSON, ev.Timestamp).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(tx).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", channelResourceEvents, r.URN)
+	return err
+}
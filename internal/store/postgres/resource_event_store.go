@@ -0,0 +1,137 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) ResourceEvents(ctx context.Context, afterID int64, limit int) ([]resource.Event, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ResourceEvents"),
+			attribute.String(string(semconv.DBSQLTableKey), tableResourceEvents),
+		}...,
+	)
+
+	// the feed is read in the order of the transactions writing it, up to the
+	// oldest one still running. Events committed later cannot sort before
+	// the ones read then.
+	builder := sq.Select("id", "event_type", "urn", "resource", "created_at").
+		From(tableResourceEvents).
+		Where(sq.Expr("xact_id < pg_snapshot_xmin(pg_current_snapshot())")).
+		OrderBy("xact_id ASC", "id ASC")
+	if afterID > 0 {
+		builder = builder.Where(sq.Expr(
+			"(xact_id, id) > (SELECT xact_id, id FROM "+tableResourceEvents+" WHERE id = ?)", afterID,
+		))
+	}
+	if limit > 0 {
+		builder = builder.Limit(uint64(limit))
+	}
+
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var models []resourceEventModel
+	if err := st.db.SelectContext(ctx, &models, query, args...); err != nil {
+		return nil, err
+	}
+
+	// checked after reading so that events pruned meanwhile are noticed.
+	// watches started on an empty feed read after 0, which never expires.
+	if afterID > 0 {
+		var retained bool
+		query := "SELECT EXISTS (SELECT 1 FROM " + tableResourceEvents + " WHERE id = $1)"
+		if err := st.db.QueryRowxContext(ctx, query, afterID).Scan(&retained); err != nil {
+			return nil, err
+		} else if !retained {
+			return nil, errors.ErrInvalid.WithMsgf("watch token has expired")
+		}
+	}
+
+	events := make([]resource.Event, 0, len(models))
+	for _, m := range models {
+		ev, err := m.toEvent()
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, ev)
+	}
+	return events, nil
+}
+
+func (st *Store) LastResourceEventID(ctx context.Context) (int64, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "LastResourceEventID"),
+			attribute.String(string(semconv.DBSQLTableKey), tableResourceEvents),
+		}...,
+	)
+
+	query, args, err := sq.Select("id").
+		From(tableResourceEvents).
+		Where(sq.Expr("xact_id < pg_snapshot_xmin(pg_current_snapshot())")).
+		OrderBy("xact_id DESC", "id DESC").
+		Limit(1).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	var lastID int64
+	if err := st.db.QueryRowxContext(ctx, query, args...).Scan(&lastID); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return lastID, nil
+}
+
+func (st *Store) PruneResourceEvents(ctx context.Context, before time.Time) (int, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "PruneResourceEvents"),
+			attribute.String(string(semconv.DBSQLTableKey), tableResourceEvents),
+		}...,
+	)
+
+	query, args, err := sq.Delete(tableResourceEvents).
+		Where(sq.Lt{"created_at": before}).
+		Where(sq.Expr("id <> (SELECT id FROM " + tableResourceEvents + " ORDER BY xact_id DESC, id DESC LIMIT 1)")).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return 0, err
+	}
+
+	result, err := st.db.ExecContext(ctx, query, args...)
+	if err != nil {
+		return 0, err
+	}
+
+	pruned, err := result.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+	return int(pruned), nil
+}
+
+func (st *Store) ResourceEventNotifications(ctx context.Context) (<-chan struct{}, error) {
+	return st.eventListener.subscribe(ctx)
+}
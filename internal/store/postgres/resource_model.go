@@ -13,79 +13,137 @@ import (
 	"github.com/goto/entropy/pkg/errors"
 )
 
-const listResourceByFilterQuery = `SELECT r.id, r.urn, r.kind, r.name, r.project, r.created_at, r.updated_at, r.state_status, r.state_output, r.state_module_data, r.state_next_sync, r.state_sync_result, r.created_by, r.updated_by,
+// listResourceFilterClause filters resources by project ($1), kind ($2),
+// labels as tags ($3) and the keyset pagination cursor ($4). A resource
+// matches the labels only when it has all of the given tags.
+const listResourceFilterClause = `WHERE ($1 = '' OR r.project = $1)
+  AND ($2 = '' OR r.kind = $2)
+  AND (cardinality($3::text[]) = 0 OR r.id IN (
+      SELECT rtf.resource_id
+      FROM resource_tags rtf
+      WHERE rtf.tag = ANY ($3::text[])
+      GROUP BY rtf.resource_id
+      HAVING count(DISTINCT rtf.tag) = cardinality($3::text[])
+  ))
+  AND r.id > $4
+`
+
+const countResourceByFilterQuery = `SELECT count(*)
+FROM resources r
+` + listResourceFilterClause
+
+const listResourceByFilterQuery = `SELECT r.id, r.urn, r.kind, r.name, r.project, r.created_at, r.updated_at, r.state_status, r.state_output, r.state_module_data, r.state_next_sync, r.state_sync_result, r.state_delete_deferred, r.state_output_changed, r.state_previous_dependencies, r.created_by, r.updated_by, r.version,
 	COALESCE(NULLIF(array_agg(rt.tag), '{NULL}'), '{}')::text[] AS tags,
 	jsonb_object_agg(COALESCE(rd.dependency_key, ''), d.urn) AS dependencies
 FROM resources r
          LEFT JOIN resource_dependencies rd ON r.id = rd.resource_id
          LEFT JOIN resources d ON rd.depends_on = d.id
          LEFT JOIN resource_tags rt ON r.id = rt.resource_id
-WHERE ($1 = '' OR r.project = $1)
-  AND ($2 = '' OR r.kind = $2)
-GROUP BY r.id
-LIMIT $3
-OFFSET $4
+` + listResourceFilterClause + `GROUP BY r.id
+ORDER BY r.id
+LIMIT $5
+OFFSET $6
 `
 
-const listResourceWithSpecConfigsByFilterQuery = `SELECT r.id, r.urn, r.kind, r.name, r.project, r.created_at, r.updated_at, r.spec_configs, r.state_status, r.state_output, r.state_module_data, r.state_next_sync, r.state_sync_result, r.created_by, r.updated_by,
+const listResourceWithSpecConfigsByFilterQuery = `SELECT r.id, r.urn, r.kind, r.name, r.project, r.created_at, r.updated_at, r.spec_configs, r.state_status, r.state_output, r.state_module_data, r.state_next_sync, r.state_sync_result, r.state_delete_deferred, r.state_output_changed, r.state_previous_dependencies, r.created_by, r.updated_by, r.version,
 	COALESCE(NULLIF(array_agg(rt.tag), '{NULL}'), '{}')::text[] AS tags,
 	jsonb_object_agg(COALESCE(rd.dependency_key, ''), d.urn) AS dependencies
 FROM resources r
          LEFT JOIN resource_dependencies rd ON r.id = rd.resource_id
          LEFT JOIN resources d ON rd.depends_on = d.id
          LEFT JOIN resource_tags rt ON r.id = rt.resource_id
-WHERE ($1 = '' OR r.project = $1)
-  AND ($2 = '' OR r.kind = $2)
-GROUP BY r.id
-LIMIT $3
-OFFSET $4
+` + listResourceFilterClause + `GROUP BY r.id
+ORDER BY r.id
+LIMIT $5
+OFFSET $6
 `
 
-type resourceModel struct {
-	ID              int64           `db:"id"`
-	URN             string          `db:"urn"`
-	Kind            string          `db:"kind"`
-	Name            string          `db:"name"`
-	Project         string          `db:"project"`
-	CreatedAt       time.Time       `db:"created_at"`
-	UpdatedAt       time.Time       `db:"updated_at"`
-	CreatedBy       string          `db:"created_by"`
-	UpdatedBy       string          `db:"updated_by"`
-	SpecConfigs     []byte          `db:"spec_configs"`
-	StateStatus     string          `db:"state_status"`
-	StateOutput     []byte          `db:"state_output"`
-	StateModuleData []byte          `db:"state_module_data"`
-	StateNextSync   *time.Time      `db:"state_next_sync"`
-	StateSyncResult json.RawMessage `db:"state_sync_result"`
+// listResourceParams holds the arguments for the list queries above.
+type listResourceParams struct {
+	Project string
+	Kind    string
+	Tags    []string
+	AfterID int64
+	Limit   int32
+	Offset  int32
 }
 
-type ListResourceByFilterRow struct {
-	ID              int64
-	Urn             string
-	Kind            string
-	Name            string
-	Project         string
-	CreatedAt       *time.Time
-	UpdatedAt       *time.Time
-	SpecConfigs     []byte
-	StateStatus     string
-	StateOutput     []byte
-	StateModuleData []byte
-	StateNextSync   *time.Time
-	StateSyncResult []byte
-	CreatedBy       string
-	UpdatedBy       string
-	Tags            pq.StringArray
-	Dependencies    []byte
+func (p listResourceParams) filterArgs() []any {
+	tags := p.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	return []any{p.Project, p.Kind, pq.StringArray(tags), p.AfterID}
 }
 
-func listResourceWithSpecConfigsByFilter(ctx context.Context, db *sqlx.DB, project, kind string, limit int32, offset int32) ([]ListResourceByFilterRow, error) {
+func (p listResourceParams) args() []any {
 	// Set limit default to nil
 	var limitPointers *int32
-	if limit != 0 {
-		limitPointers = &limit
+	if p.Limit != 0 {
+		limitPointers = &p.Limit
+	}
+	return append(p.filterArgs(), limitPointers, p.Offset)
+}
+
+type resourceModel struct {
+	ID                  int64           `db:"id"`
+	URN                 string          `db:"urn"`
+	Kind                string          `db:"kind"`
+	Name                string          `db:"name"`
+	Project             string          `db:"project"`
+	CreatedAt           time.Time       `db:"created_at"`
+	UpdatedAt           time.Time       `db:"updated_at"`
+	CreatedBy           string          `db:"created_by"`
+	UpdatedBy           string          `db:"updated_by"`
+	SpecConfigs         []byte          `db:"spec_configs"`
+	StateStatus         string          `db:"state_status"`
+	StateOutput         []byte          `db:"state_output"`
+	StateModuleData     []byte          `db:"state_module_data"`
+	StateNextSync       *time.Time      `db:"state_next_sync"`
+	StateSyncResult     json.RawMessage `db:"state_sync_result"`
+	StateDeleteDeferred bool            `db:"state_delete_deferred"`
+	StateOutputChanged  bool            `db:"state_output_changed"`
+	StatePreviousDeps   []byte          `db:"state_previous_dependencies"`
+	Version             int64           `db:"version"`
+}
+
+type ListResourceByFilterRow struct {
+	ID                  int64
+	Urn                 string
+	Kind                string
+	Name                string
+	Project             string
+	CreatedAt           *time.Time
+	UpdatedAt           *time.Time
+	SpecConfigs         []byte
+	StateStatus         string
+	StateOutput         []byte
+	StateModuleData     []byte
+	StateNextSync       *time.Time
+	StateSyncResult     []byte
+	StateDeleteDeferred bool
+	StateOutputChanged  bool
+	StatePreviousDeps   []byte
+	CreatedBy           string
+	UpdatedBy           string
+	Version             int64
+	Tags                pq.StringArray
+	Dependencies        []byte
+}
+
+func countResourceByFilter(ctx context.Context, r sqlx.QueryerContext, params listResourceParams) (int64, error) {
+	// total count must not depend on the page being fetched.
+	params.AfterID = 0
+
+	var count int64
+	if err := r.QueryRowxContext(ctx, countResourceByFilterQuery, params.filterArgs()...).Scan(&count); err != nil {
+		return 0, err
 	}
-	rows, err := db.QueryContext(ctx, listResourceWithSpecConfigsByFilterQuery, project, kind, limitPointers, offset)
+	return count, nil
+}
+
+func listResourceWithSpecConfigsByFilter(ctx context.Context, r sqlx.QueryerContext, params listResourceParams) ([]ListResourceByFilterRow, error) {
+	rows, err := r.QueryContext(ctx, listResourceWithSpecConfigsByFilterQuery, params.args()...)
 	if err != nil {
 		return nil, err
 	}
@@ -108,8 +166,12 @@ func listResourceWithSpecConfigsByFilter(ctx context.Context, db *sqlx.DB, proje
 			&i.StateModuleData,
 			&i.StateNextSync,
 			&i.StateSyncResult,
+			&i.StateDeleteDeferred,
+			&i.StateOutputChanged,
+			&i.StatePreviousDeps,
 			&i.CreatedBy,
 			&i.UpdatedBy,
+			&i.Version,
 			&i.Tags,
 			&i.Dependencies,
 		); err != nil {
@@ -124,13 +186,8 @@ func listResourceWithSpecConfigsByFilter(ctx context.Context, db *sqlx.DB, proje
 	return items, nil
 }
 
-func listResourceByFilter(ctx context.Context, db *sqlx.DB, project, kind string, limit int32, offset int32) ([]ListResourceByFilterRow, error) {
-	// Set limit default to nil
-	var limitPointers *int32
-	if limit != 0 {
-		limitPointers = &limit
-	}
-	rows, err := db.QueryContext(ctx, listResourceByFilterQuery, project, kind, limitPointers, offset)
+func listResourceByFilter(ctx context.Context, r sqlx.QueryerContext, params listResourceParams) ([]ListResourceByFilterRow, error) {
+	rows, err := r.QueryContext(ctx, listResourceByFilterQuery, params.args()...)
 	if err != nil {
 		return nil, err
 	}
@@ -151,8 +208,12 @@ func listResourceByFilter(ctx context.Context, db *sqlx.DB, project, kind string
 			&i.StateModuleData,
 			&i.StateNextSync,
 			&i.StateSyncResult,
+			&i.StateDeleteDeferred,
+			&i.StateOutputChanged,
+			&i.StatePreviousDeps,
 			&i.CreatedBy,
 			&i.UpdatedBy,
+			&i.Version,
 			&i.Tags,
 			&i.Dependencies,
 		); err != nil {
@@ -170,7 +231,8 @@ func readResourceRecord(ctx context.Context, r sqlx.QueryerContext, urn string,
 	cols := []string{
 		"id", "urn", "kind", "project", "name", "created_at", "updated_at", "created_by", "updated_by",
 		"spec_configs", "state_status", "state_output", "state_module_data",
-		"state_next_sync", "state_sync_result",
+		"state_next_sync", "state_sync_result", "state_delete_deferred", "state_output_changed", "state_previous_dependencies",
+		"version",
 	}
 	builder := sq.Select(cols...).From(tableResources).Where(sq.Eq{"urn": urn})
 
@@ -217,6 +279,29 @@ func readResourceDeps(ctx context.Context, r sq.BaseRunner, id int64, into map[s
 	return rows.Err()
 }
 
+func readResourceDependents(ctx context.Context, r sq.BaseRunner, id int64, into *[]string) error {
+	q := sq.Select("r.urn").
+		From("resource_dependencies rd").
+		Join("resources r ON r.id=rd.resource_id").
+		Where(sq.Eq{"rd.depends_on": id}).
+		OrderBy("r.id")
+
+	rows, err := q.PlaceholderFormat(sq.Dollar).RunWith(r).QueryContext(ctx)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var urn string
+		if err := rows.Scan(&urn); err != nil {
+			return err
+		}
+		*into = append(*into, urn)
+	}
+	return rows.Err()
+}
+
 func translateURNToID(ctx context.Context, r sq.BaseRunner, urn string) (int64, error) {
 	row := sq.Select("id").
 		From(tableResources).
@@ -58,6 +58,15 @@ func (st *Store) GetByURN(ctx context.Context, urn string) (*resource.Resource,
 		}
 	}
 
+	var prevDeps map[string]string
+	if len(rec.StatePreviousDeps) > 0 {
+		if err := json.Unmarshal(rec.StatePreviousDeps, &prevDeps); err != nil {
+			return nil, errors.ErrInternal.
+				WithMsgf("failed to json unmarshal state_previous_dependencies").
+				WithCausef("%s", err.Error())
+		}
+	}
+
 	return &resource.Resource{
 		URN:       rec.URN,
 		Kind:      rec.Kind,
@@ -68,23 +77,25 @@ func (st *Store) GetByURN(ctx context.Context, urn string) (*resource.Resource,
 		UpdatedAt: rec.UpdatedAt,
 		CreatedBy: rec.CreatedBy,
 		UpdatedBy: rec.UpdatedBy,
+		Version:   rec.Version,
 		Spec: resource.Spec{
 			Configs:      rec.SpecConfigs,
 			Dependencies: deps,
 		},
 		State: resource.State{
-			Status:     rec.StateStatus,
-			Output:     rec.StateOutput,
-			ModuleData: rec.StateModuleData,
-			NextSyncAt: rec.StateNextSync,
-			SyncResult: syncResult,
+			Status:               rec.StateStatus,
+			Output:               rec.StateOutput,
+			ModuleData:           rec.StateModuleData,
+			NextSyncAt:           rec.StateNextSync,
+			SyncResult:           syncResult,
+			DeleteDeferred:       rec.StateDeleteDeferred,
+			OutputChanged:        rec.StateOutputChanged,
+			PreviousDependencies: prevDeps,
 		},
 	}, nil
 }
 
-func (st *Store) List(ctx context.Context, filter resource.Filter, withSpecConfigs bool) ([]resource.Resource, error) {
-	var resourceList []ListResourceByFilterRow
-
+func (st *Store) List(ctx context.Context, filter resource.Filter, withSpecConfigs bool) (resource.PagedResource, error) {
 	if filter.PageSize < 1 {
 		filter.PageSize = st.config.PaginationSizeDefault
 	}
@@ -92,7 +103,29 @@ func (st *Store) List(ctx context.Context, filter resource.Filter, withSpecConfi
 		filter.PageNum = st.config.PaginationPageDefault
 	}
 
-	offset := (filter.PageNum - 1) * filter.PageSize
+	params := listResourceParams{
+		Project: filter.Project,
+		Kind:    filter.Kind,
+		Tags:    labelMapToTags(filter.Labels),
+		Limit:   filter.PageSize,
+		Offset:  (filter.PageNum - 1) * filter.PageSize,
+	}
+
+	if filter.PageToken != "" {
+		afterID, err := decodePageToken(filter.PageToken)
+		if err != nil {
+			return resource.PagedResource{}, err
+		}
+		// page token and page number are mutually exclusive. when a token
+		// is given, the page starts right after the cursor.
+		params.AfterID = afterID
+		params.Offset = 0
+	}
+
+	if params.Limit > 0 {
+		// fetch one extra row to find out if there is a next page.
+		params.Limit++
+	}
 
 	ctx = otelsql.WithCustomAttributes(
 		ctx,
@@ -102,14 +135,30 @@ func (st *Store) List(ctx context.Context, filter resource.Filter, withSpecConfi
 		}...,
 	)
 
-	var err error
-	if withSpecConfigs {
-		resourceList, err = listResourceWithSpecConfigsByFilter(ctx, st.db, filter.Project, filter.Kind, filter.PageSize, offset)
-	} else {
-		resourceList, err = listResourceByFilter(ctx, st.db, filter.Project, filter.Kind, filter.PageSize, offset)
+	var count int64
+	var resourceList []ListResourceByFilterRow
+	listResources := func(ctx context.Context, tx *sqlx.Tx) error {
+		var err error
+		count, err = countResourceByFilter(ctx, tx, params)
+		if err != nil {
+			return err
+		}
+
+		if withSpecConfigs {
+			resourceList, err = listResourceWithSpecConfigsByFilter(ctx, tx, params)
+		} else {
+			resourceList, err = listResourceByFilter(ctx, tx, params)
+		}
+		return err
 	}
-	if err != nil {
-		return nil, err
+	if err := withinTx(ctx, st.db, true, listResources); err != nil {
+		return resource.PagedResource{}, translateErr(err)
+	}
+
+	var nextPageToken string
+	if filter.PageSize > 0 && len(resourceList) > int(filter.PageSize) {
+		resourceList = resourceList[:filter.PageSize]
+		nextPageToken = encodePageToken(resourceList[len(resourceList)-1].ID)
 	}
 
 	var result []resource.Resource
@@ -122,13 +171,20 @@ func (st *Store) List(ctx context.Context, filter resource.Filter, withSpecConfi
 		var syncResult resource.SyncResult
 		if len(res.StateSyncResult) > 0 {
 			if err := json.Unmarshal(res.StateSyncResult, &syncResult); err != nil {
-				return nil, err
+				return resource.PagedResource{}, err
+			}
+		}
+
+		var prevDeps map[string]string
+		if len(res.StatePreviousDeps) > 0 {
+			if err := json.Unmarshal(res.StatePreviousDeps, &prevDeps); err != nil {
+				return resource.PagedResource{}, err
 			}
 		}
 
 		deps, err := depsBytesToMap(res.Dependencies)
 		if err != nil {
-			return nil, err
+			return resource.PagedResource{}, err
 		}
 
 		result = append(result, resource.Resource{
@@ -141,21 +197,29 @@ func (st *Store) List(ctx context.Context, filter resource.Filter, withSpecConfi
 			UpdatedAt: *res.UpdatedAt,
 			UpdatedBy: res.UpdatedBy,
 			CreatedBy: res.CreatedBy,
+			Version:   res.Version,
 			Spec: resource.Spec{
 				Configs:      res.SpecConfigs,
 				Dependencies: deps,
 			},
 			State: resource.State{
-				Status:     res.StateStatus,
-				Output:     res.StateOutput,
-				ModuleData: res.StateModuleData,
-				NextSyncAt: nextSyncAt,
-				SyncResult: syncResult,
+				Status:               res.StateStatus,
+				Output:               res.StateOutput,
+				ModuleData:           res.StateModuleData,
+				NextSyncAt:           nextSyncAt,
+				SyncResult:           syncResult,
+				DeleteDeferred:       res.StateDeleteDeferred,
+				OutputChanged:        res.StateOutputChanged,
+				PreviousDependencies: prevDeps,
 			},
 		})
 	}
 
-	return result, nil
+	return resource.PagedResource{
+		Count:         int32(count),
+		Resources:     result,
+		NextPageToken: nextPageToken,
+	}, nil
 }
 
 func (st *Store) Create(ctx context.Context, r resource.Resource, hooks ...resource.MutationHook) error {
@@ -185,7 +249,17 @@ func (st *Store) Create(ctx context.Context, r resource.Resource, hooks ...resou
 			return translateErr(err)
 		}
 
-		return runAllHooks(ctx, hooks)
+		if err := notifySyncScheduled(ctx, tx, r, nil); err != nil {
+			return err
+		}
+
+		created := r
+		created.Version = 1
+		if err := insertResourceEvent(ctx, tx, resource.EventCreated, created); err != nil {
+			return err
+		}
+
+		return runAllHooks(ctx, tx, hooks)
 	}
 
 	ctx = otelsql.WithCustomAttributes(
@@ -210,22 +284,41 @@ func (st *Store) Update(ctx context.Context, r resource.Resource, saveRevision b
 			return err
 		}
 
+		prevNextSync, err := readNextSync(ctx, tx, id)
+		if err != nil {
+			return err
+		}
+
+		// the update only applies if nobody else updated the resource since
+		// it was read.
 		updateSpec := sq.Update(tableResources).
-			Where(sq.Eq{"id": id}).
+			Where(sq.Eq{"id": id, "version": r.Version}).
 			SetMap(map[string]interface{}{
-				"updated_at":        sq.Expr("current_timestamp"),
-				"updated_by":        r.UpdatedBy,
-				"spec_configs":      r.Spec.Configs,
-				"state_status":      r.State.Status,
-				"state_output":      r.State.Output,
-				"state_module_data": r.State.ModuleData,
-				"state_next_sync":   r.State.NextSyncAt,
-				"state_sync_result": syncResultAsJSON(r.State.SyncResult),
+				"version":                     sq.Expr("version + 1"),
+				"updated_at":                  sq.Expr("current_timestamp"),
+				"updated_by":                  r.UpdatedBy,
+				"spec_configs":                r.Spec.Configs,
+				"state_status":                r.State.Status,
+				"state_output":                r.State.Output,
+				"state_module_data":           r.State.ModuleData,
+				"state_next_sync":             r.State.NextSyncAt,
+				"state_sync_result":           syncResultAsJSON(r.State.SyncResult),
+				"state_delete_deferred":       r.State.DeleteDeferred,
+				"state_output_changed":        r.State.OutputChanged,
+				"state_previous_dependencies": previousDepsAsJSON(r.State.PreviousDependencies),
 			}).
 			PlaceholderFormat(sq.Dollar)
 
-		if _, err := updateSpec.RunWith(tx).ExecContext(ctx); err != nil {
+		result, err := updateSpec.RunWith(tx).ExecContext(ctx)
+		if err != nil {
+			return err
+		}
+
+		if affected, err := result.RowsAffected(); err != nil {
 			return err
+		} else if affected == 0 {
+			return errors.ErrConflict.
+				WithMsgf("resource '%s' was modified concurrently (version %d is stale)", r.URN, r.Version)
 		}
 
 		if err := setResourceTags(ctx, tx, id, r.Labels); err != nil {
@@ -250,7 +343,17 @@ func (st *Store) Update(ctx context.Context, r resource.Resource, saveRevision b
 			}
 		}
 
-		return runAllHooks(ctx, hooks)
+		if err := notifySyncScheduled(ctx, tx, r, prevNextSync); err != nil {
+			return err
+		}
+
+		updated := r
+		updated.Version = r.Version + 1
+		if err := insertResourceEvent(ctx, tx, resource.EventUpdated, updated); err != nil {
+			return err
+		}
+
+		return runAllHooks(ctx, tx, hooks)
 	}
 
 	ctx = otelsql.WithCustomAttributes(
@@ -268,14 +371,44 @@ func (st *Store) Update(ctx context.Context, r resource.Resource, saveRevision b
 	return nil
 }
 
-func (st *Store) Delete(ctx context.Context, urn string, hooks ...resource.MutationHook) error {
-	deleteFn := func(ctx context.Context, tx *sqlx.Tx) error {
+func (st *Store) Dependents(ctx context.Context, urn string) ([]string, error) {
+	var urns []string
+	readDependents := func(ctx context.Context, tx *sqlx.Tx) error {
 		id, err := translateURNToID(ctx, tx, urn)
 		if err != nil {
 			return err
 		}
+		return readResourceDependents(ctx, tx, id, &urns)
+	}
+
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "Dependents"),
+			attribute.String(string(semconv.DBSQLTableKey), tableResourceDependencies),
+		}...,
+	)
+
+	if txErr := withinTx(ctx, st.db, true, readDependents); txErr != nil {
+		return nil, translateErr(txErr)
+	}
+	return urns, nil
+}
+
+func (st *Store) Delete(ctx context.Context, urn string, hooks ...resource.MutationHook) error {
+	deleteFn := func(ctx context.Context, tx *sqlx.Tx) error {
+		var rec resourceModel
+		if err := readResourceRecord(ctx, tx, urn, &rec); err != nil {
+			return err
+		}
+		id := rec.ID
 
-		_, err = sq.Delete(tableResourceDependencies).
+		var tags []string
+		if err := readResourceTags(ctx, tx, id, &tags); err != nil {
+			return err
+		}
+
+		_, err := sq.Delete(tableResourceDependencies).
 			Where(sq.Eq{"resource_id": id}).
 			PlaceholderFormat(sq.Dollar).
 			RunWith(tx).
@@ -293,7 +426,27 @@ func (st *Store) Delete(ctx context.Context, urn string, hooks ...resource.Mutat
 			return err
 		}
 
-		return runAllHooks(ctx, hooks)
+		deleted := resource.Resource{
+			URN:       rec.URN,
+			Kind:      rec.Kind,
+			Name:      rec.Name,
+			Project:   rec.Project,
+			Labels:    tagsToLabelMap(tags),
+			CreatedAt: rec.CreatedAt,
+			UpdatedAt: rec.UpdatedAt,
+			CreatedBy: rec.CreatedBy,
+			UpdatedBy: rec.UpdatedBy,
+			Version:   rec.Version,
+			State: resource.State{
+				Status: rec.StateStatus,
+				Output: rec.StateOutput,
+			},
+		}
+		if err := insertResourceEvent(ctx, tx, resource.EventDeleted, deleted); err != nil {
+			return err
+		}
+
+		return runAllHooks(ctx, tx, hooks)
 	}
 
 	ctx = otelsql.WithCustomAttributes(
@@ -307,7 +460,7 @@ func (st *Store) Delete(ctx context.Context, urn string, hooks ...resource.Mutat
 	return withinTx(ctx, st.db, false, deleteFn)
 }
 
-func (st *Store) SyncOne(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn) error {
+func (st *Store) SyncOne(ctx context.Context, scope map[string][]string, syncFn resource.SyncFn, hooks ...resource.MutationHook) error {
 	ctx = otelsql.WithCustomAttributes(
 		ctx,
 		[]attribute.KeyValue{
@@ -335,7 +488,7 @@ func (st *Store) SyncOne(ctx context.Context, scope map[string][]string, syncFn
 		return err
 	}
 
-	return st.Update(ctx, *synced, false, "sync")
+	return st.Update(ctx, *synced, false, "sync", hooks...)
 }
 
 func (st *Store) handleDequeued(baseCtx context.Context, res resource.Resource, fn resource.SyncFn) (*resource.Resource, error) {
@@ -462,6 +615,17 @@ func setDependencies(ctx context.Context, runner sq.BaseRunner, id int64, deps m
 	return nil
 }
 
+func previousDepsAsJSON(deps map[string]string) json.RawMessage {
+	if len(deps) == 0 {
+		return nil
+	}
+	val, err := json.Marshal(deps)
+	if err != nil {
+		panic(err)
+	}
+	return val
+}
+
 func syncResultAsJSON(syncRes resource.SyncResult) json.RawMessage {
 	if syncRes == (resource.SyncResult{}) {
 		return nil
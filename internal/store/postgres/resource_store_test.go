@@ -108,6 +108,49 @@ func (s *ResourceStoreTestSuite) TestSyncOne() {
 	}
 }
 
+func (s *ResourceStoreTestSuite) TestList() {
+	s.Run("filter by labels", func() {
+		page, err := s.store.List(s.ctx, resource.Filter{
+			Labels: map[string]string{"description": "test dagger resource"},
+		}, false)
+		s.Require().NoError(err)
+		s.Equal(int32(3), page.Count)
+		s.Len(page.Resources, 3)
+		s.Empty(page.NextPageToken)
+		for _, res := range page.Resources {
+			s.Equal("dagger", res.Kind)
+			s.Nil(res.Spec.Configs)
+		}
+	})
+
+	s.Run("paginate with page token", func() {
+		filter := resource.Filter{
+			Kind:     "firehose",
+			Labels:   map[string]string{"description": "test firehose resource"},
+			PageSize: 2,
+		}
+
+		first, err := s.store.List(s.ctx, filter, true)
+		s.Require().NoError(err)
+		s.Equal(int32(3), first.Count)
+		s.Len(first.Resources, 2)
+		s.NotEmpty(first.NextPageToken)
+
+		filter.PageToken = first.NextPageToken
+		second, err := s.store.List(s.ctx, filter, true)
+		s.Require().NoError(err)
+		s.Equal(int32(3), second.Count)
+		s.Len(second.Resources, 1)
+		s.Empty(second.NextPageToken)
+		s.Equal("orn:entropy:firehose:test-project-00:test-firehose-02", second.Resources[0].URN)
+	})
+
+	s.Run("invalid page token", func() {
+		_, err := s.store.List(s.ctx, resource.Filter{PageToken: "???"}, false)
+		s.ErrorIs(err, errors.ErrInvalid)
+	})
+}
+
 func (s *ResourceStoreTestSuite) TearDownTest() {
 	if err := s.pool.Purge(s.resource); err != nil {
 		s.T().Fatal(err)
@@ -8,12 +8,13 @@ import (
 )
 
 type revisionModel struct {
-	ID          int64     `db:"id"`
-	Reason      string    `db:"reason"`
-	CreatedAt   time.Time `db:"created_at"`
-	CreatedBy   string    `db:"created_by"`
-	ResourceID  int64     `db:"resource_id"`
-	SpecConfigs []byte    `db:"spec_configs"`
+	ID               int64     `db:"id"`
+	Reason           string    `db:"reason"`
+	CreatedAt        time.Time `db:"created_at"`
+	CreatedBy        string    `db:"created_by"`
+	ResourceID       int64     `db:"resource_id"`
+	SpecConfigs      []byte    `db:"spec_configs"`
+	SpecDependencies []byte    `db:"spec_dependencies"`
 }
 
 func readRevisionTags(ctx context.Context, r sq.BaseRunner, revisionID int64, into *[]string) error {
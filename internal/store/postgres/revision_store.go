@@ -3,6 +3,7 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/jmoiron/sqlx"
@@ -57,6 +58,16 @@ func (st *Store) Revisions(ctx context.Context, selector resource.RevisionsSelec
 				return err
 			}
 
+			// revisions recorded before dependencies became updatable
+			// do not have them, current ones are the best guess.
+			revDeps := deps
+			if rm.SpecDependencies != nil {
+				revDeps, err = depsBytesToMap(rm.SpecDependencies)
+				if err != nil {
+					return err
+				}
+			}
+
 			revs = append(revs, resource.Revision{
 				ID:        rm.ID,
 				URN:       selector.URN,
@@ -65,7 +76,7 @@ func (st *Store) Revisions(ctx context.Context, selector resource.RevisionsSelec
 				CreatedBy: rm.CreatedBy,
 				Spec: resource.Spec{
 					Configs:      rm.SpecConfigs,
-					Dependencies: deps,
+					Dependencies: revDeps,
 				},
 			})
 		}
@@ -92,9 +103,19 @@ func (st *Store) Revisions(ctx context.Context, selector resource.RevisionsSelec
 }
 
 func insertRevision(ctx context.Context, tx *sqlx.Tx, resID int64, rev resource.Revision) error {
+	deps := rev.Spec.Dependencies
+	if deps == nil {
+		deps = map[string]string{}
+	}
+
+	depsJSON, err := json.Marshal(deps)
+	if err != nil {
+		return err
+	}
+
 	q := sq.Insert(tableRevisions).
-		Columns("resource_id", "reason", "spec_configs", "created_by").
-		Values(resID, rev.Reason, rev.Spec.Configs, rev.CreatedBy).
+		Columns("resource_id", "reason", "spec_configs", "spec_dependencies", "created_by").
+		Values(resID, rev.Reason, rev.Spec.Configs, depsJSON, rev.CreatedBy).
 		Suffix(`RETURNING "id"`).
 		PlaceholderFormat(sq.Dollar)
 
@@ -0,0 +1,33 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/goto/entropy/core/rbac"
+)
+
+type roleBindingModel struct {
+	ID        int64          `db:"id"`
+	Project   string         `db:"project"`
+	User      string         `db:"user_id"`
+	Group     string         `db:"group_name"`
+	Role      string         `db:"role"`
+	Actions   pq.StringArray `db:"actions"`
+	CreatedAt time.Time      `db:"created_at"`
+	CreatedBy string         `db:"created_by"`
+}
+
+func (m roleBindingModel) toBinding() rbac.Binding {
+	return rbac.Binding{
+		ID:        m.ID,
+		Project:   m.Project,
+		User:      m.User,
+		Group:     m.Group,
+		Role:      m.Role,
+		Actions:   m.Actions,
+		CreatedAt: m.CreatedAt,
+		CreatedBy: m.CreatedBy,
+	}
+}
@@ -0,0 +1,129 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/rbac"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) CreateRoleBinding(ctx context.Context, b rbac.Binding) (*rbac.Binding, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CreateRoleBinding"),
+			attribute.String(string(semconv.DBSQLTableKey), tableRoleBindings),
+		}...,
+	)
+
+	if b.CreatedAt.IsZero() {
+		b.CreatedAt = time.Now()
+	}
+
+	err := sq.Insert(tableRoleBindings).
+		Columns("project", "user_id", "group_name", "role", "actions", "created_at", "created_by").
+		Values(b.Project, b.User, b.Group, b.Role, textArray(b.Actions), b.CreatedAt, b.CreatedBy).
+		Suffix(`RETURNING "id"`).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		QueryRowContext(ctx).
+		Scan(&b.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+func (st *Store) GetRoleBinding(ctx context.Context, id int64) (*rbac.Binding, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetRoleBinding"),
+			attribute.String(string(semconv.DBSQLTableKey), tableRoleBindings),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tableRoleBindings).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var m roleBindingModel
+	if err := st.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.WithCausef("role binding with id '%d' not found", id)
+		}
+		return nil, err
+	}
+
+	b := m.toBinding()
+	return &b, nil
+}
+
+func (st *Store) ListRoleBindings(ctx context.Context, project string) ([]rbac.Binding, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListRoleBindings"),
+			attribute.String(string(semconv.DBSQLTableKey), tableRoleBindings),
+		}...,
+	)
+
+	builder := sq.Select("*").From(tableRoleBindings).OrderBy("id")
+	if project != "" {
+		builder = builder.Where(sq.Eq{"project": project})
+	}
+
+	q, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var models []roleBindingModel
+	if err := st.db.SelectContext(ctx, &models, q, args...); err != nil {
+		return nil, err
+	}
+
+	bindings := make([]rbac.Binding, 0, len(models))
+	for _, m := range models {
+		bindings = append(bindings, m.toBinding())
+	}
+	return bindings, nil
+}
+
+func (st *Store) DeleteRoleBinding(ctx context.Context, id int64) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "DeleteRoleBinding"),
+			attribute.String(string(semconv.DBSQLTableKey), tableRoleBindings),
+		}...,
+	)
+
+	result, err := sq.Delete(tableRoleBindings).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("role binding with id '%d' not found", id)
+	}
+	return nil
+}
@@ -0,0 +1,70 @@
+package postgres
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/schedule"
+)
+
+type scheduleModel struct {
+	ID        int64        `db:"id"`
+	URN       string       `db:"urn"`
+	Project   string       `db:"project"`
+	Action    string       `db:"action"`
+	Params    []byte       `db:"params"`
+	Labels    []byte       `db:"labels"`
+	UserID    string       `db:"user_id"`
+	RunAt     sql.NullTime `db:"run_at"`
+	Cron      string       `db:"cron"`
+	Status    string       `db:"status"`
+	NextRunAt time.Time    `db:"next_run_at"`
+	LastRunAt sql.NullTime `db:"last_run_at"`
+	LastError string       `db:"last_error"`
+	CreatedAt time.Time    `db:"created_at"`
+	CreatedBy string       `db:"created_by"`
+}
+
+func (m scheduleModel) toSchedule() (*schedule.Schedule, error) {
+	var labels map[string]string
+	if len(m.Labels) > 0 {
+		if err := json.Unmarshal(m.Labels, &labels); err != nil {
+			return nil, err
+		}
+	}
+
+	s := &schedule.Schedule{
+		ID:      m.ID,
+		URN:     m.URN,
+		Project: m.Project,
+		Action: module.ActionRequest{
+			Name:   m.Action,
+			Params: m.Params,
+			Labels: labels,
+			UserID: m.UserID,
+		},
+		Cron:      m.Cron,
+		Status:    m.Status,
+		NextRunAt: m.NextRunAt,
+		LastError: m.LastError,
+		CreatedAt: m.CreatedAt,
+		CreatedBy: m.CreatedBy,
+	}
+	if m.RunAt.Valid {
+		s.RunAt = m.RunAt.Time
+	}
+	if m.LastRunAt.Valid {
+		lastRunAt := m.LastRunAt.Time
+		s.LastRunAt = &lastRunAt
+	}
+	return s, nil
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil || t.IsZero() {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
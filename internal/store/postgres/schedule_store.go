@@ -0,0 +1,186 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/schedule"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// claimSchedulesQuery leases the due schedules by pushing their next run.
+// Rows locked by other schedulers are skipped.
+const claimSchedulesQuery = `UPDATE scheduled_actions s SET next_run_at = $2
+FROM (
+	SELECT id, next_run_at FROM scheduled_actions
+	WHERE status = $3 AND next_run_at <= $1
+	ORDER BY next_run_at, id
+	LIMIT NULLIF($4, 0)
+	FOR UPDATE SKIP LOCKED
+) due
+WHERE s.id = due.id
+RETURNING s.id, s.urn, s.project, s.action, s.params, s.labels, s.user_id, s.run_at, s.cron, s.status,
+	due.next_run_at, s.last_run_at, s.last_error, s.created_at, s.created_by
+`
+
+func (st *Store) CreateSchedule(ctx context.Context, s schedule.Schedule) (*schedule.Schedule, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CreateSchedule"),
+			attribute.String(string(semconv.DBSQLTableKey), tableScheduledActions),
+		}...,
+	)
+
+	labelsJSON, err := json.Marshal(s.Action.Labels)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.CreatedAt.IsZero() {
+		s.CreatedAt = time.Now()
+	}
+
+	err = sq.Insert(tableScheduledActions).
+		Columns("urn", "project", "action", "params", "labels", "user_id", "run_at", "cron",
+			"status", "next_run_at", "created_at", "created_by").
+		Values(s.URN, s.Project, s.Action.Name, []byte(s.Action.Params), labelsJSON, s.Action.UserID,
+			nullTime(&s.RunAt), s.Cron, s.Status, s.NextRunAt, s.CreatedAt, s.CreatedBy).
+		Suffix(`RETURNING "id"`).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		QueryRowContext(ctx).
+		Scan(&s.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &s, nil
+}
+
+func (st *Store) GetSchedule(ctx context.Context, id int64) (*schedule.Schedule, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetSchedule"),
+			attribute.String(string(semconv.DBSQLTableKey), tableScheduledActions),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tableScheduledActions).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var m scheduleModel
+	if err := st.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.WithCausef("schedule with id '%d' not found", id)
+		}
+		return nil, err
+	}
+	return m.toSchedule()
+}
+
+func (st *Store) ListSchedules(ctx context.Context, filter schedule.Filter) ([]schedule.Schedule, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListSchedules"),
+			attribute.String(string(semconv.DBSQLTableKey), tableScheduledActions),
+		}...,
+	)
+
+	builder := sq.Select("*").From(tableScheduledActions).OrderBy("id")
+	if filter.URN != "" {
+		builder = builder.Where(sq.Eq{"urn": filter.URN})
+	}
+	if filter.Project != "" {
+		builder = builder.Where(sq.Eq{"project": filter.Project})
+	}
+	if filter.Status != "" {
+		builder = builder.Where(sq.Eq{"status": filter.Status})
+	}
+
+	q, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var models []scheduleModel
+	if err := st.db.SelectContext(ctx, &models, q, args...); err != nil {
+		return nil, err
+	}
+	return toSchedules(models)
+}
+
+func (st *Store) ClaimDueSchedules(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]schedule.Schedule, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ClaimDueSchedules"),
+			attribute.String(string(semconv.DBSQLTableKey), tableScheduledActions),
+		}...,
+	)
+
+	var models []scheduleModel
+	err := st.db.SelectContext(ctx, &models, claimSchedulesQuery, now, now.Add(lease), schedule.StatusPending, limit)
+	if err != nil {
+		return nil, err
+	}
+	return toSchedules(models)
+}
+
+func (st *Store) UpdateSchedule(ctx context.Context, s schedule.Schedule) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "UpdateSchedule"),
+			attribute.String(string(semconv.DBSQLTableKey), tableScheduledActions),
+		}...,
+	)
+
+	result, err := sq.Update(tableScheduledActions).
+		Where(sq.Eq{"id": s.ID}).
+		SetMap(map[string]interface{}{
+			"status":      s.Status,
+			"next_run_at": s.NextRunAt,
+			"last_run_at": nullTime(s.LastRunAt),
+			"last_error":  s.LastError,
+		}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("schedule with id '%d' not found", s.ID)
+	}
+	return nil
+}
+
+func toSchedules(models []scheduleModel) ([]schedule.Schedule, error) {
+	schedules := make([]schedule.Schedule, 0, len(models))
+	for _, m := range models {
+		s, err := m.toSchedule()
+		if err != nil {
+			return nil, err
+		}
+		schedules = append(schedules, *s)
+	}
+	return schedules, nil
+}
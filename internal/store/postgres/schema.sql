@@ -76,7 +76,196 @@ ALTER TABLE resources
 
 ALTER TABLE revisions ADD COLUMN IF NOT EXISTS created_by TEXT NOT NULL DEFAULT '<unknown>';
 
+-- dependencies as of the revision. NULL for revisions created before
+-- dependencies became updatable.
+ALTER TABLE revisions ADD COLUMN IF NOT EXISTS spec_dependencies bytea;
+
 ALTER TABLE revision_tags
 DROP CONSTRAINT revision_tags_revision_id_fkey,
     ADD CONSTRAINT revision_tags_revision_id_fkey FOREIGN KEY (revision_id)
-          REFERENCES revisions (id) ON DELETE CASCADE;
\ No newline at end of file
+          REFERENCES revisions (id) ON DELETE CASCADE;
+CREATE TABLE IF NOT EXISTS audit_events
+(
+    id         BIGSERIAL   NOT NULL PRIMARY KEY,
+    timestamp  timestamptz NOT NULL DEFAULT current_timestamp,
+    project    TEXT        NOT NULL DEFAULT '',
+    urn        TEXT        NOT NULL DEFAULT '',
+    user_id    TEXT        NOT NULL DEFAULT '',
+    request_id TEXT        NOT NULL DEFAULT '',
+    action     TEXT        NOT NULL,
+    params     bytea,
+    result     TEXT        NOT NULL,
+    error      TEXT        NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_audit_events_timestamp ON audit_events (timestamp);
+CREATE INDEX IF NOT EXISTS idx_audit_events_project ON audit_events (project);
+CREATE INDEX IF NOT EXISTS idx_audit_events_urn ON audit_events (urn);
+CREATE INDEX IF NOT EXISTS idx_audit_events_user_id ON audit_events (user_id);
+
+-- audit events are append-only.
+CREATE OR REPLACE RULE audit_events_no_update AS ON UPDATE TO audit_events DO INSTEAD NOTHING;
+CREATE OR REPLACE RULE audit_events_no_delete AS ON DELETE TO audit_events DO INSTEAD NOTHING;
+
+CREATE TABLE IF NOT EXISTS sync_runs
+(
+    id          BIGSERIAL   NOT NULL PRIMARY KEY,
+    resource_id BIGINT      NOT NULL REFERENCES resources (id) ON DELETE CASCADE,
+    step        TEXT        NOT NULL,
+    started_at  timestamptz NOT NULL,
+    finished_at timestamptz NOT NULL,
+    outcome     TEXT        NOT NULL,
+    error       TEXT        NOT NULL DEFAULT '',
+    retries     INT         NOT NULL DEFAULT 0
+);
+CREATE INDEX IF NOT EXISTS idx_sync_runs_resource_id ON sync_runs (resource_id);
+
+-- version is incremented on every update, updates are conditional on it.
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS version BIGINT NOT NULL DEFAULT 1;
+
+-- set on resources whose deletion waits for their dependents to be deleted.
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS state_delete_deferred BOOLEAN NOT NULL DEFAULT false;
+
+-- set on resources whose dependents are yet to be notified of an output change.
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS state_output_changed BOOLEAN NOT NULL DEFAULT false;
+
+-- dependencies replaced by a change, kept until the resource is completed.
+ALTER TABLE resources ADD COLUMN IF NOT EXISTS state_previous_dependencies JSONB;
+
+CREATE TABLE IF NOT EXISTS resource_events
+(
+    id         BIGSERIAL   NOT NULL PRIMARY KEY,
+    event_type TEXT        NOT NULL,
+    urn        TEXT        NOT NULL,
+    resource   bytea       NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT current_timestamp
+);
+CREATE INDEX IF NOT EXISTS idx_resource_events_created_at ON resource_events (created_at);
+
+-- id of the transaction that wrote the event. the feed is read in the order
+-- of it, up to the oldest transaction still running.
+ALTER TABLE resource_events ADD COLUMN IF NOT EXISTS xact_id xid8 NOT NULL DEFAULT pg_current_xact_id();
+CREATE INDEX IF NOT EXISTS idx_resource_events_xact_id ON resource_events (xact_id, id);
+
+CREATE TABLE IF NOT EXISTS webhooks
+(
+    id         BIGSERIAL   NOT NULL PRIMARY KEY,
+    project    TEXT        NOT NULL,
+    url        TEXT        NOT NULL,
+    secret     TEXT        NOT NULL,
+    kinds      TEXT[]      NOT NULL DEFAULT '{}',
+    statuses   TEXT[]      NOT NULL DEFAULT '{}',
+    actions    TEXT[]      NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT current_timestamp,
+    created_by TEXT        NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_webhooks_project ON webhooks (project);
+
+-- outbox of webhook events, deliveries are retried until they succeed or
+-- run out of attempts.
+CREATE TABLE IF NOT EXISTS webhook_deliveries
+(
+    id              BIGSERIAL   NOT NULL PRIMARY KEY,
+    webhook_id      BIGINT      NOT NULL REFERENCES webhooks (id) ON DELETE CASCADE,
+    event           bytea       NOT NULL,
+    status          TEXT        NOT NULL,
+    attempts        INT         NOT NULL DEFAULT 0,
+    next_attempt_at timestamptz NOT NULL,
+    last_error      TEXT        NOT NULL DEFAULT '',
+    created_at      timestamptz NOT NULL DEFAULT current_timestamp,
+    updated_at      timestamptz NOT NULL DEFAULT current_timestamp
+);
+CREATE INDEX IF NOT EXISTS idx_webhook_deliveries_due ON webhook_deliveries (status, next_attempt_at);
+
+CREATE TABLE IF NOT EXISTS scheduled_actions
+(
+    id          BIGSERIAL   NOT NULL PRIMARY KEY,
+    urn         TEXT        NOT NULL,
+    project     TEXT        NOT NULL,
+    action      TEXT        NOT NULL,
+    params      bytea,
+    labels      jsonb       NOT NULL DEFAULT '{}'::jsonb,
+    user_id     TEXT        NOT NULL DEFAULT '',
+    run_at      timestamptz,
+    cron        TEXT        NOT NULL DEFAULT '',
+    status      TEXT        NOT NULL,
+    next_run_at timestamptz NOT NULL,
+    last_run_at timestamptz,
+    last_error  TEXT        NOT NULL DEFAULT '',
+    created_at  timestamptz NOT NULL DEFAULT current_timestamp,
+    created_by  TEXT        NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_scheduled_actions_due ON scheduled_actions (status, next_run_at);
+CREATE INDEX IF NOT EXISTS idx_scheduled_actions_urn ON scheduled_actions (urn);
+
+CREATE TABLE IF NOT EXISTS resource_locks
+(
+    id         BIGSERIAL   NOT NULL PRIMARY KEY,
+    project    TEXT        NOT NULL,
+    urn        TEXT        NOT NULL DEFAULT '',
+    labels     jsonb       NOT NULL DEFAULT '{}'::jsonb,
+    reason     TEXT        NOT NULL,
+    owner      TEXT        NOT NULL,
+    expires_at timestamptz,
+    created_at timestamptz NOT NULL DEFAULT current_timestamp,
+    created_by TEXT        NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_resource_locks_project ON resource_locks (project);
+
+CREATE TABLE IF NOT EXISTS admission_policies
+(
+    id          BIGSERIAL   NOT NULL PRIMARY KEY,
+    name        TEXT        NOT NULL UNIQUE,
+    description TEXT        NOT NULL DEFAULT '',
+    project     TEXT        NOT NULL DEFAULT '',
+    kind        TEXT        NOT NULL DEFAULT '',
+    actions     TEXT[]      NOT NULL DEFAULT '{}',
+    expression  TEXT        NOT NULL,
+    message     TEXT        NOT NULL DEFAULT '',
+    mode        TEXT        NOT NULL,
+    disabled    BOOLEAN     NOT NULL DEFAULT false,
+    created_at  timestamptz NOT NULL DEFAULT current_timestamp,
+    updated_at  timestamptz NOT NULL DEFAULT current_timestamp,
+    created_by  TEXT        NOT NULL DEFAULT '',
+    updated_by  TEXT        NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS role_bindings
+(
+    id         BIGSERIAL   NOT NULL PRIMARY KEY,
+    project    TEXT        NOT NULL,
+    user_id    TEXT        NOT NULL DEFAULT '',
+    group_name TEXT        NOT NULL DEFAULT '',
+    role       TEXT        NOT NULL,
+    actions    TEXT[]      NOT NULL DEFAULT '{}',
+    created_at timestamptz NOT NULL DEFAULT current_timestamp,
+    created_by TEXT        NOT NULL DEFAULT ''
+);
+CREATE INDEX IF NOT EXISTS idx_role_bindings_project ON role_bindings (project);
+
+CREATE TABLE IF NOT EXISTS idempotency_records
+(
+    key          TEXT        NOT NULL PRIMARY KEY,
+    operation    TEXT        NOT NULL,
+    request_hash TEXT        NOT NULL,
+    status       TEXT        NOT NULL,
+    response     jsonb,
+    created_at   timestamptz NOT NULL DEFAULT current_timestamp,
+    expires_at   timestamptz NOT NULL
+);
+
+CREATE TABLE IF NOT EXISTS operations
+(
+    id            BIGSERIAL   NOT NULL PRIMARY KEY,
+    urn           TEXT        NOT NULL,
+    project       TEXT        NOT NULL,
+    action        TEXT        NOT NULL,
+    params        bytea,
+    user_id       TEXT        NOT NULL DEFAULT '',
+    status        TEXT        NOT NULL,
+    pending_steps TEXT[]      NOT NULL DEFAULT '{}',
+    error         TEXT        NOT NULL DEFAULT '',
+    started_at    timestamptz NOT NULL DEFAULT current_timestamp,
+    finished_at   timestamptz
+);
+CREATE INDEX IF NOT EXISTS idx_operations_urn_status ON operations (urn, status);
+CREATE INDEX IF NOT EXISTS idx_operations_project ON operations (project);
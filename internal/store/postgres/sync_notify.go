@@ -0,0 +1,193 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.uber.org/zap"
+
+	"github.com/goto/entropy/core/resource"
+)
+
+const (
+	channelSyncDue        = "entropy_sync_due"
+	channelResourceEvents = "entropy_resource_events"
+
+	listenerMinReconnect = 100 * time.Millisecond
+	listenerMaxReconnect = 10 * time.Second
+)
+
+// notifyListener fans out the notifications on a channel received over a
+// single connection to all the subscribers.
+type notifyListener struct {
+	conStr  string
+	channel string
+
+	mu       sync.Mutex
+	listener *pq.Listener
+	subs     map[chan struct{}]struct{}
+}
+
+func newNotifyListener(conStr, channel string) *notifyListener {
+	return &notifyListener{
+		conStr:  conStr,
+		channel: channel,
+		subs:    map[chan struct{}]struct{}{},
+	}
+}
+
+func (st *Store) SyncNotifications(ctx context.Context) (<-chan struct{}, error) {
+	return st.syncListener.subscribe(ctx)
+}
+
+func (st *Store) NextSyncAt(ctx context.Context, scope map[string][]string) (*time.Time, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "NextSyncAt"),
+			attribute.String(string(semconv.DBSQLTableKey), tableResources),
+		}...,
+	)
+
+	builder := sq.Select("min(state_next_sync)").
+		From(tableResources).
+		Where(sq.NotEq{"state_next_sync": nil})
+	for key, value := range scope {
+		builder = builder.Where(sq.Eq{key: value})
+	}
+
+	query, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var nextSync sql.NullTime
+	if err := st.db.QueryRowxContext(ctx, query, args...).Scan(&nextSync); err != nil {
+		return nil, err
+	} else if !nextSync.Valid {
+		return nil, nil
+	}
+	return &nextSync.Time, nil
+}
+
+// subscribe returns a channel that receives a value on every notification.
+// The connection is established on the first subscription.
+func (sl *notifyListener) subscribe(ctx context.Context) (<-chan struct{}, error) {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if sl.listener == nil {
+		listener := pq.NewListener(sl.conStr, listenerMinReconnect, listenerMaxReconnect, logListenerEvent)
+		if err := listener.Listen(sl.channel); err != nil {
+			_ = listener.Close()
+			return nil, err
+		}
+		sl.listener = listener
+		go sl.run(listener.Notify)
+	}
+
+	ch := make(chan struct{}, 1)
+	sl.subs[ch] = struct{}{}
+
+	go func() {
+		<-ctx.Done()
+
+		sl.mu.Lock()
+		defer sl.mu.Unlock()
+		if _, subscribed := sl.subs[ch]; subscribed {
+			delete(sl.subs, ch)
+			close(ch)
+		}
+	}()
+
+	return ch, nil
+}
+
+func (sl *notifyListener) run(notifications <-chan *pq.Notification) {
+	// a nil notification is sent after the connection is re-established,
+	// notifications may have been missed in between. So every value is
+	// treated as a wake-up.
+	for range notifications {
+		sl.broadcast()
+	}
+
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+	for ch := range sl.subs {
+		delete(sl.subs, ch)
+		close(ch)
+	}
+}
+
+func (sl *notifyListener) broadcast() {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	for ch := range sl.subs {
+		select {
+		case ch <- struct{}{}:
+		default:
+			// a wake-up is pending already.
+		}
+	}
+}
+
+func (sl *notifyListener) close() error {
+	sl.mu.Lock()
+	defer sl.mu.Unlock()
+
+	if sl.listener == nil {
+		return nil
+	}
+	return sl.listener.Close()
+}
+
+func logListenerEvent(event pq.ListenerEventType, err error) {
+	if err != nil {
+		zap.L().Warn("notify listener connection event", zap.Int("event", int(event)), zap.Error(err))
+	}
+}
+
+// notifySyncScheduled notifies the syncers on commit of tx if the resource
+// is due for sync already, or if its next sync moved earlier than
+// prevNextSync. The syncers sleeping until an earlier due time recompute
+// their wait on waking.
+func notifySyncScheduled(ctx context.Context, tx *sqlx.Tx, r resource.Resource, prevNextSync *time.Time) error {
+	nextSync := r.State.NextSyncAt
+	if nextSync == nil {
+		return nil
+	} else if nextSync.After(time.Now()) && prevNextSync != nil && !nextSync.Before(*prevNextSync) {
+		return nil
+	}
+
+	_, err := tx.ExecContext(ctx, "SELECT pg_notify($1, $2)", channelSyncDue, r.URN)
+	return err
+}
+
+// readNextSync returns the next sync time of the resource as stored.
+func readNextSync(ctx context.Context, tx *sqlx.Tx, id int64) (*time.Time, error) {
+	query, args, err := sq.Select("state_next_sync").
+		From(tableResources).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var nextSync sql.NullTime
+	if err := tx.QueryRowxContext(ctx, query, args...).Scan(&nextSync); err != nil {
+		return nil, err
+	} else if !nextSync.Valid {
+		return nil, nil
+	}
+	return &nextSync.Time, nil
+}
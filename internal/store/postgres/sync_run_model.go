@@ -0,0 +1,31 @@
+package postgres
+
+import (
+	"time"
+
+	"github.com/goto/entropy/core/resource"
+)
+
+type syncRunModel struct {
+	ID         int64     `db:"id"`
+	ResourceID int64     `db:"resource_id"`
+	Step       string    `db:"step"`
+	StartedAt  time.Time `db:"started_at"`
+	FinishedAt time.Time `db:"finished_at"`
+	Outcome    string    `db:"outcome"`
+	Error      string    `db:"error"`
+	Retries    int       `db:"retries"`
+}
+
+func (m syncRunModel) toSyncRun(urn string) resource.SyncRun {
+	return resource.SyncRun{
+		ID:         m.ID,
+		URN:        urn,
+		Step:       m.Step,
+		StartedAt:  m.StartedAt,
+		FinishedAt: m.FinishedAt,
+		Outcome:    m.Outcome,
+		Error:      m.Error,
+		Retries:    m.Retries,
+	}
+}
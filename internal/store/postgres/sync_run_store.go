@@ -0,0 +1,89 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+func (st *Store) AppendSyncRun(ctx context.Context, run resource.SyncRun) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "AppendSyncRun"),
+			attribute.String(string(semconv.DBSQLTableKey), tableSyncRuns),
+		}...,
+	)
+
+	return withinTx(ctx, st.db, false, func(ctx context.Context, tx *sqlx.Tx) error {
+		resourceID, err := translateURNToID(ctx, tx, run.URN)
+		if err != nil {
+			return translateErr(err)
+		}
+
+		_, err = sq.Insert(tableSyncRuns).
+			Columns("resource_id", "step", "started_at", "finished_at", "outcome", "error", "retries").
+			Values(resourceID, run.Step, run.StartedAt, run.FinishedAt, run.Outcome, run.Error, run.Retries).
+			PlaceholderFormat(sq.Dollar).
+			RunWith(tx).
+			ExecContext(ctx)
+		return err
+	})
+}
+
+func (st *Store) SyncHistory(ctx context.Context, urn string, limit int) ([]resource.SyncRun, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "SyncHistory"),
+			attribute.String(string(semconv.DBSQLTableKey), tableSyncRuns),
+		}...,
+	)
+
+	var runs []resource.SyncRun
+	txFn := func(ctx context.Context, tx *sqlx.Tx) error {
+		resourceID, err := translateURNToID(ctx, tx, urn)
+		if err != nil {
+			return err
+		}
+
+		builder := sq.Select("*").
+			From(tableSyncRuns).
+			Where(sq.Eq{"resource_id": resourceID}).
+			OrderBy("id DESC")
+		if limit > 0 {
+			builder = builder.Limit(uint64(limit))
+		}
+
+		q, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+		if err != nil {
+			return err
+		}
+
+		var models []syncRunModel
+		if err := tx.SelectContext(ctx, &models, q, args...); err != nil {
+			return err
+		}
+
+		for _, m := range models {
+			runs = append(runs, m.toSyncRun(urn))
+		}
+		return nil
+	}
+
+	if err := withinTx(ctx, st.db, true, txFn); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return runs, nil
+}
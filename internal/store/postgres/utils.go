@@ -3,6 +3,8 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"encoding/base64"
+	"strconv"
 
 	"github.com/jmoiron/sqlx"
 	"github.com/lib/pq"
@@ -51,7 +53,10 @@ func translateErr(err error) error {
 	return err
 }
 
-func runAllHooks(ctx context.Context, hooks []resource.MutationHook) error {
+// runAllHooks runs the hooks within the transaction. Stores called from the
+// hooks use the transaction found in the context, see txFromContext.
+func runAllHooks(ctx context.Context, tx *sqlx.Tx, hooks []resource.MutationHook) error {
+	ctx = context.WithValue(ctx, txKey{}, tx)
 	for _, hook := range hooks {
 		if err := hook(ctx); err != nil {
 			return err
@@ -59,3 +64,40 @@ func runAllHooks(ctx context.Context, hooks []resource.MutationHook) error {
 	}
 	return nil
 }
+
+type txKey struct{}
+
+// txFromContext returns the transaction of the mutation whose hooks are
+// running, if any.
+func txFromContext(ctx context.Context) (*sqlx.Tx, bool) {
+	tx, ok := ctx.Value(txKey{}).(*sqlx.Tx)
+	return tx, ok
+}
+
+// encodePageToken returns an opaque token for resuming a listing right
+// after the resource with the given id.
+func encodePageToken(lastID int64) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.FormatInt(lastID, 10)))
+}
+
+func decodePageToken(token string) (int64, error) {
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return 0, errors.ErrInvalid.WithMsgf("invalid page token")
+	}
+
+	lastID, err := strconv.ParseInt(string(b), 10, 64)
+	if err != nil || lastID < 0 {
+		return 0, errors.ErrInvalid.WithMsgf("invalid page token")
+	}
+	return lastID, nil
+}
+
+// textArray returns the values for a 'TEXT[] NOT NULL' column, which pq
+// would otherwise write as NULL when the slice is nil.
+func textArray(values []string) pq.StringArray {
+	if values == nil {
+		return pq.StringArray{}
+	}
+	return values
+}
@@ -0,0 +1,65 @@
+package postgres
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/lib/pq"
+
+	"github.com/goto/entropy/core/webhook"
+)
+
+type webhookModel struct {
+	ID        int64          `db:"id"`
+	Project   string         `db:"project"`
+	URL       string         `db:"url"`
+	Secret    string         `db:"secret"`
+	Kinds     pq.StringArray `db:"kinds"`
+	Statuses  pq.StringArray `db:"statuses"`
+	Actions   pq.StringArray `db:"actions"`
+	CreatedAt time.Time      `db:"created_at"`
+	CreatedBy string         `db:"created_by"`
+}
+
+func (m webhookModel) toSubscription() webhook.Subscription {
+	return webhook.Subscription{
+		ID:        m.ID,
+		Project:   m.Project,
+		URL:       m.URL,
+		Secret:    m.Secret,
+		Kinds:     m.Kinds,
+		Statuses:  m.Statuses,
+		Actions:   m.Actions,
+		CreatedAt: m.CreatedAt,
+		CreatedBy: m.CreatedBy,
+	}
+}
+
+// claimedDeliveryModel is a claimed delivery joined with its subscription.
+type claimedDeliveryModel struct {
+	webhookModel
+
+	DeliveryID    int64     `db:"delivery_id"`
+	Event         []byte    `db:"event"`
+	Status        string    `db:"status"`
+	Attempts      int       `db:"attempts"`
+	NextAttemptAt time.Time `db:"next_attempt_at"`
+	LastError     string    `db:"last_error"`
+}
+
+func (m claimedDeliveryModel) toDelivery() (webhook.Delivery, error) {
+	var ev webhook.Event
+	if err := json.Unmarshal(m.Event, &ev); err != nil {
+		return webhook.Delivery{}, err
+	}
+
+	return webhook.Delivery{
+		ID:            m.DeliveryID,
+		Subscription:  m.webhookModel.toSubscription(),
+		Event:         ev,
+		Status:        m.Status,
+		Attempts:      m.Attempts,
+		NextAttemptAt: m.NextAttemptAt,
+		LastError:     m.LastError,
+	}, nil
+}
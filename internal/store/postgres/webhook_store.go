@@ -0,0 +1,256 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"go.nhat.io/otelsql"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+
+	"github.com/goto/entropy/core/webhook"
+	"github.com/goto/entropy/pkg/errors"
+)
+
+// claimDeliveriesQuery leases the due deliveries by pushing their next
+// attempt time. Rows locked by other dispatchers are skipped.
+const claimDeliveriesQuery = `WITH claimed AS (
+	UPDATE webhook_deliveries SET next_attempt_at = $2
+	WHERE id IN (
+		SELECT id FROM webhook_deliveries
+		WHERE status = $3 AND next_attempt_at <= $1
+		ORDER BY next_attempt_at
+		LIMIT NULLIF($4, 0)
+		FOR UPDATE SKIP LOCKED
+	)
+	RETURNING id, webhook_id, event, status, attempts, next_attempt_at, last_error
+)
+SELECT c.id AS delivery_id, c.event, c.status, c.attempts, c.next_attempt_at, c.last_error,
+	w.id, w.project, w.url, w.secret, w.kinds, w.statuses, w.actions, w.created_at, w.created_by
+FROM claimed c
+JOIN webhooks w ON w.id = c.webhook_id
+ORDER BY c.next_attempt_at, c.id
+`
+
+func (st *Store) CreateWebhook(ctx context.Context, sub webhook.Subscription) (*webhook.Subscription, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "CreateWebhook"),
+			attribute.String(string(semconv.DBSQLTableKey), tableWebhooks),
+		}...,
+	)
+
+	if sub.CreatedAt.IsZero() {
+		sub.CreatedAt = time.Now()
+	}
+
+	err := sq.Insert(tableWebhooks).
+		Columns("project", "url", "secret", "kinds", "statuses", "actions", "created_at", "created_by").
+		Values(sub.Project, sub.URL, sub.Secret, textArray(sub.Kinds), textArray(sub.Statuses),
+			textArray(sub.Actions), sub.CreatedAt, sub.CreatedBy).
+		Suffix(`RETURNING "id"`).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		QueryRowContext(ctx).
+		Scan(&sub.ID)
+	if err != nil {
+		return nil, err
+	}
+	return &sub, nil
+}
+
+func (st *Store) GetWebhook(ctx context.Context, id int64) (*webhook.Subscription, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "GetWebhook"),
+			attribute.String(string(semconv.DBSQLTableKey), tableWebhooks),
+		}...,
+	)
+
+	q, args, err := sq.Select("*").
+		From(tableWebhooks).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	var m webhookModel
+	if err := st.db.GetContext(ctx, &m, q, args...); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, errors.ErrNotFound.WithCausef("webhook with id '%d' not found", id)
+		}
+		return nil, err
+	}
+
+	sub := m.toSubscription()
+	return &sub, nil
+}
+
+func (st *Store) ListWebhooks(ctx context.Context, project string) ([]webhook.Subscription, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ListWebhooks"),
+			attribute.String(string(semconv.DBSQLTableKey), tableWebhooks),
+		}...,
+	)
+
+	builder := sq.Select("*").From(tableWebhooks).OrderBy("id")
+	if project != "" {
+		builder = builder.Where(sq.Eq{"project": project})
+	}
+
+	q, args, err := builder.PlaceholderFormat(sq.Dollar).ToSql()
+	if err != nil {
+		return nil, err
+	}
+
+	// read within the mutation when called from its hooks.
+	var db sqlx.QueryerContext = st.db
+	if tx, ok := txFromContext(ctx); ok {
+		db = tx
+	}
+
+	var models []webhookModel
+	if err := sqlx.SelectContext(ctx, db, &models, q, args...); err != nil {
+		return nil, err
+	}
+
+	subs := make([]webhook.Subscription, 0, len(models))
+	for _, m := range models {
+		subs = append(subs, m.toSubscription())
+	}
+	return subs, nil
+}
+
+func (st *Store) DeleteWebhook(ctx context.Context, id int64) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "DeleteWebhook"),
+			attribute.String(string(semconv.DBSQLTableKey), tableWebhooks),
+		}...,
+	)
+
+	// pending deliveries are deleted along by the foreign key.
+	result, err := sq.Delete(tableWebhooks).
+		Where(sq.Eq{"id": id}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("webhook with id '%d' not found", id)
+	}
+	return nil
+}
+
+func (st *Store) EnqueueDeliveries(ctx context.Context, deliveries []webhook.Delivery) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "EnqueueDeliveries"),
+			attribute.String(string(semconv.DBSQLTableKey), tableWebhookDeliveries),
+		}...,
+	)
+
+	insertDeliveries := func(ctx context.Context, tx *sqlx.Tx) error {
+		builder := sq.Insert(tableWebhookDeliveries).
+			Columns("webhook_id", "event", "status", "attempts", "next_attempt_at")
+
+		for _, d := range deliveries {
+			eventJSON, err := json.Marshal(d.Event)
+			if err != nil {
+				return err
+			}
+
+			status := d.Status
+			if status == "" {
+				status = webhook.DeliveryPending
+			}
+			builder = builder.Values(d.Subscription.ID, eventJSON, status, d.Attempts, d.NextAttemptAt)
+		}
+
+		_, err := builder.PlaceholderFormat(sq.Dollar).RunWith(tx).ExecContext(ctx)
+		return err
+	}
+
+	// queue within the mutation when called from its hooks, so that the
+	// deliveries are committed along with the change.
+	if tx, ok := txFromContext(ctx); ok {
+		return insertDeliveries(ctx, tx)
+	}
+	return withinTx(ctx, st.db, false, insertDeliveries)
+}
+
+func (st *Store) ClaimDeliveries(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]webhook.Delivery, error) {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "ClaimDeliveries"),
+			attribute.String(string(semconv.DBSQLTableKey), tableWebhookDeliveries),
+		}...,
+	)
+
+	var models []claimedDeliveryModel
+	err := st.db.SelectContext(ctx, &models, claimDeliveriesQuery, now, now.Add(lease), webhook.DeliveryPending, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := make([]webhook.Delivery, 0, len(models))
+	for _, m := range models {
+		d, err := m.toDelivery()
+		if err != nil {
+			return nil, err
+		}
+		deliveries = append(deliveries, d)
+	}
+	return deliveries, nil
+}
+
+func (st *Store) UpdateDelivery(ctx context.Context, d webhook.Delivery) error {
+	ctx = otelsql.WithCustomAttributes(
+		ctx,
+		[]attribute.KeyValue{
+			attribute.String("db.repository.method", "UpdateDelivery"),
+			attribute.String(string(semconv.DBSQLTableKey), tableWebhookDeliveries),
+		}...,
+	)
+
+	result, err := sq.Update(tableWebhookDeliveries).
+		Where(sq.Eq{"id": d.ID}).
+		SetMap(map[string]interface{}{
+			"status":          d.Status,
+			"attempts":        d.Attempts,
+			"next_attempt_at": d.NextAttemptAt,
+			"last_error":      d.LastError,
+			"updated_at":      sq.Expr("current_timestamp"),
+		}).
+		PlaceholderFormat(sq.Dollar).
+		RunWith(st.db).
+		ExecContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if affected, err := result.RowsAffected(); err != nil {
+		return err
+	} else if affected == 0 {
+		return errors.ErrNotFound.WithCausef("webhook delivery with id '%d' not found", d.ID)
+	}
+	return nil
+}
@@ -0,0 +1,46 @@
+package dagger
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/modules"
+	"github.com/goto/entropy/modules/flink"
+	"github.com/goto/entropy/pkg/errors"
+	"github.com/goto/entropy/pkg/helm"
+)
+
+func (dd *daggerDriver) DescribeEffects(_ context.Context, cur module.ExpandedResource, planned resource.Resource) ([]module.Effect, error) {
+	var flinkOut flink.Output
+	if err := json.Unmarshal(cur.Dependencies[keyFlinkDependency].Output, &flinkOut); err != nil {
+		return nil, errors.ErrInternal.WithMsgf("invalid flink state").WithCausef("%s", err.Error())
+	}
+
+	var curRelease *helm.ReleaseConfig
+	if len(cur.Spec.Configs) > 0 {
+		curConf, err := readConfig(cur, cur.Spec.Configs, dd.conf)
+		if err != nil {
+			return nil, err
+		}
+
+		curRelease, err = dd.getHelmRelease(cur.Resource, *curConf, flinkOut.KubeCluster)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plannedExr := module.ExpandedResource{Resource: planned, Dependencies: cur.Dependencies}
+	plannedConf, err := readConfig(plannedExr, planned.Spec.Configs, dd.conf)
+	if err != nil {
+		return nil, err
+	}
+
+	plannedRelease, err := dd.getHelmRelease(planned, *plannedConf, flinkOut.KubeCluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return modules.HelmReleaseEffects(curRelease, plannedRelease)
+}
@@ -0,0 +1,34 @@
+package modules
+
+import (
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/pkg/helm"
+)
+
+const effectHelmRelease = "helm_release"
+
+// HelmReleaseEffects describes the changes to the values of a helm release.
+// cur is nil if the release does not exist yet. Returns nil if the values
+// remain the same.
+func HelmReleaseEffects(cur, planned *helm.ReleaseConfig) ([]module.Effect, error) {
+	var curValues []byte
+	if cur != nil {
+		curValues = MustJSON(cur.Values)
+	}
+
+	changes, err := resource.DiffJSON(curValues, MustJSON(planned.Values))
+	if err != nil {
+		return nil, err
+	} else if len(changes) == 0 {
+		return nil, nil
+	}
+
+	return []module.Effect{
+		{
+			Kind:    effectHelmRelease,
+			Target:  planned.Namespace + "/" + planned.Name,
+			Changes: changes,
+		},
+	}, nil
+}
@@ -33,10 +33,11 @@ const (
 )
 
 const (
-	stepReleaseCreate = "release_create"
-	stepReleaseUpdate = "release_update"
-	stepReleaseStop   = "release_stop"
-	stepKafkaReset    = "consumer_reset"
+	stepReleaseCreate         = "release_create"
+	stepReleaseUpdate         = "release_update"
+	stepReleaseStop           = "release_stop"
+	stepKafkaReset            = "consumer_reset"
+	stepPreviousReleaseDelete = "previous_release_delete"
 )
 
 const (
@@ -87,6 +88,7 @@ type firehoseDriver struct {
 	timeNow           func() time.Time
 	conf              driverConf
 	kubeDeploy        kubeDeployFn
+	kubeDelete        kubeDeleteFn
 	kubeGetPod        kubeGetPodFn
 	kubeGetDeployment kubeGetDeploymentFn
 	consumerReset     consumerResetFn
@@ -94,6 +96,7 @@ type firehoseDriver struct {
 
 type (
 	kubeDeployFn        func(ctx context.Context, isCreate bool, conf kube.Config, hc helm.ReleaseConfig) error
+	kubeDeleteFn        func(ctx context.Context, conf kube.Config, ns, name string) error
 	kubeGetPodFn        func(ctx context.Context, conf kube.Config, ns string, labels map[string]string) ([]kube.Pod, error)
 	kubeGetDeploymentFn func(ctx context.Context, conf kube.Config, ns string, name string) (kube.Deployment, error)
 	consumerResetFn     func(ctx context.Context, conf Config, out kubernetes.Output, resetTo string, offsetResetDelaySeconds int) error
@@ -188,6 +191,17 @@ type Output struct {
 type transientData struct {
 	PendingSteps  []string `json:"pending_steps"`
 	ResetOffsetTo string   `json:"reset_offset_to,omitempty"`
+
+	// PreviousRelease is the release left behind when the firehose moves
+	// to another namespace or kube cluster.
+	PreviousRelease *previousRelease `json:"previous_release,omitempty"`
+}
+
+type previousRelease struct {
+	Namespace string `json:"namespace"`
+
+	// KubeCluster is the urn of the kube cluster the release is on.
+	KubeCluster string `json:"kube_cluster,omitempty"`
 }
 
 func (fd *firehoseDriver) getHelmRelease(res resource.Resource, conf Config,
@@ -0,0 +1,45 @@
+package firehose
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/modules"
+	"github.com/goto/entropy/modules/kubernetes"
+	"github.com/goto/entropy/pkg/errors"
+	"github.com/goto/entropy/pkg/helm"
+)
+
+func (fd *firehoseDriver) DescribeEffects(_ context.Context, cur module.ExpandedResource, planned resource.Resource) ([]module.Effect, error) {
+	var kubeOut kubernetes.Output
+	if err := json.Unmarshal(cur.Dependencies[keyKubeDependency].Output, &kubeOut); err != nil {
+		return nil, errors.ErrInternal.WithMsgf("invalid kube state").WithCausef("%s", err.Error())
+	}
+
+	var curRelease *helm.ReleaseConfig
+	if len(cur.Spec.Configs) > 0 {
+		curConf, err := readConfig(cur.Resource, cur.Spec.Configs, fd.conf)
+		if err != nil {
+			return nil, err
+		}
+
+		curRelease, err = fd.getHelmRelease(cur.Resource, *curConf, kubeOut)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	plannedConf, err := readConfig(planned, planned.Spec.Configs, fd.conf)
+	if err != nil {
+		return nil, err
+	}
+
+	plannedRelease, err := fd.getHelmRelease(planned, *plannedConf, kubeOut)
+	if err != nil {
+		return nil, err
+	}
+
+	return modules.HelmReleaseEffects(curRelease, plannedRelease)
+}
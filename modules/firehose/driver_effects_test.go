@@ -0,0 +1,94 @@
+package firehose
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/goto/entropy/core/module"
+	"github.com/goto/entropy/core/resource"
+	"github.com/goto/entropy/modules"
+	"github.com/goto/entropy/modules/kubernetes"
+)
+
+func TestFirehoseDriver_DescribeEffects(t *testing.T) {
+	t.Parallel()
+
+	configs := func(replicas int) []byte {
+		return modules.MustJSON(map[string]any{
+			"namespace":     "firehose",
+			"replicas":      replicas,
+			"deployment_id": "firehose-deployment-x",
+			"chart_values": map[string]string{
+				"image_repository":  "gotocompany/firehose",
+				"chart_version":     "1.0.0",
+				"image_pull_policy": "",
+				"image_tag":         "1.0.0",
+			},
+			"env_variables": map[string]string{
+				"SINK_TYPE":                "LOG",
+				"INPUT_SCHEMA_PROTO_CLASS": "com.foo.Bar",
+				"SOURCE_KAFKA_BROKERS":     "localhost:9092",
+				"SOURCE_KAFKA_TOPIC":       "foo-log",
+			},
+		})
+	}
+
+	cur := module.ExpandedResource{
+		Resource: resource.Resource{
+			URN:     "urn:goto:entropy:foo:fh1",
+			Kind:    "firehose",
+			Name:    "fh1",
+			Project: "foo",
+			Spec:    resource.Spec{Configs: configs(1)},
+		},
+		Dependencies: map[string]module.ResolvedDependency{
+			"kube_cluster": {
+				Kind:   "kubernetes",
+				Output: modules.MustJSON(kubernetes.Output{}),
+			},
+		},
+	}
+
+	fd := &firehoseDriver{
+		conf:    defaultDriverConf,
+		timeNow: func() time.Time { return frozenTime },
+	}
+
+	t.Run("Update", func(t *testing.T) {
+		t.Parallel()
+		planned := cur.Resource
+		planned.Spec.Configs = configs(3)
+
+		effects, err := fd.DescribeEffects(context.Background(), cur, planned)
+		require.NoError(t, err)
+		require.Len(t, effects, 1)
+		assert.Equal(t, "helm_release", effects[0].Kind)
+		assert.Equal(t, "firehose/firehose-deployment-x", effects[0].Target)
+		assert.Equal(t, []resource.Change{
+			{Op: resource.ChangeChanged, Path: "replicaCount", From: float64(1), To: float64(3)},
+		}, effects[0].Changes)
+	})
+
+	t.Run("NoChange", func(t *testing.T) {
+		t.Parallel()
+		effects, err := fd.DescribeEffects(context.Background(), cur, cur.Resource)
+		require.NoError(t, err)
+		assert.Empty(t, effects)
+	})
+
+	t.Run("Create", func(t *testing.T) {
+		t.Parallel()
+		created := cur
+		created.Spec.Configs = nil
+
+		effects, err := fd.DescribeEffects(context.Background(), created, cur.Resource)
+		require.NoError(t, err)
+		require.Len(t, effects, 1)
+		assert.Len(t, effects[0].Changes, 1)
+		assert.Equal(t, resource.ChangeAdded, effects[0].Changes[0].Op)
+	})
+}
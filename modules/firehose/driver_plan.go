@@ -19,7 +19,10 @@ const SourceKafkaConsumerAutoOffsetReset = "SOURCE_KAFKA_CONSUMER_CONFIG_AUTO_OF
 
 var suffixRegex = regexp.MustCompile(`^([A-Za-z0-9-]+)-([0-9]+)$`)
 
-var errCauseInvalidNamespaceUpdate = "cannot update kube namespace of a running firehose"
+var (
+	errCauseInvalidNamespaceUpdate = "cannot update kube namespace of a running firehose"
+	errCauseInvalidClusterUpdate   = "cannot move a running firehose to another kube cluster"
+)
 
 func (fd *firehoseDriver) Plan(_ context.Context, exr module.ExpandedResource, act module.ActionRequest) (*resource.Resource, error) {
 	switch act.Name {
@@ -43,6 +46,14 @@ func (fd *firehoseDriver) planChange(exr module.ExpandedResource, act module.Act
 		return nil, err
 	}
 
+	modData, err := readTransientData(exr)
+	if err != nil {
+		return nil, err
+	}
+
+	// a release left behind by an earlier move may not be deleted yet.
+	prevRelease := modData.PreviousRelease
+
 	switch act.Name {
 	case module.UpdateAction:
 		newConf, err := readConfig(exr.Resource, act.Params, fd.conf)
@@ -50,6 +61,12 @@ func (fd *firehoseDriver) planChange(exr module.ExpandedResource, act module.Act
 			return nil, err
 		}
 
+		prevKube, changed := act.PreviousDependencies[keyKubeDependency]
+		kubeChanged := changed && prevKube != exr.Spec.Dependencies[keyKubeDependency]
+		if kubeChanged && !curConf.Stopped {
+			return nil, errors.ErrInvalid.WithCausef("%s", errCauseInvalidClusterUpdate)
+		}
+
 		chartVals, err := mergeChartValues(curConf.ChartValues, newConf.ChartValues)
 		if err != nil {
 			return nil, err
@@ -84,6 +101,24 @@ func (fd *firehoseDriver) planChange(exr module.ExpandedResource, act module.Act
 			}
 		}
 
+		current := previousRelease{
+			Namespace:   curConf.Namespace,
+			KubeCluster: exr.Spec.Dependencies[keyKubeDependency],
+		}
+		if kubeChanged {
+			current.KubeCluster = prevKube
+		}
+		target := previousRelease{
+			Namespace:   newConf.Namespace,
+			KubeCluster: exr.Spec.Dependencies[keyKubeDependency],
+		}
+
+		// moving back to where a release is left behind leaves the release
+		// at the current location to clean up instead.
+		if current != target && (prevRelease == nil || *prevRelease == target) {
+			prevRelease = &current
+		}
+
 		curConf = newConf
 
 	case ScaleAction:
@@ -121,6 +156,10 @@ func (fd *firehoseDriver) planChange(exr module.ExpandedResource, act module.Act
 		// upgrade the chart values to the latest project-level config.
 		// Note: upgrade/downgrade will happen based on module-level configs.
 		curConf.ChartValues = &fd.conf.ChartValues
+
+	case DependencyChangedAction:
+		// nothing to change in the configs. the release update step picks
+		// up the new kube cluster output during sync.
 	}
 
 	immediately := fd.timeNow()
@@ -132,11 +171,27 @@ func (fd *firehoseDriver) planChange(exr module.ExpandedResource, act module.Act
 		return nil, err
 	}
 
+	// the release being deployed is never the one to delete.
+	target := previousRelease{
+		Namespace:   curConf.Namespace,
+		KubeCluster: exr.Spec.Dependencies[keyKubeDependency],
+	}
+	if prevRelease != nil && *prevRelease == target {
+		prevRelease = nil
+	}
+
+	// the previous release is deleted once the new one is in place.
+	pendingSteps := []string{stepReleaseUpdate}
+	if prevRelease != nil {
+		pendingSteps = append(pendingSteps, stepPreviousReleaseDelete)
+	}
+
 	exr.Resource.State = resource.State{
 		Status: resource.StatusPending,
 		Output: exr.Resource.State.Output,
 		ModuleData: modules.MustJSON(transientData{
-			PendingSteps: []string{stepReleaseUpdate},
+			PendingSteps:    pendingSteps,
+			PreviousRelease: prevRelease,
 		}),
 		NextSyncAt: &immediately,
 	}
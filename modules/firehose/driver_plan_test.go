@@ -257,6 +257,103 @@ func TestFirehoseDriver_Plan(t *testing.T) {
 			wantErr: nil,
 		},
 
+		// dependency changed action tests
+		{
+			title: "DependencyChanged_Valid",
+			exr: module.ExpandedResource{
+				Resource: resource.Resource{
+					URN:     "urn:goto:entropy:foo:fh1",
+					Kind:    "firehose",
+					Name:    "fh1",
+					Project: "foo",
+					Spec: resource.Spec{
+						Configs: modules.MustJSON(map[string]any{
+							"stopped":       false,
+							"replicas":      1,
+							"deployment_id": "firehose-deployment-x",
+							"chart_values": map[string]string{
+								"image_repository":  "gotocompany/firehose",
+								"chart_version":     "0.1.0",
+								"image_pull_policy": "IfNotPresent",
+								"image_tag":         "latest",
+							},
+							"env_variables": map[string]string{
+								"SINK_TYPE":                      "LOG",
+								"INPUT_SCHEMA_PROTO_CLASS":       "com.foo.Bar",
+								"SOURCE_KAFKA_CONSUMER_GROUP_ID": "foo-bar-baz",
+								"SOURCE_KAFKA_BROKERS":           "localhost:9092",
+								"SOURCE_KAFKA_TOPIC":             "foo-log",
+							},
+						}),
+					},
+					State: resource.State{
+						Status: resource.StatusCompleted,
+						Output: modules.MustJSON(Output{
+							Namespace:   "firehose",
+							ReleaseName: "bar",
+						}),
+					},
+				},
+				Dependencies: map[string]module.ResolvedDependency{
+					"kube_cluster": {
+						Kind:   "kubernetes",
+						Output: modules.MustJSON(kubernetes.Output{}),
+					},
+				},
+			},
+			act: module.ActionRequest{
+				Name: DependencyChangedAction,
+			},
+			want: &resource.Resource{
+				URN:     "urn:goto:entropy:foo:fh1",
+				Kind:    "firehose",
+				Name:    "fh1",
+				Project: "foo",
+				Spec: resource.Spec{
+					Configs: modules.MustJSON(map[string]any{
+						"namespace":     "firehose",
+						"stopped":       false,
+						"replicas":      1,
+						"deployment_id": "firehose-deployment-x",
+						"chart_values": map[string]string{
+							"chart_version":     "0.1.0",
+							"image_repository":  "gotocompany/firehose",
+							"image_pull_policy": "IfNotPresent",
+							"image_tag":         "latest",
+						},
+						"limits": map[string]any{
+							"cpu":    "200m",
+							"memory": "512Mi",
+						},
+						"requests": map[string]any{
+							"cpu":    "200m",
+							"memory": "512Mi",
+						},
+						"env_variables": map[string]string{
+							"SINK_TYPE":                      "LOG",
+							"INPUT_SCHEMA_PROTO_CLASS":       "com.foo.Bar",
+							"SOURCE_KAFKA_CONSUMER_GROUP_ID": "foo-bar-baz",
+							"SOURCE_KAFKA_BROKERS":           "localhost:9092",
+							"SOURCE_KAFKA_TOPIC":             "foo-log",
+						},
+						"init_container": map[string]interface{}{"args": interface{}(nil), "command": interface{}(nil), "enabled": false, "image_tag": "", "pull_policy": "", "repository": ""},
+					}),
+				},
+				State: resource.State{
+					Status: resource.StatusPending,
+					Output: modules.MustJSON(Output{
+						Namespace:   "firehose",
+						ReleaseName: "bar",
+					}),
+					ModuleData: modules.MustJSON(transientData{
+						PendingSteps: []string{stepReleaseUpdate},
+					}),
+					NextSyncAt: &frozenTime,
+				},
+			},
+			wantErr: nil,
+		},
+
 		// scale action tests
 		{
 			title: "Scale_Invalid_params",
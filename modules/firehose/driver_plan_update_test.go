@@ -513,6 +513,310 @@ func TestFirehoseDriver_Plan_Update(t *testing.T) {
 			want:    nil,
 			wantErr: errors.ErrInvalid.WithCausef("%s", errCauseInvalidNamespaceUpdate),
 		},
+		{
+			title: "Update_Running_Firehose_Cluster",
+			exr: module.ExpandedResource{
+				Resource: resource.Resource{
+					URN:     "urn:goto:entropy:foo:fh1",
+					Kind:    "firehose",
+					Name:    "fh1",
+					Project: "foo",
+					Spec: resource.Spec{
+						Configs: modules.MustJSON(map[string]any{
+							"namespace":     "firehose",
+							"replicas":      1,
+							"stopped":       false,
+							"deployment_id": "firehose-deployment-x",
+							"env_variables": map[string]string{
+								"SINK_TYPE":                "LOG",
+								"INPUT_SCHEMA_PROTO_CLASS": "com.foo.Bar",
+								"SOURCE_KAFKA_BROKERS":     "localhost:9092",
+								"SOURCE_KAFKA_TOPIC":       "foo-log",
+							},
+						}),
+						Dependencies: map[string]string{
+							"kube_cluster": "orn:entropy:kubernetes:foo:cluster-b",
+						},
+					},
+					State: resource.State{
+						Status: resource.StatusCompleted,
+						Output: modules.MustJSON(Output{
+							Namespace:   "firehose",
+							ReleaseName: "bar",
+						}),
+					},
+				},
+				Dependencies: map[string]module.ResolvedDependency{
+					"kube_cluster": {
+						Kind:   "kubernetes",
+						Output: modules.MustJSON(kubernetes.Output{}),
+					},
+				},
+			},
+			act: module.ActionRequest{
+				Name: module.UpdateAction,
+				Params: modules.MustJSON(map[string]any{
+					"replicas": 1,
+					"stopped":  false,
+					"env_variables": map[string]string{
+						"SINK_TYPE":                "LOG",
+						"INPUT_SCHEMA_PROTO_CLASS": "com.foo.Bar",
+						"SOURCE_KAFKA_BROKERS":     "localhost:9092",
+						"SOURCE_KAFKA_TOPIC":       "foo-log",
+					},
+				}),
+				PreviousDependencies: map[string]string{
+					"kube_cluster": "orn:entropy:kubernetes:foo:cluster-a",
+				},
+			},
+			want:    nil,
+			wantErr: errors.ErrInvalid.WithCausef("%s", errCauseInvalidClusterUpdate),
+		},
+		{
+			title: "Update_Stopped_Firehose_Cluster",
+			exr: module.ExpandedResource{
+				Resource: resource.Resource{
+					URN:     "urn:goto:entropy:foo:fh1",
+					Kind:    "firehose",
+					Name:    "fh1",
+					Project: "foo",
+					Spec: resource.Spec{
+						Configs: modules.MustJSON(map[string]any{
+							"namespace":     "firehose",
+							"replicas":      1,
+							"stopped":       true,
+							"deployment_id": "firehose-deployment-x",
+							"chart_values": map[string]string{
+								"image_repository":  "gotocompany/firehose",
+								"chart_version":     "1.0.0",
+								"image_pull_policy": "",
+								"image_tag":         "1.0.0",
+							},
+							"env_variables": map[string]string{
+								"SINK_TYPE":                "LOG",
+								"INPUT_SCHEMA_PROTO_CLASS": "com.foo.Bar",
+								"SOURCE_KAFKA_BROKERS":     "localhost:9092",
+								"SOURCE_KAFKA_TOPIC":       "foo-log",
+							},
+						}),
+						Dependencies: map[string]string{
+							"kube_cluster": "orn:entropy:kubernetes:foo:cluster-b",
+						},
+					},
+					State: resource.State{
+						Status: resource.StatusCompleted,
+						Output: modules.MustJSON(Output{
+							Namespace:   "firehose",
+							ReleaseName: "bar",
+						}),
+					},
+				},
+				Dependencies: map[string]module.ResolvedDependency{
+					"kube_cluster": {
+						Kind:   "kubernetes",
+						Output: modules.MustJSON(kubernetes.Output{}),
+					},
+				},
+			},
+			act: module.ActionRequest{
+				Name: module.UpdateAction,
+				Params: modules.MustJSON(map[string]any{
+					"replicas": 1,
+					"stopped":  true,
+					"env_variables": map[string]string{
+						"SINK_TYPE":                "LOG",
+						"INPUT_SCHEMA_PROTO_CLASS": "com.foo.Bar",
+						"SOURCE_KAFKA_BROKERS":     "localhost:9092",
+						"SOURCE_KAFKA_TOPIC":       "foo-log",
+					},
+				}),
+				PreviousDependencies: map[string]string{
+					"kube_cluster": "orn:entropy:kubernetes:foo:cluster-a",
+				},
+			},
+			want: &resource.Resource{
+				URN:     "urn:goto:entropy:foo:fh1",
+				Kind:    "firehose",
+				Name:    "fh1",
+				Project: "foo",
+				Spec: resource.Spec{
+					Configs: modules.MustJSON(map[string]any{
+						"namespace":     "firehose",
+						"stopped":       true,
+						"replicas":      1,
+						"deployment_id": "firehose-deployment-x",
+						"chart_values": map[string]string{
+							"image_repository":  "gotocompany/firehose",
+							"chart_version":     "1.0.0",
+							"image_pull_policy": "",
+							"image_tag":         "1.0.0",
+						},
+						"env_variables": map[string]string{
+							"SINK_TYPE":                      "LOG",
+							"INPUT_SCHEMA_PROTO_CLASS":       "com.foo.Bar",
+							"SOURCE_KAFKA_BROKERS":           "localhost:9092",
+							"SOURCE_KAFKA_CONSUMER_GROUP_ID": "foo-fh1-firehose-1",
+							"SOURCE_KAFKA_TOPIC":             "foo-log",
+						},
+						"limits": map[string]any{
+							"cpu":    "200m",
+							"memory": "512Mi",
+						},
+						"requests": map[string]any{
+							"cpu":    "200m",
+							"memory": "512Mi",
+						},
+						"init_container": map[string]interface{}{"args": interface{}(nil), "command": interface{}(nil), "enabled": false, "image_tag": "", "pull_policy": "", "repository": ""},
+					}),
+					Dependencies: map[string]string{
+						"kube_cluster": "orn:entropy:kubernetes:foo:cluster-b",
+					},
+				},
+				State: resource.State{
+					Status: resource.StatusPending,
+					Output: modules.MustJSON(Output{
+						Namespace:   "firehose",
+						ReleaseName: "bar",
+					}),
+					ModuleData: modules.MustJSON(transientData{
+						PendingSteps: []string{stepReleaseUpdate, stepPreviousReleaseDelete},
+						PreviousRelease: &previousRelease{
+							Namespace:   "firehose",
+							KubeCluster: "orn:entropy:kubernetes:foo:cluster-a",
+						},
+					}),
+					NextSyncAt: &frozenTime,
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			title: "Update_Stopped_Firehose_Cluster_Reverted",
+			exr: module.ExpandedResource{
+				Resource: resource.Resource{
+					URN:     "urn:goto:entropy:foo:fh1",
+					Kind:    "firehose",
+					Name:    "fh1",
+					Project: "foo",
+					Spec: resource.Spec{
+						Configs: modules.MustJSON(map[string]any{
+							"namespace":     "firehose",
+							"replicas":      1,
+							"stopped":       true,
+							"deployment_id": "firehose-deployment-x",
+							"chart_values": map[string]string{
+								"image_repository":  "gotocompany/firehose",
+								"chart_version":     "1.0.0",
+								"image_pull_policy": "",
+								"image_tag":         "1.0.0",
+							},
+							"env_variables": map[string]string{
+								"SINK_TYPE":                "LOG",
+								"INPUT_SCHEMA_PROTO_CLASS": "com.foo.Bar",
+								"SOURCE_KAFKA_BROKERS":     "localhost:9092",
+								"SOURCE_KAFKA_TOPIC":       "foo-log",
+							},
+						}),
+						Dependencies: map[string]string{
+							"kube_cluster": "orn:entropy:kubernetes:foo:cluster-a",
+						},
+					},
+					// the move from cluster-a to cluster-b failed.
+					State: resource.State{
+						Status: resource.StatusError,
+						Output: modules.MustJSON(Output{
+							Namespace:   "firehose",
+							ReleaseName: "bar",
+						}),
+						ModuleData: modules.MustJSON(transientData{
+							PendingSteps: []string{stepPreviousReleaseDelete},
+							PreviousRelease: &previousRelease{
+								Namespace:   "firehose",
+								KubeCluster: "orn:entropy:kubernetes:foo:cluster-a",
+							},
+						}),
+					},
+				},
+				Dependencies: map[string]module.ResolvedDependency{
+					"kube_cluster": {
+						Kind:   "kubernetes",
+						Output: modules.MustJSON(kubernetes.Output{}),
+					},
+				},
+			},
+			act: module.ActionRequest{
+				Name: module.UpdateAction,
+				Params: modules.MustJSON(map[string]any{
+					"replicas": 1,
+					"stopped":  true,
+					"env_variables": map[string]string{
+						"SINK_TYPE":                "LOG",
+						"INPUT_SCHEMA_PROTO_CLASS": "com.foo.Bar",
+						"SOURCE_KAFKA_BROKERS":     "localhost:9092",
+						"SOURCE_KAFKA_TOPIC":       "foo-log",
+					},
+				}),
+				PreviousDependencies: map[string]string{
+					"kube_cluster": "orn:entropy:kubernetes:foo:cluster-b",
+				},
+			},
+			want: &resource.Resource{
+				URN:     "urn:goto:entropy:foo:fh1",
+				Kind:    "firehose",
+				Name:    "fh1",
+				Project: "foo",
+				Spec: resource.Spec{
+					Configs: modules.MustJSON(map[string]any{
+						"namespace":     "firehose",
+						"stopped":       true,
+						"replicas":      1,
+						"deployment_id": "firehose-deployment-x",
+						"chart_values": map[string]string{
+							"image_repository":  "gotocompany/firehose",
+							"chart_version":     "1.0.0",
+							"image_pull_policy": "",
+							"image_tag":         "1.0.0",
+						},
+						"env_variables": map[string]string{
+							"SINK_TYPE":                      "LOG",
+							"INPUT_SCHEMA_PROTO_CLASS":       "com.foo.Bar",
+							"SOURCE_KAFKA_BROKERS":           "localhost:9092",
+							"SOURCE_KAFKA_CONSUMER_GROUP_ID": "foo-fh1-firehose-1",
+							"SOURCE_KAFKA_TOPIC":             "foo-log",
+						},
+						"limits": map[string]any{
+							"cpu":    "200m",
+							"memory": "512Mi",
+						},
+						"requests": map[string]any{
+							"cpu":    "200m",
+							"memory": "512Mi",
+						},
+						"init_container": map[string]interface{}{"args": interface{}(nil), "command": interface{}(nil), "enabled": false, "image_tag": "", "pull_policy": "", "repository": ""},
+					}),
+					Dependencies: map[string]string{
+						"kube_cluster": "orn:entropy:kubernetes:foo:cluster-a",
+					},
+				},
+				State: resource.State{
+					Status: resource.StatusPending,
+					Output: modules.MustJSON(Output{
+						Namespace:   "firehose",
+						ReleaseName: "bar",
+					}),
+					ModuleData: modules.MustJSON(transientData{
+						PendingSteps: []string{stepReleaseUpdate, stepPreviousReleaseDelete},
+						// the release on cluster-a is the one being deployed.
+						PreviousRelease: &previousRelease{
+							Namespace:   "firehose",
+							KubeCluster: "orn:entropy:kubernetes:foo:cluster-b",
+						},
+					}),
+					NextSyncAt: &frozenTime,
+				},
+			},
+			wantErr: nil,
+		},
 		{
 			title: "Update_Stopped_Firehose_Namespace",
 			exr: module.ExpandedResource{
@@ -612,7 +916,8 @@ func TestFirehoseDriver_Plan_Update(t *testing.T) {
 						ReleaseName: "bar",
 					}),
 					ModuleData: modules.MustJSON(transientData{
-						PendingSteps: []string{stepReleaseUpdate},
+						PendingSteps:    []string{stepReleaseUpdate, stepPreviousReleaseDelete},
+						PreviousRelease: &previousRelease{Namespace: "firehose"},
 					}),
 					NextSyncAt: &frozenTime,
 				},
@@ -65,6 +65,12 @@ func (fd *firehoseDriver) Sync(ctx context.Context, exr module.ExpandedResource)
 				return nil, err
 			}
 
+		case stepPreviousReleaseDelete:
+			if err := fd.previousReleaseDelete(ctx, exr, *conf, modData.PreviousRelease, kubeOut); err != nil {
+				return nil, err
+			}
+			modData.PreviousRelease = nil
+
 		default:
 			return nil, errors.ErrInternal.WithMsgf("unknown step: '%s'", pendingStep)
 		}
@@ -114,3 +120,31 @@ func (fd *firehoseDriver) releaseSync(ctx context.Context, r resource.Resource,
 	}
 	return nil
 }
+
+// previousReleaseDelete deletes the release left behind by a move to another
+// namespace or kube cluster. There is nothing to delete if the previous kube
+// cluster no longer exists.
+func (fd *firehoseDriver) previousReleaseDelete(ctx context.Context, exr module.ExpandedResource,
+	conf Config, prev *previousRelease, kubeOut kubernetes.Output,
+) error {
+	if prev == nil {
+		return nil
+	}
+
+	if prev.KubeCluster != "" && prev.KubeCluster != exr.Spec.Dependencies[keyKubeDependency] {
+		prevKube, found := exr.PreviousDependencies[keyKubeDependency]
+		if !found {
+			return nil
+		}
+
+		kubeOut = kubernetes.Output{}
+		if err := json.Unmarshal(prevKube.Output, &kubeOut); err != nil {
+			return errors.ErrInternal.WithMsgf("invalid previous kube state").WithCausef("%s", err.Error())
+		}
+	}
+
+	if err := fd.kubeDelete(ctx, kubeOut.Configs, prev.Namespace, conf.DeploymentID); err != nil {
+		return errors.ErrInternal.WithCausef("%s", err.Error())
+	}
+	return nil
+}
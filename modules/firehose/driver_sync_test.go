@@ -23,6 +23,7 @@ func TestFirehoseDriver_Sync(t *testing.T) {
 	table := []struct {
 		title             string
 		kubeDeploy        func(t *testing.T) kubeDeployFn
+		kubeDelete        func(t *testing.T) kubeDeleteFn
 		kubeGetPod        func(t *testing.T) kubeGetPodFn
 		kubeGetDeployment func(t *testing.T) kubeGetDeploymentFn
 
@@ -255,6 +256,114 @@ func TestFirehoseDriver_Sync(t *testing.T) {
 				NextSyncAt: &frozenTime,
 			},
 		},
+		{
+			title: "Sync_previous_release_delete_other_cluster",
+			exr: withPreviousKube(sampleResourceWithState(resource.State{
+				Status: resource.StatusPending,
+				Output: modules.MustJSON(Output{}),
+				ModuleData: modules.MustJSON(transientData{
+					PendingSteps: []string{stepPreviousReleaseDelete},
+					PreviousRelease: &previousRelease{
+						Namespace:   "firehose-old",
+						KubeCluster: "orn:entropy:kubernetes:foo:cluster-a",
+					},
+				}),
+			}, "LOG", "firehose"), "https://cluster-a"),
+			kubeDelete: func(t *testing.T) kubeDeleteFn {
+				t.Helper()
+				return func(ctx context.Context, conf kube.Config, ns, name string) error {
+					assert.Equal(t, "https://cluster-a", conf.Host)
+					assert.Equal(t, "firehose-old", ns)
+					assert.Equal(t, "firehose-foo-fh1", name)
+					return nil
+				}
+			},
+			want: &resource.State{
+				Status: resource.StatusPending,
+				Output: modules.MustJSON(Output{}),
+				ModuleData: modules.MustJSON(transientData{
+					PendingSteps: []string{},
+				}),
+				NextSyncAt: &frozenTime,
+			},
+		},
+		{
+			title: "Sync_previous_release_delete_same_cluster",
+			exr: sampleResourceWithState(resource.State{
+				Status: resource.StatusPending,
+				Output: modules.MustJSON(Output{}),
+				ModuleData: modules.MustJSON(transientData{
+					PendingSteps:    []string{stepPreviousReleaseDelete},
+					PreviousRelease: &previousRelease{Namespace: "firehose-old"},
+				}),
+			}, "LOG", "firehose"),
+			kubeDelete: func(t *testing.T) kubeDeleteFn {
+				t.Helper()
+				return func(ctx context.Context, conf kube.Config, ns, name string) error {
+					assert.Equal(t, "", conf.Host)
+					assert.Equal(t, "firehose-old", ns)
+					return nil
+				}
+			},
+			want: &resource.State{
+				Status: resource.StatusPending,
+				Output: modules.MustJSON(Output{}),
+				ModuleData: modules.MustJSON(transientData{
+					PendingSteps: []string{},
+				}),
+				NextSyncAt: &frozenTime,
+			},
+		},
+		{
+			title: "Sync_previous_release_delete_cluster_gone",
+			exr: sampleResourceWithState(resource.State{
+				Status: resource.StatusPending,
+				Output: modules.MustJSON(Output{}),
+				ModuleData: modules.MustJSON(transientData{
+					PendingSteps: []string{stepPreviousReleaseDelete},
+					PreviousRelease: &previousRelease{
+						Namespace:   "firehose-old",
+						KubeCluster: "orn:entropy:kubernetes:foo:cluster-a",
+					},
+				}),
+			}, "LOG", "firehose"),
+			kubeDelete: func(t *testing.T) kubeDeleteFn {
+				t.Helper()
+				return func(ctx context.Context, conf kube.Config, ns, name string) error {
+					t.Error("release must not be deleted from the current cluster")
+					return nil
+				}
+			},
+			want: &resource.State{
+				Status: resource.StatusPending,
+				Output: modules.MustJSON(Output{}),
+				ModuleData: modules.MustJSON(transientData{
+					PendingSteps: []string{},
+				}),
+				NextSyncAt: &frozenTime,
+			},
+		},
+		{
+			title: "Sync_previous_release_delete_failure",
+			exr: withPreviousKube(sampleResourceWithState(resource.State{
+				Status: resource.StatusPending,
+				Output: modules.MustJSON(Output{}),
+				ModuleData: modules.MustJSON(transientData{
+					PendingSteps: []string{stepPreviousReleaseDelete},
+					PreviousRelease: &previousRelease{
+						Namespace:   "firehose-old",
+						KubeCluster: "orn:entropy:kubernetes:foo:cluster-a",
+					},
+				}),
+			}, "LOG", "firehose"), "https://cluster-a"),
+			kubeDelete: func(t *testing.T) kubeDeleteFn {
+				t.Helper()
+				return func(ctx context.Context, conf kube.Config, ns, name string) error {
+					return errors.New("failed")
+				}
+			},
+			wantErr: errors.ErrInternal,
+		},
 	}
 
 	for _, tt := range table {
@@ -272,6 +381,10 @@ func TestFirehoseDriver_Sync(t *testing.T) {
 				fd.kubeDeploy = tt.kubeDeploy(t)
 			}
 
+			if tt.kubeDelete != nil {
+				fd.kubeDelete = tt.kubeDelete(t)
+			}
+
 			if tt.kubeGetDeployment != nil {
 				fd.kubeGetDeployment = tt.kubeGetDeployment(t)
 			}
@@ -331,3 +444,13 @@ func sampleResourceWithState(state resource.State, sinkType, namespace string) m
 		},
 	}
 }
+
+func withPreviousKube(exr module.ExpandedResource, host string) module.ExpandedResource {
+	exr.PreviousDependencies = map[string]module.ResolvedDependency{
+		"kube_cluster": {
+			Kind:   "kubernetes",
+			Output: modules.MustJSON(kubernetes.Output{Configs: kube.Config{Host: host}}),
+		},
+	}
+	return exr
+}
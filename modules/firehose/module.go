@@ -29,6 +29,8 @@ const (
 	ResetAction   = "reset"
 	ResetV2Action = "reset-v2"
 	UpgradeAction = "upgrade"
+
+	DependencyChangedAction = "dependency_changed"
 )
 
 var mu sync.Mutex
@@ -71,6 +73,13 @@ var Module = module.Descriptor{
 			Name:        UpgradeAction,
 			Description: "Upgrade firehose version",
 		},
+		{
+			Name:        DependencyChangedAction,
+			Description: "Redeploy firehose with the latest kubernetes cluster output.",
+		},
+	},
+	OnDependencyChange: &module.DependencyChangePolicy{
+		Action: DependencyChangedAction,
 	},
 	DriverFactory: func(confJSON json.RawMessage) (module.Driver, error) {
 		mu.Lock()
@@ -107,6 +116,15 @@ var Module = module.Descriptor{
 				_, errHelm := helmCl.Upsert(&hc, canUpdate)
 				return errHelm
 			},
+			kubeDelete: func(_ context.Context, kubeConf kube.Config, ns, name string) error {
+				helmCl := helm.NewClient(&helm.Config{Kubernetes: kubeConf})
+				err := helmCl.Delete(&helm.ReleaseConfig{Name: name, Namespace: ns})
+				if errors.Is(err, errors.ErrNotFound) {
+					// already deleted by an earlier attempt.
+					return nil
+				}
+				return err
+			},
 			kubeGetPod: func(ctx context.Context, conf kube.Config, ns string, labels map[string]string) ([]kube.Pod, error) {
 				kubeCl, err := kube.NewClient(ctx, conf)
 				if err != nil {
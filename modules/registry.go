@@ -2,28 +2,59 @@ package modules
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
 	"reflect"
 	"sync"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+
 	"github.com/goto/entropy/core/module"
 	"github.com/goto/entropy/pkg/errors"
+	"github.com/goto/entropy/pkg/telemetry"
+)
+
+const (
+	driverCacheHitCounter  = "driver_cache_hit_counter"
+	driverCacheMissCounter = "driver_cache_miss_counter"
 )
 
-// Registry maintains a list of supported/enabled modules.
+// Registry maintains a list of supported/enabled modules. Drivers initialised
+// through GetDriver are cached per module URN until the module configs change
+// or the cache entry is invalidated.
 type Registry struct {
+	// ServiceName is used as the name of the meter for cache metrics.
+	ServiceName string
+
 	mu      sync.RWMutex
 	modules map[string]module.Descriptor
+
+	cacheMu sync.RWMutex
+	drivers map[string]cachedDriver
 }
 
-func (mr *Registry) GetDriver(_ context.Context, mod module.Module) (module.Driver, module.Descriptor, error) {
-	mr.mu.RLock()
-	defer mr.mu.RUnlock()
+type cachedDriver struct {
+	configHash string
+	driver     module.Driver
+}
 
+func (mr *Registry) GetDriver(ctx context.Context, mod module.Module) (module.Driver, module.Descriptor, error) {
+	mr.mu.RLock()
 	desc, found := mr.modules[mod.Name]
+	mr.mu.RUnlock()
 	if !found {
 		return nil, module.Descriptor{}, errors.ErrNotFound
 	}
 
+	configHash := hashConfigs(mod.Configs)
+	if driver, hit := mr.cachedDriver(mod.URN, configHash); hit {
+		mr.countCacheLookup(ctx, driverCacheHitCounter, "hits", mod)
+		return driver, desc, nil
+	}
+	mr.countCacheLookup(ctx, driverCacheMissCounter, "misses", mod)
+
 	driver, err := desc.DriverFactory(mod.Configs)
 	if err != nil {
 		return nil, module.Descriptor{}, errors.ErrInvalid.
@@ -31,9 +62,26 @@ func (mr *Registry) GetDriver(_ context.Context, mod module.Module) (module.Driv
 			WithCausef("%s", err.Error())
 	}
 
+	if mod.URN != "" {
+		mr.cacheMu.Lock()
+		if mr.drivers == nil {
+			mr.drivers = map[string]cachedDriver{}
+		}
+		mr.drivers[mod.URN] = cachedDriver{configHash: configHash, driver: driver}
+		mr.cacheMu.Unlock()
+	}
+
 	return driver, desc, nil
 }
 
+// InvalidateDriver drops the cached driver for the module, if any. The next
+// GetDriver call for the module initialises a fresh driver.
+func (mr *Registry) InvalidateDriver(urn string) {
+	mr.cacheMu.Lock()
+	defer mr.cacheMu.Unlock()
+	delete(mr.drivers, urn)
+}
+
 // Register adds a module to the registry.
 func (mr *Registry) Register(desc module.Descriptor) error {
 	mr.mu.Lock()
@@ -56,3 +104,35 @@ func (mr *Registry) Register(desc module.Descriptor) error {
 	mr.modules[desc.Kind] = desc
 	return nil
 }
+
+func (mr *Registry) cachedDriver(urn, configHash string) (module.Driver, bool) {
+	if urn == "" {
+		return nil, false
+	}
+
+	mr.cacheMu.RLock()
+	defer mr.cacheMu.RUnlock()
+
+	cached, found := mr.drivers[urn]
+	if !found || cached.configHash != configHash {
+		return nil, false
+	}
+	return cached.driver, true
+}
+
+func (mr *Registry) countCacheLookup(ctx context.Context, name, result string, mod module.Module) {
+	counter, err := telemetry.GetMeter(mr.ServiceName).Int64Counter(
+		name,
+		metric.WithDescription(fmt.Sprintf("Total number of module driver cache %s", result)),
+		metric.WithUnit("1"),
+	)
+	if err != nil {
+		return
+	}
+	counter.Add(ctx, 1, metric.WithAttributes(attribute.String("module", mod.URN)))
+}
+
+func hashConfigs(configs []byte) string {
+	sum := sha256.Sum256(configs)
+	return hex.EncodeToString(sum[:])
+}
@@ -68,6 +68,46 @@ func TestRegistry_GetDriver(t *testing.T) {
 	})
 }
 
+func TestRegistry_GetDriver_Cache(t *testing.T) {
+	t.Parallel()
+
+	var factoryCalls int
+	reg := &modules.Registry{}
+	require.NoError(t, reg.Register(module.Descriptor{
+		Kind: "foo",
+		DriverFactory: func(_ json.RawMessage) (module.Driver, error) {
+			factoryCalls++
+			return &mocks.ModuleDriver{}, nil
+		},
+	}))
+
+	mod := module.Module{
+		URN:     "orn:entropy:module:prj:foo",
+		Name:    "foo",
+		Project: "prj",
+		Configs: json.RawMessage(`{"replicas":1}`),
+	}
+
+	first, _, err := reg.GetDriver(context.Background(), mod)
+	require.NoError(t, err)
+	second, _, err := reg.GetDriver(context.Background(), mod)
+	require.NoError(t, err)
+	assert.Same(t, first, second)
+	assert.Equal(t, 1, factoryCalls)
+
+	// changed configs must not be served from the cache.
+	mod.Configs = json.RawMessage(`{"replicas":2}`)
+	third, _, err := reg.GetDriver(context.Background(), mod)
+	require.NoError(t, err)
+	assert.NotSame(t, first, third)
+	assert.Equal(t, 2, factoryCalls)
+
+	reg.InvalidateDriver(mod.URN)
+	_, _, err = reg.GetDriver(context.Background(), mod)
+	require.NoError(t, err)
+	assert.Equal(t, 3, factoryCalls)
+}
+
 func TestRegistry_Register(t *testing.T) {
 	t.Parallel()
 
@@ -15,19 +15,31 @@ var (
 
 // Common error categories. Use `ErrX.WithXXX()` to clone and add context.
 var (
-	ErrInvalid     = Error{Code: "bad_request", Message: "request is not valid"}
-	ErrNotFound    = Error{Code: "not_found", Message: "requested entity not found"}
-	ErrConflict    = Error{Code: "conflict", Message: "an entity with conflicting identifier exists"}
-	ErrInternal    = Error{Code: "internal_error", Message: "some unexpected error occurred"}
-	ErrUnsupported = Error{Code: "unsupported", Message: "requested feature is not supported"}
+	ErrInvalid         = Error{Code: "bad_request", Message: "request is not valid"}
+	ErrNotFound        = Error{Code: "not_found", Message: "requested entity not found"}
+	ErrConflict        = Error{Code: "conflict", Message: "an entity with conflicting identifier exists"}
+	ErrInternal        = Error{Code: "internal_error", Message: "some unexpected error occurred"}
+	ErrUnsupported     = Error{Code: "unsupported", Message: "requested feature is not supported"}
+	ErrQuotaExceeded   = Error{Code: "quota_exceeded", Message: "project quota exceeded"}
+	ErrForbidden       = Error{Code: "forbidden", Message: "permission denied"}
+	ErrUnauthenticated = Error{Code: "unauthenticated", Message: "request is not authenticated"}
 )
 
 // Error represents any error returned by the Entropy components along with any
 // relevant context.
 type Error struct {
-	Code    string `json:"code"`
-	Cause   string `json:"cause,omitempty"`
-	Message string `json:"message"`
+	Code       string      `json:"code"`
+	Cause      string      `json:"cause,omitempty"`
+	Message    string      `json:"message"`
+	Violations []Violation `json:"violations,omitempty"`
+}
+
+// Violation is a single rule broken by a request, returned to the user
+// as a structured detail of the error.
+type Violation struct {
+	Type        string `json:"type"`
+	Subject     string `json:"subject"`
+	Description string `json:"description"`
 }
 
 // WithCausef returns clone of err with the cause added. Use this when
@@ -50,6 +62,14 @@ func (err Error) WithMsgf(format string, args ...interface{}) Error {
 	return cloned
 }
 
+// WithViolations returns a clone of the error with the violations that
+// caused it attached.
+func (err Error) WithViolations(violations ...Violation) Error {
+	cloned := err
+	cloned.Violations = append([]Violation(nil), violations...)
+	return cloned
+}
+
 // Is checks if 'other' is of type Error and has the same code.
 // See https://blog.golang.org/go1.13-errors.
 func (err Error) Is(other error) bool {
@@ -58,6 +58,9 @@ func (p *Client) Delete(config *ReleaseConfig) error {
 
 	act := action.NewUninstall(actionConfig)
 	if _, err := act.Run(config.Name); err != nil {
+		if isReleaseNotFoundErr(err) {
+			return errors.ErrNotFound.WithCausef("%s", err.Error())
+		}
 		return errors.ErrInternal.WithMsgf("unable to uninstall release %s", err)
 	}
 	return nil
@@ -19,14 +19,42 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	ResourceService_ListResources_FullMethodName        = "/gotocompany.entropy.v1beta1.ResourceService/ListResources"
-	ResourceService_GetResource_FullMethodName          = "/gotocompany.entropy.v1beta1.ResourceService/GetResource"
-	ResourceService_CreateResource_FullMethodName       = "/gotocompany.entropy.v1beta1.ResourceService/CreateResource"
-	ResourceService_UpdateResource_FullMethodName       = "/gotocompany.entropy.v1beta1.ResourceService/UpdateResource"
-	ResourceService_DeleteResource_FullMethodName       = "/gotocompany.entropy.v1beta1.ResourceService/DeleteResource"
-	ResourceService_ApplyAction_FullMethodName          = "/gotocompany.entropy.v1beta1.ResourceService/ApplyAction"
-	ResourceService_GetLog_FullMethodName               = "/gotocompany.entropy.v1beta1.ResourceService/GetLog"
-	ResourceService_GetResourceRevisions_FullMethodName = "/gotocompany.entropy.v1beta1.ResourceService/GetResourceRevisions"
+	ResourceService_ListResources_FullMethodName          = "/gotocompany.entropy.v1beta1.ResourceService/ListResources"
+	ResourceService_GetResource_FullMethodName            = "/gotocompany.entropy.v1beta1.ResourceService/GetResource"
+	ResourceService_CreateResource_FullMethodName         = "/gotocompany.entropy.v1beta1.ResourceService/CreateResource"
+	ResourceService_UpdateResource_FullMethodName         = "/gotocompany.entropy.v1beta1.ResourceService/UpdateResource"
+	ResourceService_DeleteResource_FullMethodName         = "/gotocompany.entropy.v1beta1.ResourceService/DeleteResource"
+	ResourceService_ApplyAction_FullMethodName            = "/gotocompany.entropy.v1beta1.ResourceService/ApplyAction"
+	ResourceService_GetLog_FullMethodName                 = "/gotocompany.entropy.v1beta1.ResourceService/GetLog"
+	ResourceService_GetResourceRevisions_FullMethodName   = "/gotocompany.entropy.v1beta1.ResourceService/GetResourceRevisions"
+	ResourceService_RollbackResource_FullMethodName       = "/gotocompany.entropy.v1beta1.ResourceService/RollbackResource"
+	ResourceService_DiffRevisions_FullMethodName          = "/gotocompany.entropy.v1beta1.ResourceService/DiffRevisions"
+	ResourceService_GetResourceDependents_FullMethodName  = "/gotocompany.entropy.v1beta1.ResourceService/GetResourceDependents"
+	ResourceService_ListAuditEvents_FullMethodName        = "/gotocompany.entropy.v1beta1.ResourceService/ListAuditEvents"
+	ResourceService_GetResourceSyncHistory_FullMethodName = "/gotocompany.entropy.v1beta1.ResourceService/GetResourceSyncHistory"
+	ResourceService_RetrySync_FullMethodName              = "/gotocompany.entropy.v1beta1.ResourceService/RetrySync"
+	ResourceService_WatchResources_FullMethodName         = "/gotocompany.entropy.v1beta1.ResourceService/WatchResources"
+	ResourceService_CreateWebhook_FullMethodName          = "/gotocompany.entropy.v1beta1.ResourceService/CreateWebhook"
+	ResourceService_ListWebhooks_FullMethodName           = "/gotocompany.entropy.v1beta1.ResourceService/ListWebhooks"
+	ResourceService_DeleteWebhook_FullMethodName          = "/gotocompany.entropy.v1beta1.ResourceService/DeleteWebhook"
+	ResourceService_ScheduleAction_FullMethodName         = "/gotocompany.entropy.v1beta1.ResourceService/ScheduleAction"
+	ResourceService_ListScheduledActions_FullMethodName   = "/gotocompany.entropy.v1beta1.ResourceService/ListScheduledActions"
+	ResourceService_CancelScheduledAction_FullMethodName  = "/gotocompany.entropy.v1beta1.ResourceService/CancelScheduledAction"
+	ResourceService_BulkApplyAction_FullMethodName        = "/gotocompany.entropy.v1beta1.ResourceService/BulkApplyAction"
+	ResourceService_CreateLock_FullMethodName             = "/gotocompany.entropy.v1beta1.ResourceService/CreateLock"
+	ResourceService_ListLocks_FullMethodName              = "/gotocompany.entropy.v1beta1.ResourceService/ListLocks"
+	ResourceService_DeleteLock_FullMethodName             = "/gotocompany.entropy.v1beta1.ResourceService/DeleteLock"
+	ResourceService_GetProjectQuota_FullMethodName        = "/gotocompany.entropy.v1beta1.ResourceService/GetProjectQuota"
+	ResourceService_CreatePolicy_FullMethodName           = "/gotocompany.entropy.v1beta1.ResourceService/CreatePolicy"
+	ResourceService_GetPolicy_FullMethodName              = "/gotocompany.entropy.v1beta1.ResourceService/GetPolicy"
+	ResourceService_ListPolicies_FullMethodName           = "/gotocompany.entropy.v1beta1.ResourceService/ListPolicies"
+	ResourceService_UpdatePolicy_FullMethodName           = "/gotocompany.entropy.v1beta1.ResourceService/UpdatePolicy"
+	ResourceService_DeletePolicy_FullMethodName           = "/gotocompany.entropy.v1beta1.ResourceService/DeletePolicy"
+	ResourceService_CreateRoleBinding_FullMethodName      = "/gotocompany.entropy.v1beta1.ResourceService/CreateRoleBinding"
+	ResourceService_ListRoleBindings_FullMethodName       = "/gotocompany.entropy.v1beta1.ResourceService/ListRoleBindings"
+	ResourceService_DeleteRoleBinding_FullMethodName      = "/gotocompany.entropy.v1beta1.ResourceService/DeleteRoleBinding"
+	ResourceService_GetOperation_FullMethodName           = "/gotocompany.entropy.v1beta1.ResourceService/GetOperation"
+	ResourceService_ListOperations_FullMethodName         = "/gotocompany.entropy.v1beta1.ResourceService/ListOperations"
 )
 
 // ResourceServiceClient is the client API for ResourceService service.
@@ -41,6 +69,34 @@ type ResourceServiceClient interface {
 	ApplyAction(ctx context.Context, in *ApplyActionRequest, opts ...grpc.CallOption) (*ApplyActionResponse, error)
 	GetLog(ctx context.Context, in *GetLogRequest, opts ...grpc.CallOption) (ResourceService_GetLogClient, error)
 	GetResourceRevisions(ctx context.Context, in *GetResourceRevisionsRequest, opts ...grpc.CallOption) (*GetResourceRevisionsResponse, error)
+	RollbackResource(ctx context.Context, in *RollbackResourceRequest, opts ...grpc.CallOption) (*RollbackResourceResponse, error)
+	DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error)
+	GetResourceDependents(ctx context.Context, in *GetResourceDependentsRequest, opts ...grpc.CallOption) (*GetResourceDependentsResponse, error)
+	ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error)
+	GetResourceSyncHistory(ctx context.Context, in *GetResourceSyncHistoryRequest, opts ...grpc.CallOption) (*GetResourceSyncHistoryResponse, error)
+	RetrySync(ctx context.Context, in *RetrySyncRequest, opts ...grpc.CallOption) (*RetrySyncResponse, error)
+	WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (ResourceService_WatchResourcesClient, error)
+	CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error)
+	ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error)
+	DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error)
+	ScheduleAction(ctx context.Context, in *ScheduleActionRequest, opts ...grpc.CallOption) (*ScheduleActionResponse, error)
+	ListScheduledActions(ctx context.Context, in *ListScheduledActionsRequest, opts ...grpc.CallOption) (*ListScheduledActionsResponse, error)
+	CancelScheduledAction(ctx context.Context, in *CancelScheduledActionRequest, opts ...grpc.CallOption) (*CancelScheduledActionResponse, error)
+	BulkApplyAction(ctx context.Context, in *BulkApplyActionRequest, opts ...grpc.CallOption) (*BulkApplyActionResponse, error)
+	CreateLock(ctx context.Context, in *CreateLockRequest, opts ...grpc.CallOption) (*CreateLockResponse, error)
+	ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error)
+	DeleteLock(ctx context.Context, in *DeleteLockRequest, opts ...grpc.CallOption) (*DeleteLockResponse, error)
+	GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*GetProjectQuotaResponse, error)
+	CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error)
+	GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*GetPolicyResponse, error)
+	ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error)
+	UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*UpdatePolicyResponse, error)
+	DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error)
+	CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...grpc.CallOption) (*CreateRoleBindingResponse, error)
+	ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error)
+	DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*DeleteRoleBindingResponse, error)
+	GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error)
+	ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error)
 }
 
 type resourceServiceClient struct {
@@ -146,6 +202,281 @@ func (c *resourceServiceClient) GetResourceRevisions(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *resourceServiceClient) RollbackResource(ctx context.Context, in *RollbackResourceRequest, opts ...grpc.CallOption) (*RollbackResourceResponse, error) {
+	out := new(RollbackResourceResponse)
+	err := c.cc.Invoke(ctx, ResourceService_RollbackResource_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DiffRevisions(ctx context.Context, in *DiffRevisionsRequest, opts ...grpc.CallOption) (*DiffRevisionsResponse, error) {
+	out := new(DiffRevisionsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DiffRevisions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) GetResourceDependents(ctx context.Context, in *GetResourceDependentsRequest, opts ...grpc.CallOption) (*GetResourceDependentsResponse, error) {
+	out := new(GetResourceDependentsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_GetResourceDependents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListAuditEvents(ctx context.Context, in *ListAuditEventsRequest, opts ...grpc.CallOption) (*ListAuditEventsResponse, error) {
+	out := new(ListAuditEventsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListAuditEvents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) GetResourceSyncHistory(ctx context.Context, in *GetResourceSyncHistoryRequest, opts ...grpc.CallOption) (*GetResourceSyncHistoryResponse, error) {
+	out := new(GetResourceSyncHistoryResponse)
+	err := c.cc.Invoke(ctx, ResourceService_GetResourceSyncHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) RetrySync(ctx context.Context, in *RetrySyncRequest, opts ...grpc.CallOption) (*RetrySyncResponse, error) {
+	out := new(RetrySyncResponse)
+	err := c.cc.Invoke(ctx, ResourceService_RetrySync_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) WatchResources(ctx context.Context, in *WatchResourcesRequest, opts ...grpc.CallOption) (ResourceService_WatchResourcesClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ResourceService_ServiceDesc.Streams[1], ResourceService_WatchResources_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &resourceServiceWatchResourcesClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ResourceService_WatchResourcesClient interface {
+	Recv() (*WatchResourcesResponse, error)
+	grpc.ClientStream
+}
+
+type resourceServiceWatchResourcesClient struct {
+	grpc.ClientStream
+}
+
+func (x *resourceServiceWatchResourcesClient) Recv() (*WatchResourcesResponse, error) {
+	m := new(WatchResourcesResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *resourceServiceClient) CreateWebhook(ctx context.Context, in *CreateWebhookRequest, opts ...grpc.CallOption) (*CreateWebhookResponse, error) {
+	out := new(CreateWebhookResponse)
+	err := c.cc.Invoke(ctx, ResourceService_CreateWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListWebhooks(ctx context.Context, in *ListWebhooksRequest, opts ...grpc.CallOption) (*ListWebhooksResponse, error) {
+	out := new(ListWebhooksResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListWebhooks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteWebhook(ctx context.Context, in *DeleteWebhookRequest, opts ...grpc.CallOption) (*DeleteWebhookResponse, error) {
+	out := new(DeleteWebhookResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DeleteWebhook_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ScheduleAction(ctx context.Context, in *ScheduleActionRequest, opts ...grpc.CallOption) (*ScheduleActionResponse, error) {
+	out := new(ScheduleActionResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ScheduleAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListScheduledActions(ctx context.Context, in *ListScheduledActionsRequest, opts ...grpc.CallOption) (*ListScheduledActionsResponse, error) {
+	out := new(ListScheduledActionsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListScheduledActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) CancelScheduledAction(ctx context.Context, in *CancelScheduledActionRequest, opts ...grpc.CallOption) (*CancelScheduledActionResponse, error) {
+	out := new(CancelScheduledActionResponse)
+	err := c.cc.Invoke(ctx, ResourceService_CancelScheduledAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) BulkApplyAction(ctx context.Context, in *BulkApplyActionRequest, opts ...grpc.CallOption) (*BulkApplyActionResponse, error) {
+	out := new(BulkApplyActionResponse)
+	err := c.cc.Invoke(ctx, ResourceService_BulkApplyAction_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) CreateLock(ctx context.Context, in *CreateLockRequest, opts ...grpc.CallOption) (*CreateLockResponse, error) {
+	out := new(CreateLockResponse)
+	err := c.cc.Invoke(ctx, ResourceService_CreateLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListLocks(ctx context.Context, in *ListLocksRequest, opts ...grpc.CallOption) (*ListLocksResponse, error) {
+	out := new(ListLocksResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListLocks_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteLock(ctx context.Context, in *DeleteLockRequest, opts ...grpc.CallOption) (*DeleteLockResponse, error) {
+	out := new(DeleteLockResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DeleteLock_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) GetProjectQuota(ctx context.Context, in *GetProjectQuotaRequest, opts ...grpc.CallOption) (*GetProjectQuotaResponse, error) {
+	out := new(GetProjectQuotaResponse)
+	err := c.cc.Invoke(ctx, ResourceService_GetProjectQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...grpc.CallOption) (*CreatePolicyResponse, error) {
+	out := new(CreatePolicyResponse)
+	err := c.cc.Invoke(ctx, ResourceService_CreatePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) GetPolicy(ctx context.Context, in *GetPolicyRequest, opts ...grpc.CallOption) (*GetPolicyResponse, error) {
+	out := new(GetPolicyResponse)
+	err := c.cc.Invoke(ctx, ResourceService_GetPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...grpc.CallOption) (*ListPoliciesResponse, error) {
+	out := new(ListPoliciesResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListPolicies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...grpc.CallOption) (*UpdatePolicyResponse, error) {
+	out := new(UpdatePolicyResponse)
+	err := c.cc.Invoke(ctx, ResourceService_UpdatePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...grpc.CallOption) (*DeletePolicyResponse, error) {
+	out := new(DeletePolicyResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DeletePolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...grpc.CallOption) (*CreateRoleBindingResponse, error) {
+	out := new(CreateRoleBindingResponse)
+	err := c.cc.Invoke(ctx, ResourceService_CreateRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...grpc.CallOption) (*ListRoleBindingsResponse, error) {
+	out := new(ListRoleBindingsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListRoleBindings_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...grpc.CallOption) (*DeleteRoleBindingResponse, error) {
+	out := new(DeleteRoleBindingResponse)
+	err := c.cc.Invoke(ctx, ResourceService_DeleteRoleBinding_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) GetOperation(ctx context.Context, in *GetOperationRequest, opts ...grpc.CallOption) (*GetOperationResponse, error) {
+	out := new(GetOperationResponse)
+	err := c.cc.Invoke(ctx, ResourceService_GetOperation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *resourceServiceClient) ListOperations(ctx context.Context, in *ListOperationsRequest, opts ...grpc.CallOption) (*ListOperationsResponse, error) {
+	out := new(ListOperationsResponse)
+	err := c.cc.Invoke(ctx, ResourceService_ListOperations_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ResourceServiceServer is the server API for ResourceService service.
 // All implementations must embed UnimplementedResourceServiceServer
 // for forward compatibility
@@ -158,6 +489,34 @@ type ResourceServiceServer interface {
 	ApplyAction(context.Context, *ApplyActionRequest) (*ApplyActionResponse, error)
 	GetLog(*GetLogRequest, ResourceService_GetLogServer) error
 	GetResourceRevisions(context.Context, *GetResourceRevisionsRequest) (*GetResourceRevisionsResponse, error)
+	RollbackResource(context.Context, *RollbackResourceRequest) (*RollbackResourceResponse, error)
+	DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error)
+	GetResourceDependents(context.Context, *GetResourceDependentsRequest) (*GetResourceDependentsResponse, error)
+	ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+	GetResourceSyncHistory(context.Context, *GetResourceSyncHistoryRequest) (*GetResourceSyncHistoryResponse, error)
+	RetrySync(context.Context, *RetrySyncRequest) (*RetrySyncResponse, error)
+	WatchResources(*WatchResourcesRequest, ResourceService_WatchResourcesServer) error
+	CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error)
+	ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error)
+	DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error)
+	ScheduleAction(context.Context, *ScheduleActionRequest) (*ScheduleActionResponse, error)
+	ListScheduledActions(context.Context, *ListScheduledActionsRequest) (*ListScheduledActionsResponse, error)
+	CancelScheduledAction(context.Context, *CancelScheduledActionRequest) (*CancelScheduledActionResponse, error)
+	BulkApplyAction(context.Context, *BulkApplyActionRequest) (*BulkApplyActionResponse, error)
+	CreateLock(context.Context, *CreateLockRequest) (*CreateLockResponse, error)
+	ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error)
+	DeleteLock(context.Context, *DeleteLockRequest) (*DeleteLockResponse, error)
+	GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*GetProjectQuotaResponse, error)
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error)
+	GetPolicy(context.Context, *GetPolicyRequest) (*GetPolicyResponse, error)
+	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error)
+	UpdatePolicy(context.Context, *UpdatePolicyRequest) (*UpdatePolicyResponse, error)
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*CreateRoleBindingResponse, error)
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error)
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*DeleteRoleBindingResponse, error)
+	GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error)
+	ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error)
 	mustEmbedUnimplementedResourceServiceServer()
 }
 
@@ -189,6 +548,90 @@ func (UnimplementedResourceServiceServer) GetLog(*GetLogRequest, ResourceService
 func (UnimplementedResourceServiceServer) GetResourceRevisions(context.Context, *GetResourceRevisionsRequest) (*GetResourceRevisionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetResourceRevisions not implemented")
 }
+func (UnimplementedResourceServiceServer) RollbackResource(context.Context, *RollbackResourceRequest) (*RollbackResourceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackResource not implemented")
+}
+func (UnimplementedResourceServiceServer) DiffRevisions(context.Context, *DiffRevisionsRequest) (*DiffRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRevisions not implemented")
+}
+func (UnimplementedResourceServiceServer) GetResourceDependents(context.Context, *GetResourceDependentsRequest) (*GetResourceDependentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceDependents not implemented")
+}
+func (UnimplementedResourceServiceServer) ListAuditEvents(context.Context, *ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAuditEvents not implemented")
+}
+func (UnimplementedResourceServiceServer) GetResourceSyncHistory(context.Context, *GetResourceSyncHistoryRequest) (*GetResourceSyncHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetResourceSyncHistory not implemented")
+}
+func (UnimplementedResourceServiceServer) RetrySync(context.Context, *RetrySyncRequest) (*RetrySyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetrySync not implemented")
+}
+func (UnimplementedResourceServiceServer) WatchResources(*WatchResourcesRequest, ResourceService_WatchResourcesServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchResources not implemented")
+}
+func (UnimplementedResourceServiceServer) CreateWebhook(context.Context, *CreateWebhookRequest) (*CreateWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateWebhook not implemented")
+}
+func (UnimplementedResourceServiceServer) ListWebhooks(context.Context, *ListWebhooksRequest) (*ListWebhooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListWebhooks not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteWebhook(context.Context, *DeleteWebhookRequest) (*DeleteWebhookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteWebhook not implemented")
+}
+func (UnimplementedResourceServiceServer) ScheduleAction(context.Context, *ScheduleActionRequest) (*ScheduleActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleAction not implemented")
+}
+func (UnimplementedResourceServiceServer) ListScheduledActions(context.Context, *ListScheduledActionsRequest) (*ListScheduledActionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListScheduledActions not implemented")
+}
+func (UnimplementedResourceServiceServer) CancelScheduledAction(context.Context, *CancelScheduledActionRequest) (*CancelScheduledActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelScheduledAction not implemented")
+}
+func (UnimplementedResourceServiceServer) BulkApplyAction(context.Context, *BulkApplyActionRequest) (*BulkApplyActionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BulkApplyAction not implemented")
+}
+func (UnimplementedResourceServiceServer) CreateLock(context.Context, *CreateLockRequest) (*CreateLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateLock not implemented")
+}
+func (UnimplementedResourceServiceServer) ListLocks(context.Context, *ListLocksRequest) (*ListLocksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLocks not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteLock(context.Context, *DeleteLockRequest) (*DeleteLockResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteLock not implemented")
+}
+func (UnimplementedResourceServiceServer) GetProjectQuota(context.Context, *GetProjectQuotaRequest) (*GetProjectQuotaResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetProjectQuota not implemented")
+}
+func (UnimplementedResourceServiceServer) CreatePolicy(context.Context, *CreatePolicyRequest) (*CreatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePolicy not implemented")
+}
+func (UnimplementedResourceServiceServer) GetPolicy(context.Context, *GetPolicyRequest) (*GetPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPolicy not implemented")
+}
+func (UnimplementedResourceServiceServer) ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPolicies not implemented")
+}
+func (UnimplementedResourceServiceServer) UpdatePolicy(context.Context, *UpdatePolicyRequest) (*UpdatePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePolicy not implemented")
+}
+func (UnimplementedResourceServiceServer) DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeletePolicy not implemented")
+}
+func (UnimplementedResourceServiceServer) CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*CreateRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRoleBinding not implemented")
+}
+func (UnimplementedResourceServiceServer) ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleBindings not implemented")
+}
+func (UnimplementedResourceServiceServer) DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*DeleteRoleBindingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteRoleBinding not implemented")
+}
+func (UnimplementedResourceServiceServer) GetOperation(context.Context, *GetOperationRequest) (*GetOperationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOperation not implemented")
+}
+func (UnimplementedResourceServiceServer) ListOperations(context.Context, *ListOperationsRequest) (*ListOperationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOperations not implemented")
+}
 func (UnimplementedResourceServiceServer) mustEmbedUnimplementedResourceServiceServer() {}
 
 // UnsafeResourceServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -349,46 +792,666 @@ func _ResourceService_GetResourceRevisions_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
-// ResourceService_ServiceDesc is the grpc.ServiceDesc for ResourceService service.
-// It's only intended for direct use with grpc.RegisterService,
-// and not to be introspected or modified (even as a copy)
-var ResourceService_ServiceDesc = grpc.ServiceDesc{
-	ServiceName: "gotocompany.entropy.v1beta1.ResourceService",
-	HandlerType: (*ResourceServiceServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ListResources",
-			Handler:    _ResourceService_ListResources_Handler,
-		},
-		{
-			MethodName: "GetResource",
-			Handler:    _ResourceService_GetResource_Handler,
-		},
-		{
-			MethodName: "CreateResource",
-			Handler:    _ResourceService_CreateResource_Handler,
-		},
-		{
-			MethodName: "UpdateResource",
-			Handler:    _ResourceService_UpdateResource_Handler,
-		},
-		{
-			MethodName: "DeleteResource",
-			Handler:    _ResourceService_DeleteResource_Handler,
-		},
-		{
-			MethodName: "ApplyAction",
-			Handler:    _ResourceService_ApplyAction_Handler,
-		},
-		{
-			MethodName: "GetResourceRevisions",
-			Handler:    _ResourceService_GetResourceRevisions_Handler,
-		},
-	},
-	Streams: []grpc.StreamDesc{
-		{
-			StreamName:    "GetLog",
-			Handler:       _ResourceService_GetLog_Handler,
+func _ResourceService_RollbackResource_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackResourceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).RollbackResource(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_RollbackResource_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).RollbackResource(ctx, req.(*RollbackResourceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DiffRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DiffRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DiffRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DiffRevisions(ctx, req.(*DiffRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_GetResourceDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).GetResourceDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_GetResourceDependents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).GetResourceDependents(ctx, req.(*GetResourceDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListAuditEvents(ctx, req.(*ListAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_GetResourceSyncHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetResourceSyncHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).GetResourceSyncHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_GetResourceSyncHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).GetResourceSyncHistory(ctx, req.(*GetResourceSyncHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_RetrySync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetrySyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).RetrySync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_RetrySync_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).RetrySync(ctx, req.(*RetrySyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_WatchResources_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchResourcesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ResourceServiceServer).WatchResources(m, &resourceServiceWatchResourcesServer{stream})
+}
+
+type ResourceService_WatchResourcesServer interface {
+	Send(*WatchResourcesResponse) error
+	grpc.ServerStream
+}
+
+type resourceServiceWatchResourcesServer struct {
+	grpc.ServerStream
+}
+
+func (x *resourceServiceWatchResourcesServer) Send(m *WatchResourcesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ResourceService_CreateWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).CreateWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_CreateWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).CreateWebhook(ctx, req.(*CreateWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListWebhooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListWebhooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListWebhooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListWebhooks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListWebhooks(ctx, req.(*ListWebhooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteWebhook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteWebhookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteWebhook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DeleteWebhook_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteWebhook(ctx, req.(*DeleteWebhookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ScheduleAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ScheduleActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ScheduleAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ScheduleAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ScheduleAction(ctx, req.(*ScheduleActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListScheduledActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListScheduledActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListScheduledActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListScheduledActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListScheduledActions(ctx, req.(*ListScheduledActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_CancelScheduledAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelScheduledActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).CancelScheduledAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_CancelScheduledAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).CancelScheduledAction(ctx, req.(*CancelScheduledActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_BulkApplyAction_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkApplyActionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).BulkApplyAction(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_BulkApplyAction_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).BulkApplyAction(ctx, req.(*BulkApplyActionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_CreateLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).CreateLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_CreateLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).CreateLock(ctx, req.(*CreateLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListLocks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListLocks(ctx, req.(*ListLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteLock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteLockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteLock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DeleteLock_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteLock(ctx, req.(*DeleteLockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_GetProjectQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetProjectQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).GetProjectQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_GetProjectQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).GetProjectQuota(ctx, req.(*GetProjectQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_CreatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).CreatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_CreatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).CreatePolicy(ctx, req.(*CreatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_GetPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).GetPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_GetPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).GetPolicy(ctx, req.(*GetPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPoliciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListPolicies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListPolicies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListPolicies(ctx, req.(*ListPoliciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_UpdatePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).UpdatePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_UpdatePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).UpdatePolicy(ctx, req.(*UpdatePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeletePolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeletePolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeletePolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DeletePolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeletePolicy(ctx, req.(*DeletePolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_CreateRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).CreateRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_CreateRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).CreateRoleBinding(ctx, req.(*CreateRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListRoleBindings_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRoleBindingsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListRoleBindings(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListRoleBindings_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_DeleteRoleBinding_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteRoleBindingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).DeleteRoleBinding(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_DeleteRoleBinding_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).DeleteRoleBinding(ctx, req.(*DeleteRoleBindingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_GetOperation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOperationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).GetOperation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_GetOperation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).GetOperation(ctx, req.(*GetOperationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ResourceService_ListOperations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOperationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ResourceServiceServer).ListOperations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: ResourceService_ListOperations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ResourceServiceServer).ListOperations(ctx, req.(*ListOperationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// ResourceService_ServiceDesc is the grpc.ServiceDesc for ResourceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var ResourceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "gotocompany.entropy.v1beta1.ResourceService",
+	HandlerType: (*ResourceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListResources",
+			Handler:    _ResourceService_ListResources_Handler,
+		},
+		{
+			MethodName: "GetResource",
+			Handler:    _ResourceService_GetResource_Handler,
+		},
+		{
+			MethodName: "CreateResource",
+			Handler:    _ResourceService_CreateResource_Handler,
+		},
+		{
+			MethodName: "UpdateResource",
+			Handler:    _ResourceService_UpdateResource_Handler,
+		},
+		{
+			MethodName: "DeleteResource",
+			Handler:    _ResourceService_DeleteResource_Handler,
+		},
+		{
+			MethodName: "ApplyAction",
+			Handler:    _ResourceService_ApplyAction_Handler,
+		},
+		{
+			MethodName: "GetResourceRevisions",
+			Handler:    _ResourceService_GetResourceRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackResource",
+			Handler:    _ResourceService_RollbackResource_Handler,
+		},
+		{
+			MethodName: "DiffRevisions",
+			Handler:    _ResourceService_DiffRevisions_Handler,
+		},
+		{
+			MethodName: "GetResourceDependents",
+			Handler:    _ResourceService_GetResourceDependents_Handler,
+		},
+		{
+			MethodName: "ListAuditEvents",
+			Handler:    _ResourceService_ListAuditEvents_Handler,
+		},
+		{
+			MethodName: "GetResourceSyncHistory",
+			Handler:    _ResourceService_GetResourceSyncHistory_Handler,
+		},
+		{
+			MethodName: "RetrySync",
+			Handler:    _ResourceService_RetrySync_Handler,
+		},
+		{
+			MethodName: "CreateWebhook",
+			Handler:    _ResourceService_CreateWebhook_Handler,
+		},
+		{
+			MethodName: "ListWebhooks",
+			Handler:    _ResourceService_ListWebhooks_Handler,
+		},
+		{
+			MethodName: "DeleteWebhook",
+			Handler:    _ResourceService_DeleteWebhook_Handler,
+		},
+		{
+			MethodName: "ScheduleAction",
+			Handler:    _ResourceService_ScheduleAction_Handler,
+		},
+		{
+			MethodName: "ListScheduledActions",
+			Handler:    _ResourceService_ListScheduledActions_Handler,
+		},
+		{
+			MethodName: "CancelScheduledAction",
+			Handler:    _ResourceService_CancelScheduledAction_Handler,
+		},
+		{
+			MethodName: "BulkApplyAction",
+			Handler:    _ResourceService_BulkApplyAction_Handler,
+		},
+		{
+			MethodName: "CreateLock",
+			Handler:    _ResourceService_CreateLock_Handler,
+		},
+		{
+			MethodName: "ListLocks",
+			Handler:    _ResourceService_ListLocks_Handler,
+		},
+		{
+			MethodName: "DeleteLock",
+			Handler:    _ResourceService_DeleteLock_Handler,
+		},
+		{
+			MethodName: "GetProjectQuota",
+			Handler:    _ResourceService_GetProjectQuota_Handler,
+		},
+		{
+			MethodName: "CreatePolicy",
+			Handler:    _ResourceService_CreatePolicy_Handler,
+		},
+		{
+			MethodName: "GetPolicy",
+			Handler:    _ResourceService_GetPolicy_Handler,
+		},
+		{
+			MethodName: "ListPolicies",
+			Handler:    _ResourceService_ListPolicies_Handler,
+		},
+		{
+			MethodName: "UpdatePolicy",
+			Handler:    _ResourceService_UpdatePolicy_Handler,
+		},
+		{
+			MethodName: "DeletePolicy",
+			Handler:    _ResourceService_DeletePolicy_Handler,
+		},
+		{
+			MethodName: "CreateRoleBinding",
+			Handler:    _ResourceService_CreateRoleBinding_Handler,
+		},
+		{
+			MethodName: "ListRoleBindings",
+			Handler:    _ResourceService_ListRoleBindings_Handler,
+		},
+		{
+			MethodName: "DeleteRoleBinding",
+			Handler:    _ResourceService_DeleteRoleBinding_Handler,
+		},
+		{
+			MethodName: "GetOperation",
+			Handler:    _ResourceService_GetOperation_Handler,
+		},
+		{
+			MethodName: "ListOperations",
+			Handler:    _ResourceService_ListOperations_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GetLog",
+			Handler:       _ResourceService_GetLog_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchResources",
+			Handler:       _ResourceService_WatchResources_Handler,
 			ServerStreams: true,
 		},
 	},